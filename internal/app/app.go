@@ -12,81 +12,47 @@ const (
 )
 
 type App struct {
-	Cli               *cli.App
-	StackNames        *cli.StringSlice
-	Profile           string
-	Region            string
-	InteractiveMode   bool
-	ForceMode         bool
-	YesMode           bool
-	ConcurrencyNumber int
+	Cli                   *cli.App
+	StackNames            *cli.StringSlice
+	Profile               string
+	Region                string
+	InteractiveMode       bool
+	ForceMode             bool
+	YesMode               bool
+	ConcurrencyNumber     int
+	FinalSnapshot         bool
+	DeleteLambdaLogGroups bool
+	PreEmpty              bool
+	RetainUnsupported     *cli.StringSlice
+	LeftoverReport        string
+	CleanupOrphanEni      *cli.StringSlice
+	OrphanEniReport       string
 
 	// CDK subcommand fields
 	CdkAppPath  string
 	CdkContexts *cli.StringSlice
+
+	// Resources subcommand fields
+	ResourcesMarkdown bool
 }
 
 func NewApp(version string) *App {
 	app := App{}
 	app.StackNames = cli.NewStringSlice()
 	app.CdkContexts = cli.NewStringSlice()
+	app.RetainUnsupported = cli.NewStringSlice()
+	app.CleanupOrphanEni = cli.NewStringSlice()
 
 	app.Cli = &cli.App{
 		Name:  "delstack",
 		Usage: "A CLI tool to force delete the entire CloudFormation stack.",
-		Flags: []cli.Flag{
-			&cli.StringSliceFlag{
-				Name:        "stackName",
-				Aliases:     []string{"s"},
-				Usage:       "CloudFormation stack names(one or more)",
-				Destination: app.StackNames,
-			},
-			&cli.StringFlag{
-				Name:        "profile",
-				Aliases:     []string{"p"},
-				Usage:       "AWS profile name",
-				Destination: &app.Profile,
-			},
-			&cli.StringFlag{
-				Name:        "region",
-				Aliases:     []string{"r"},
-				Usage:       "AWS region",
-				Destination: &app.Region,
-			},
-			&cli.BoolFlag{
-				Name:        "interactive",
-				Aliases:     []string{"i"},
-				Value:       false,
-				Usage:       "Interactive Mode",
-				Destination: &app.InteractiveMode,
-			},
-			&cli.BoolFlag{
-				Name:        "force",
-				Aliases:     []string{"f"},
-				Value:       false,
-				Usage:       "Force Mode to delete stacks including resources with deletion policy Retain/RetainExceptOnCreate, resources with deletion protection, and stacks with TerminationProtection",
-				Destination: &app.ForceMode,
-			},
-			&cli.BoolFlag{
-				Name:        "yes",
-				Aliases:     []string{"y"},
-				Value:       false,
-				Usage:       "Skip confirmation prompts",
-				Destination: &app.YesMode,
-			},
-			&cli.IntFlag{
-				Name:        "concurrencyNumber",
-				Aliases:     []string{"n"},
-				Value:       UnspecifiedConcurrencyNumber,
-				Usage:       "Specify the number of parallel stack deletions. Default is unlimited (delete all stacks in parallel).",
-				Destination: &app.ConcurrencyNumber,
-			},
-		},
+		Flags: app.deleteFlags(),
 		Commands: []*cli.Command{
 			{
 				Name:  "cdk",
 				Usage: "Delete stacks from a CDK app by synthesizing or reading an existing cdk.out",
-				Flags: []cli.Flag{
+				// The global flags are redefined so that they work after the subcommand name.
+				Flags: append([]cli.Flag{
 					&cli.StringFlag{
 						Name:        "app",
 						Aliases:     []string{"a"},
@@ -99,88 +65,152 @@ func NewApp(version string) *App {
 						Usage:       "CDK context values in key=value format (repeatable)",
 						Destination: app.CdkContexts,
 					},
-					// Shared flags (same as global flags, redefined so they work after the subcommand name)
-					&cli.StringSliceFlag{
-						Name:        "stackName",
-						Aliases:     []string{"s"},
-						Usage:       "CloudFormation stack names(one or more)",
-						Destination: app.StackNames,
-					},
-					&cli.StringFlag{
-						Name:        "profile",
-						Aliases:     []string{"p"},
-						Usage:       "AWS profile name",
-						Destination: &app.Profile,
-					},
-					&cli.StringFlag{
-						Name:        "region",
-						Aliases:     []string{"r"},
-						Usage:       "AWS region",
-						Destination: &app.Region,
-					},
-					&cli.BoolFlag{
-						Name:        "interactive",
-						Aliases:     []string{"i"},
-						Value:       false,
-						Usage:       "Interactive Mode",
-						Destination: &app.InteractiveMode,
-					},
-					&cli.BoolFlag{
-						Name:        "force",
-						Aliases:     []string{"f"},
-						Value:       false,
-						Usage:       "Force Mode to delete stacks including resources with deletion policy Retain/RetainExceptOnCreate, resources with deletion protection, and stacks with TerminationProtection",
-						Destination: &app.ForceMode,
-					},
-					&cli.BoolFlag{
-						Name:        "yes",
-						Aliases:     []string{"y"},
-						Value:       false,
-						Usage:       "Skip confirmation prompts",
-						Destination: &app.YesMode,
-					},
-					&cli.IntFlag{
-						Name:        "concurrencyNumber",
-						Aliases:     []string{"n"},
-						Value:       UnspecifiedConcurrencyNumber,
-						Usage:       "Specify the number of parallel stack deletions. Default is unlimited (delete all stacks in parallel).",
-						Destination: &app.ConcurrencyNumber,
-					},
-				},
+				}, app.deleteFlags()...),
 				Action: func(c *cli.Context) error {
 					return NewCdkAction(
-						app.StackNames.Value(),
-						app.Profile,
-						app.Region,
-						app.InteractiveMode,
-						app.ForceMode,
-						app.YesMode,
-						app.ConcurrencyNumber,
+						app.deleteOptions(),
 						app.CdkAppPath,
 						app.CdkContexts.Value(),
 					).Run(c.Context)
 				},
 			},
+			{
+				Name:  "resources",
+				Usage: "List the resource types that delstack force-deletes or processes before the deletion",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:        "markdown",
+						Value:       false,
+						Usage:       "Output the lists as Markdown tables",
+						Destination: &app.ResourcesMarkdown,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return NewResourcesAction(app.ResourcesMarkdown).Run(c.Context)
+				},
+			},
 		},
 	}
 
 	app.Cli.Version = version
 	app.Cli.Action = func(c *cli.Context) error {
-		return NewRootAction(
-			app.StackNames.Value(),
-			app.Profile,
-			app.Region,
-			app.InteractiveMode,
-			app.ForceMode,
-			app.YesMode,
-			app.ConcurrencyNumber,
-		).Run(c.Context)
+		return NewRootAction(app.deleteOptions()).Run(c.Context)
 	}
 	app.Cli.HideHelpCommand = true
 
 	return &app
 }
 
+// deleteFlags returns the flags of the stack deletion shared by the root command and the cdk subcommand.
+// A new slice is returned for each command since the flags hold their parsed state.
+func (a *App) deleteFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:        "stackName",
+			Aliases:     []string{"s"},
+			Usage:       "CloudFormation stack names(one or more)",
+			Destination: a.StackNames,
+		},
+		&cli.StringFlag{
+			Name:        "profile",
+			Aliases:     []string{"p"},
+			Usage:       "AWS profile name",
+			Destination: &a.Profile,
+		},
+		&cli.StringFlag{
+			Name:        "region",
+			Aliases:     []string{"r"},
+			Usage:       "AWS region",
+			Destination: &a.Region,
+		},
+		&cli.BoolFlag{
+			Name:        "interactive",
+			Aliases:     []string{"i"},
+			Value:       false,
+			Usage:       "Interactive Mode",
+			Destination: &a.InteractiveMode,
+		},
+		&cli.BoolFlag{
+			Name:        "force",
+			Aliases:     []string{"f"},
+			Value:       false,
+			Usage:       "Force Mode to delete stacks including resources with deletion policy Retain/RetainExceptOnCreate, resources with deletion protection, and stacks with TerminationProtection",
+			Destination: &a.ForceMode,
+		},
+		&cli.BoolFlag{
+			Name:        "yes",
+			Aliases:     []string{"y"},
+			Value:       false,
+			Usage:       "Skip confirmation prompts",
+			Destination: &a.YesMode,
+		},
+		&cli.IntFlag{
+			Name:        "concurrencyNumber",
+			Aliases:     []string{"n"},
+			Value:       UnspecifiedConcurrencyNumber,
+			Usage:       "Specify the number of parallel stack deletions. Default is unlimited (delete all stacks in parallel).",
+			Destination: &a.ConcurrencyNumber,
+		},
+		&cli.BoolFlag{
+			Name:        "finalSnapshot",
+			Value:       false,
+			Usage:       "Take a final snapshot when delstack deletes resources that support it by itself (e.g. ElastiCache replication groups)",
+			Destination: &a.FinalSnapshot,
+		},
+		&cli.BoolFlag{
+			Name:        "deleteLambdaLogGroups",
+			Value:       false,
+			Usage:       "Delete the log groups that Lambda creates implicitly for the functions in the stacks (/aws/lambda/<function name>) after the stacks are deleted",
+			Destination: &a.DeleteLambdaLogGroups,
+		},
+		&cli.BoolFlag{
+			Name:        "preEmpty",
+			Value:       false,
+			Usage:       "Start emptying the S3 buckets and ECR repositories in the stacks in parallel with the first stack deletion, instead of after it fails on them",
+			Destination: &a.PreEmpty,
+		},
+		&cli.StringSliceFlag{
+			Name:        "retainUnsupported",
+			Usage:       "Resource type patterns (e.g. 'Datadog::*') of unsupported resources to retain from the stacks instead of failing the deletion (repeatable). The retained resources are reported as leftovers",
+			Destination: a.RetainUnsupported,
+		},
+		&cli.StringFlag{
+			Name:        "leftoverReport",
+			Usage:       "Write the resources retained by --retainUnsupported to the file as JSON",
+			Destination: &a.LeftoverReport,
+		},
+		&cli.StringSliceFlag{
+			Name:        "cleanupOrphanEni",
+			Usage:       "Orphan ENIs of other AWS services to delete from the subnets and the security groups of the stacks, in addition to the AWS Lambda ones, as 'requesterId=<pattern>' or 'interfaceType=<pattern>' (e.g. 'interfaceType=vpc_endpoint') (repeatable). The deleted ENIs are reported",
+			Destination: a.CleanupOrphanEni,
+		},
+		&cli.StringFlag{
+			Name:        "orphanEniReport",
+			Usage:       "Write the ENIs deleted by --cleanupOrphanEni to the file as JSON",
+			Destination: &a.OrphanEniReport,
+		},
+	}
+}
+
+func (a *App) deleteOptions() DeleteOptions {
+	return DeleteOptions{
+		StackNames:            a.StackNames.Value(),
+		Profile:               a.Profile,
+		Region:                a.Region,
+		InteractiveMode:       a.InteractiveMode,
+		ForceMode:             a.ForceMode,
+		YesMode:               a.YesMode,
+		ConcurrencyNumber:     a.ConcurrencyNumber,
+		FinalSnapshot:         a.FinalSnapshot,
+		DeleteLambdaLogGroups: a.DeleteLambdaLogGroups,
+		PreEmpty:              a.PreEmpty,
+		RetainUnsupported:     a.RetainUnsupported.Value(),
+		LeftoverReport:        a.LeftoverReport,
+		CleanupOrphanEni:      a.CleanupOrphanEni.Value(),
+		OrphanEniReport:       a.OrphanEniReport,
+	}
+}
+
 func (a *App) Run(ctx context.Context) error {
 	return a.Cli.RunContext(ctx, os.Args)
 }
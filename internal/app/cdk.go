@@ -10,40 +10,32 @@ import (
 )
 
 type CdkAction struct {
-	stackNames        []string
-	profile           string
-	region            string
-	interactiveMode   bool
-	forceMode         bool
-	yesMode           bool
-	concurrencyNumber int
-	appPath           string
-	contexts          []string
+	options  DeleteOptions
+	appPath  string
+	contexts []string
 }
 
-func NewCdkAction(stackNames []string, profile, region string, interactiveMode, forceMode, yesMode bool, concurrencyNumber int, appPath string, contexts []string) *CdkAction {
+func NewCdkAction(options DeleteOptions, appPath string, contexts []string) *CdkAction {
 	return &CdkAction{
-		stackNames:        stackNames,
-		profile:           profile,
-		region:            region,
-		interactiveMode:   interactiveMode,
-		forceMode:         forceMode,
-		yesMode:           yesMode,
-		concurrencyNumber: concurrencyNumber,
-		appPath:           appPath,
-		contexts:          contexts,
+		options:  options,
+		appPath:  appPath,
+		contexts: contexts,
 	}
 }
 
 func (a *CdkAction) Run(ctx context.Context) error {
-	if a.interactiveMode && len(a.stackNames) != 0 {
+	if a.options.InteractiveMode && len(a.options.StackNames) != 0 {
 		return fmt.Errorf("InvalidOptionError: Stack names (-s) cannot be specified when using Interactive Mode (-i)")
 	}
-	if a.concurrencyNumber < UnspecifiedConcurrencyNumber {
+	if a.options.ConcurrencyNumber < UnspecifiedConcurrencyNumber {
 		return fmt.Errorf("InvalidOptionError: You must specify a positive number for the -n option")
 	}
+	operationOptions, err := a.options.operationOptions()
+	if err != nil {
+		return err
+	}
 
-	io.AutoYes = a.yesMode
+	io.AutoYes = a.options.YesMode
 
 	// Step 1: Synthesize or read existing cdk.out
 	cdkOutDir := cdk.DefaultCdkOutDir
@@ -76,8 +68,8 @@ func (a *CdkAction) Run(ctx context.Context) error {
 	}
 
 	// Step 3: Resolve regions, check existence/TP, select stacks
-	selector := NewCdkStackSelector(a.stackNames, a.interactiveMode, a.forceMode)
-	resolver := NewCdkStackResolver(selector, a.profile, a.region, a.forceMode)
+	selector := NewCdkStackSelector(a.options.StackNames, a.options.InteractiveMode, a.options.ForceMode)
+	resolver := NewCdkStackResolver(selector, a.options.Profile, a.options.Region, a.options.ForceMode)
 	targetStacks, err := resolver.Resolve(ctx, stacks)
 	if err != nil {
 		return err
@@ -87,7 +79,7 @@ func (a *CdkAction) Run(ctx context.Context) error {
 	}
 
 	// Step 4: Confirm TerminationProtection and deletion
-	confirmer := NewCdkStackConfirmer(a.forceMode, a.interactiveMode)
+	confirmer := NewCdkStackConfirmer(a.options.ForceMode, a.options.InteractiveMode)
 	ok, err := confirmer.Confirm(targetStacks)
 	if err != nil {
 		return err
@@ -98,7 +90,8 @@ func (a *CdkAction) Run(ctx context.Context) error {
 	}
 
 	// Step 5: Delete stacks
-	return NewCdkDeleter(a.profile, a.forceMode, a.concurrencyNumber).DeleteStacks(ctx, targetStacks)
+	err = NewCdkDeleter(a.options.Profile, a.options.ConcurrencyNumber, operationOptions).DeleteStacks(ctx, targetStacks)
+	return a.options.report(operationOptions, err)
 }
 
 func (a *CdkAction) isDirectory() bool {
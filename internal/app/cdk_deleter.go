@@ -16,18 +16,20 @@ import (
 
 type CdkDeleter struct {
 	profile           string
-	forceMode         bool
 	concurrencyNumber int
-	configLoader      IConfigLoader
-	analyzer          IDependencyAnalyzer
-	executor          IStackExecutor
+	// options are shared by the operator factories of all regions, so that their policies collect the
+	// leftovers and the deleted ENIs of all of them.
+	options      operation.Options
+	configLoader IConfigLoader
+	analyzer     IDependencyAnalyzer
+	executor     IStackExecutor
 }
 
-func NewCdkDeleter(profile string, forceMode bool, concurrencyNumber int) *CdkDeleter {
+func NewCdkDeleter(profile string, concurrencyNumber int, options operation.Options) *CdkDeleter {
 	return &CdkDeleter{
 		profile:           profile,
-		forceMode:         forceMode,
 		concurrencyNumber: concurrencyNumber,
+		options:           options,
 		configLoader:      &ConfigLoader{},
 		analyzer:          &DependencyAnalyzer{},
 		executor:          &StackExecutor{},
@@ -77,14 +79,14 @@ func (d *CdkDeleter) deleteStacksInRegion(ctx context.Context, region string, st
 		return fmt.Errorf("failed to load AWS config for region %s: %w", region, err)
 	}
 
-	operatorFactory := operation.NewOperatorFactory(config, d.forceMode)
+	operatorFactory := operation.NewOperatorFactory(config, d.options)
 
 	stackNames := make([]string, len(stacks))
 	for i, s := range stacks {
 		stackNames[i] = s.StackName
 	}
 
-	deleter := NewStackDeleter(d.forceMode, d.concurrencyNumber, d.analyzer, d.executor)
+	deleter := NewStackDeleter(d.options.ForceMode, d.concurrencyNumber, d.analyzer, d.executor)
 	return deleter.DeleteStacksConcurrently(ctx, stackNames, config, operatorFactory)
 }
 
@@ -124,7 +126,7 @@ func (d *CdkDeleter) deleteStacksWithCrossRegionDeps(ctx context.Context, stacks
 			return fmt.Errorf("failed to load AWS config for region %s: %w", s.Region, err)
 		}
 		configCache[s.Region] = cfg
-		factoryCache[s.Region] = operation.NewOperatorFactory(cfg, d.forceMode)
+		factoryCache[s.Region] = operation.NewOperatorFactory(cfg, d.options)
 	}
 
 	// Dynamic scheduling with channels (same pattern as deleteStacksDynamically)
@@ -166,7 +168,7 @@ func (d *CdkDeleter) deleteStacksWithCrossRegionDeps(ctx context.Context, stacks
 		config := configCache[s.Region]
 		operatorFactory := factoryCache[s.Region]
 
-		if err := d.executor.Execute(deleteCtx, s.StackName, config, operatorFactory, d.forceMode, true); err != nil {
+		if err := d.executor.Execute(deleteCtx, s.StackName, config, operatorFactory, d.options.ForceMode, true); err != nil {
 			select {
 			case errorChan <- err:
 			default:
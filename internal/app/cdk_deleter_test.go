@@ -41,7 +41,6 @@ func newTestCdkDeleter(executor IStackExecutor) *CdkDeleter {
 	}
 	return &CdkDeleter{
 		profile:           "test",
-		forceMode:         false,
 		concurrencyNumber: 0,
 		configLoader:      &mockConfigLoader{},
 		analyzer:          &passThroughAnalyzer{},
@@ -155,7 +154,6 @@ func TestCdkDeleter_DeleteStacks_LoadConfigError(t *testing.T) {
 
 	d := &CdkDeleter{
 		profile:           "test",
-		forceMode:         false,
 		concurrencyNumber: 0,
 		configLoader:      &mockConfigLoader{err: fmt.Errorf("config error")},
 		executor:          &mockStackExecutor{},
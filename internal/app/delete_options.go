@@ -0,0 +1,66 @@
+package app
+
+import (
+	"os"
+
+	"github.com/go-to-k/delstack/internal/operation"
+)
+
+// DeleteOptions are the options of the stack deletion shared by the root command and the cdk subcommand.
+type DeleteOptions struct {
+	StackNames        []string
+	Profile           string
+	Region            string
+	InteractiveMode   bool
+	ForceMode         bool
+	YesMode           bool
+	ConcurrencyNumber int
+	FinalSnapshot     bool
+	// DeleteLambdaLogGroups enables the cleanup of the log groups that Lambda creates implicitly.
+	DeleteLambdaLogGroups bool
+	// PreEmpty enables emptying the S3 buckets and the ECR repositories in parallel with the deletion.
+	PreEmpty bool
+	// RetainUnsupported are the patterns of the unsupported resource types retained from the stacks.
+	RetainUnsupported []string
+	// LeftoverReport is the path of the JSON report of the retained resources.
+	LeftoverReport string
+	// CleanupOrphanEni are the allow-list entries of the orphan ENIs deleted from the subnets and the
+	// security groups, in addition to the AWS Lambda ones.
+	CleanupOrphanEni []string
+	// OrphanEniReport is the path of the JSON report of the deleted ENIs.
+	OrphanEniReport string
+}
+
+// operationOptions validates the options for the operators and creates the policies shared by them.
+func (o DeleteOptions) operationOptions() (operation.Options, error) {
+	retainPolicy, err := newRetainPolicy(o.RetainUnsupported, o.LeftoverReport)
+	if err != nil {
+		return operation.Options{}, err
+	}
+	eniCleanupPolicy, err := newENICleanupPolicy(o.CleanupOrphanEni, o.OrphanEniReport)
+	if err != nil {
+		return operation.Options{}, err
+	}
+
+	return operation.Options{
+		ForceMode:             o.ForceMode,
+		FinalSnapshot:         o.FinalSnapshot,
+		DeleteLambdaLogGroups: o.DeleteLambdaLogGroups,
+		PreEmpty:              o.PreEmpty,
+		RetainPolicy:          retainPolicy,
+		ENICleanupPolicy:      eniCleanupPolicy,
+		PluginLoader:          operation.NewPluginLoader(os.Getenv("PATH")),
+	}, nil
+}
+
+// report outputs the leftovers and the deleted ENIs. They are reported even if some stacks failed,
+// since the others may have been deleted, so err is the error of the deletion and is returned first.
+func (o DeleteOptions) report(options operation.Options, err error) error {
+	if reportErr := reportLeftovers(options.RetainPolicy, o.LeftoverReport); reportErr != nil && err == nil {
+		err = reportErr
+	}
+	if reportErr := reportOrphanENIs(options.ENICleanupPolicy, o.OrphanEniReport); reportErr != nil && err == nil {
+		err = reportErr
+	}
+	return err
+}
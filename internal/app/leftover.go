@@ -0,0 +1,27 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-to-k/delstack/internal/operation"
+)
+
+// newRetainPolicy returns the retain policy for the --retainUnsupported patterns, or nil if no pattern
+// is specified.
+func newRetainPolicy(retainUnsupported []string, leftoverReport string) (*operation.RetainPolicy, error) {
+	if len(retainUnsupported) == 0 {
+		if leftoverReport != "" {
+			return nil, fmt.Errorf("InvalidOptionError: The --leftoverReport option requires the --retainUnsupported option")
+		}
+		return nil, nil
+	}
+	return operation.NewRetainPolicy(retainUnsupported)
+}
+
+// reportLeftovers outputs the resources retained by the policy, if any.
+func reportLeftovers(retainPolicy *operation.RetainPolicy, leftoverReport string) error {
+	if retainPolicy == nil {
+		return nil
+	}
+	return retainPolicy.Report(leftoverReport)
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/go-to-k/delstack/internal/operation"
+)
+
+// newENICleanupPolicy returns the ENI cleanup policy for the --cleanupOrphanEni entries, or nil if no
+// entry is specified.
+func newENICleanupPolicy(cleanupOrphanEni []string, orphanEniReport string) (*operation.ENICleanupPolicy, error) {
+	if len(cleanupOrphanEni) == 0 {
+		if orphanEniReport != "" {
+			return nil, fmt.Errorf("InvalidOptionError: The --orphanEniReport option requires the --cleanupOrphanEni option")
+		}
+		return nil, nil
+	}
+	return operation.NewENICleanupPolicy(cleanupOrphanEni)
+}
+
+// reportOrphanENIs outputs the ENIs deleted under the policy, if any.
+func reportOrphanENIs(eniCleanupPolicy *operation.ENICleanupPolicy, orphanEniReport string) error {
+	if eniCleanupPolicy == nil {
+		return nil
+	}
+	return eniCleanupPolicy.Report(orphanEniReport)
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/operation"
+)
+
+// ResourcesAction lists the resource types handled by delstack, generated from the operator registry
+// and the plugins on PATH.
+type ResourcesAction struct {
+	markdown bool
+}
+
+func NewResourcesAction(markdown bool) *ResourcesAction {
+	return &ResourcesAction{
+		markdown: markdown,
+	}
+}
+
+func (a *ResourcesAction) Run(ctx context.Context) error {
+	if a.markdown {
+		fmt.Fprintf(os.Stdout, "%s", a.markdownTables())
+		return nil
+	}
+
+	table, err := a.table(operation.DiscoverPlugins(ctx, os.Getenv("PATH")))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "%s", table)
+	return nil
+}
+
+func (a *ResourcesAction) table(plugins []*operation.Plugin) (string, error) {
+	header := []string{"ResourceType", "Handling", "Description"}
+	data := [][]string{}
+
+	for _, resource := range operation.SupportedResources() {
+		data = append(data, []string{resource.DisplayName(), "Force deletion", operation.PlainText(resource.Description)})
+	}
+	for _, p := range operation.RegisteredPreprocessors(operation.PerformanceOptimization) {
+		data = append(data, []string{p.ResourceType, "Performance optimization", operation.PlainText(p.Description)})
+	}
+	for _, p := range operation.RegisteredPreprocessors(operation.LeftoverCleanup) {
+		data = append(data, []string{p.ResourceType, "Leftover cleanup (-f)", operation.PlainText(p.Description)})
+	}
+	for _, p := range operation.RegisteredPreprocessors(operation.ExternalReferenceCleanup) {
+		data = append(data, []string{p.ResourceType, "External reference cleanup (-f)", operation.PlainText(p.Description)})
+	}
+	for _, plugin := range plugins {
+		for _, resourceType := range plugin.ResourceTypes {
+			data = append(data, []string{resourceType, "Plugin (" + operation.PluginPrefix + plugin.Name + ")", plugin.Description})
+		}
+	}
+
+	table, err := io.ToStringAsTableFormat(header, data)
+	if err != nil {
+		return "", fmt.Errorf("ResourcesTableError: failed to create resources table, %w", err)
+	}
+	return *table, nil
+}
+
+func (a *ResourcesAction) markdownTables() string {
+	return "## Resource Types that can be forced to delete\n\n" +
+		operation.SupportedResourcesMarkdown() +
+		"\n### Performance Optimization\n\n" +
+		operation.PreprocessorsMarkdown(operation.PerformanceOptimization) +
+		"\n### Leftover Cleanup (with `-f`)\n\n" +
+		operation.PreprocessorsMarkdown(operation.LeftoverCleanup) +
+		"\n### External Reference Cleanup (with `-f`)\n\n" +
+		operation.PreprocessorsMarkdown(operation.ExternalReferenceCleanup)
+}
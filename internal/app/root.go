@@ -13,49 +13,42 @@ import (
 )
 
 type RootAction struct {
-	stackNames        []string
-	profile           string
-	region            string
-	interactiveMode   bool
-	forceMode         bool
-	yesMode           bool
-	concurrencyNumber int
+	options DeleteOptions
 }
 
-func NewRootAction(stackNames []string, profile, region string, interactiveMode, forceMode, yesMode bool, concurrencyNumber int) *RootAction {
+func NewRootAction(options DeleteOptions) *RootAction {
 	return &RootAction{
-		stackNames:        stackNames,
-		profile:           profile,
-		region:            region,
-		interactiveMode:   interactiveMode,
-		forceMode:         forceMode,
-		yesMode:           yesMode,
-		concurrencyNumber: concurrencyNumber,
+		options: options,
 	}
 }
 
 func (a *RootAction) Run(ctx context.Context) error {
-	if !a.interactiveMode && len(a.stackNames) == 0 {
+	if !a.options.InteractiveMode && len(a.options.StackNames) == 0 {
 		errMsg := fmt.Sprintln("At least one stack name must be specified in command options (-s) or a flow of the interactive mode (-i).")
 		return fmt.Errorf("InvalidOptionError: %v", errMsg)
 	}
-	if a.interactiveMode && len(a.stackNames) != 0 {
+	if a.options.InteractiveMode && len(a.options.StackNames) != 0 {
 		errMsg := fmt.Sprintln("Stack names (-s) cannot be specified when using Interactive Mode (-i).")
 		return fmt.Errorf("InvalidOptionError: %v", errMsg)
 	}
-	if a.concurrencyNumber < UnspecifiedConcurrencyNumber {
+	if a.options.ConcurrencyNumber < UnspecifiedConcurrencyNumber {
 		errMsg := fmt.Sprintln("You must specify a positive number for the -n option.")
 		return fmt.Errorf("InvalidOptionError: %v", errMsg)
 	}
 
-	io.AutoYes = a.yesMode
+	operationOptions, err := a.options.operationOptions()
+	if err != nil {
+		return err
+	}
 
-	config, err := client.LoadAWSConfig(ctx, a.region, a.profile)
+	io.AutoYes = a.options.YesMode
+
+	config, err := client.LoadAWSConfig(ctx, a.options.Region, a.options.Profile)
 	if err != nil {
 		return err
 	}
 
-	operatorFactory := operation.NewOperatorFactory(config, a.forceMode)
+	operatorFactory := operation.NewOperatorFactory(config, operationOptions)
 	cloudformationStackOperator := operatorFactory.CreateCloudFormationStackOperator()
 
 	deduplicatedStackNames := a.deduplicateStackNames()
@@ -81,25 +74,23 @@ func (a *RootAction) Run(ctx context.Context) error {
 	}
 
 	stackLength := len(sortedStackNames)
-	if stackLength > 1 && (a.concurrencyNumber == UnspecifiedConcurrencyNumber || a.concurrencyNumber > 1) {
+	if stackLength > 1 && (a.options.ConcurrencyNumber == UnspecifiedConcurrencyNumber || a.options.ConcurrencyNumber > 1) {
 		var concurrency int
-		if a.concurrencyNumber == UnspecifiedConcurrencyNumber {
+		if a.options.ConcurrencyNumber == UnspecifiedConcurrencyNumber {
 			concurrency = stackLength
 		} else {
-			concurrency = min(a.concurrencyNumber, stackLength)
+			concurrency = min(a.options.ConcurrencyNumber, stackLength)
 		}
 		io.Logger.Info().Msgf("The stacks will be removed concurrently, taking into account dependencies. (concurrency: %d)", concurrency)
 	}
-	if err := NewStackDeleter(a.forceMode, a.concurrencyNumber, &DependencyAnalyzer{}, &StackExecutor{}).DeleteStacksConcurrently(ctx, sortedStackNames, config, operatorFactory); err != nil {
-		return err
-	}
-	return nil
+	err = NewStackDeleter(a.options.ForceMode, a.options.ConcurrencyNumber, &DependencyAnalyzer{}, &StackExecutor{}).DeleteStacksConcurrently(ctx, sortedStackNames, config, operatorFactory)
+	return a.options.report(operationOptions, err)
 }
 
 func (a *RootAction) deduplicateStackNames() []string {
 	deduplicatedStackNames := []string{}
 
-	for _, stackName := range a.stackNames {
+	for _, stackName := range a.options.StackNames {
 		var isDuplicated bool
 		for _, deduplicatedStackName := range deduplicatedStackNames {
 			if stackName == deduplicatedStackName {
@@ -117,16 +108,16 @@ func (a *RootAction) deduplicateStackNames() []string {
 
 func (a *RootAction) getSortedStackNames(ctx context.Context, cloudformationStackOperator *operation.CloudFormationStackOperator, specifiedStackNames []string) ([]string, []string, bool, error) {
 	if len(specifiedStackNames) != 0 {
-		stackNames, tpStackNames, err := cloudformationStackOperator.GetSortedStackNames(ctx, specifiedStackNames, a.forceMode)
+		stackNames, tpStackNames, err := cloudformationStackOperator.GetSortedStackNames(ctx, specifiedStackNames, a.options.ForceMode)
 		if err != nil {
 			return nil, nil, false, err
 		}
 		return stackNames, tpStackNames, true, nil
 	}
 
-	if a.interactiveMode {
+	if a.options.InteractiveMode {
 		keyword := a.inputKeywordForFilter()
-		stacks, err := cloudformationStackOperator.ListStacksFilteredByKeyword(ctx, aws.String(keyword), a.forceMode)
+		stacks, err := cloudformationStackOperator.ListStacksFilteredByKeyword(ctx, aws.String(keyword), a.options.ForceMode)
 		if err != nil {
 			return nil, nil, false, err
 		}
@@ -168,7 +159,7 @@ func (a *RootAction) selectStackNames(stackNames []string) ([]string, bool, erro
 	label := []string{
 		"Select StackNames.",
 	}
-	if a.forceMode {
+	if a.options.ForceMode {
 		label = append(label, "Nested child stacks and XXX_IN_PROGRESS(e.g. ROLLBACK_IN_PROGRESS) status stacks are not displayed.")
 		label = append(label, "(* = TerminationProtection)")
 	} else {
@@ -31,13 +31,22 @@ func (e *StackExecutor) Execute(
 
 	io.Logger.Info().Msgf("[%v]: Start deletion. Please wait a few minutes...", stack)
 
+	if err := cloudformationStackOperator.CheckDeletable(ctx, aws.String(stack), isRootStack); err != nil {
+		return fmt.Errorf("[%v]: Failed to delete: %w", stack, err)
+	}
+
 	if forceMode {
 		if err := cloudformationStackOperator.RemoveDeletionPolicy(ctx, aws.String(stack)); err != nil {
 			return fmt.Errorf("[%v]: Failed to remove deletion policy: %w", stack, err)
 		}
 	}
 
-	pp := preprocessor.NewRecursivePreprocessorFromConfig(config, forceMode)
+	preprocessors := operatorFactory.CreatePreprocessors()
+	if resourceEmptier := cloudformationStackOperator.ResourceEmptier(); resourceEmptier != nil {
+		preprocessors = append(preprocessors, resourceEmptier)
+	}
+
+	pp := preprocessor.NewRecursivePreprocessorFromConfig(config, forceMode, preprocessors)
 	if err := pp.PreprocessRecursively(ctx, aws.String(stack)); err != nil {
 		return fmt.Errorf("[%v]: %w", stack, err)
 	}
@@ -36,7 +36,7 @@ func (c *StackExistenceChecker) Check(ctx context.Context, region, stackName str
 		if err != nil {
 			return operation.StackCheckResult{}, fmt.Errorf("failed to load AWS config for region %s: %w", region, err)
 		}
-		factory := operation.NewOperatorFactory(cfg, c.forceMode)
+		factory := operation.NewOperatorFactory(cfg, operation.Options{ForceMode: c.forceMode})
 		op = factory.CreateCloudFormationStackOperator()
 		c.operatorCache[region] = op
 	}
@@ -0,0 +1,239 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	acmCertificateRetryInterval = 15 * time.Second
+
+	// acmCertificateMaxRetryCount bounds the wait for ACM to reflect detachments in `InUseBy`
+	// (about 5 minutes with the default interval).
+	acmCertificateMaxRetryCount = 20
+)
+
+var _ IOperator = (*AcmCertificateOperator)(nil)
+
+// AcmCertificateOperator deletes ACM certificates that fail to delete because they are still
+// associated with resources outside the stack, such as load balancers or CloudFront distributions.
+//
+// In force mode, the certificate is detached from the listeners of Application and Network
+// Load Balancers when possible: it is removed from the additional certificates of a listener,
+// and a default certificate is replaced with another certificate of the listener. Any other
+// association is reported as an error because removing it would change the other resource.
+type AcmCertificateOperator struct {
+	acmClient   client.IAcm
+	elbv2Client client.IELBV2
+	resources   []*types.StackResourceSummary
+	forceMode   bool
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewAcmCertificateOperator(acmClient client.IAcm, elbv2Client client.IELBV2) *AcmCertificateOperator {
+	return &AcmCertificateOperator{
+		acmClient:     acmClient,
+		elbv2Client:   elbv2Client,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: acmCertificateRetryInterval,
+	}
+}
+
+func (o *AcmCertificateOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *AcmCertificateOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *AcmCertificateOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, certificate := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.DeleteCertificate(ctx, certificate.PhysicalResourceId)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (o *AcmCertificateOperator) DeleteCertificate(ctx context.Context, certificateArn *string) error {
+	exists, err := o.acmClient.CheckCertificateExists(ctx, certificateArn)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	inUseBy, err := o.acmClient.GetCertificateInUseBy(ctx, certificateArn)
+	if err != nil {
+		return err
+	}
+
+	if len(inUseBy) > 0 {
+		blockingResources := inUseBy
+		if o.forceMode {
+			blockingResources, err = o.detachFromLoadBalancers(ctx, certificateArn, inUseBy)
+			if err != nil {
+				return err
+			}
+		}
+		if len(blockingResources) > 0 {
+			return o.raiseCertificateInUseError(certificateArn, blockingResources)
+		}
+
+		if err := o.waitForCertificateNotInUse(ctx, certificateArn); err != nil {
+			return err
+		}
+	}
+
+	return o.acmClient.DeleteCertificate(ctx, certificateArn)
+}
+
+// detachFromLoadBalancers detaches the certificate from the load balancers in inUseBy and
+// returns the resources that the certificate could not be detached from.
+func (o *AcmCertificateOperator) detachFromLoadBalancers(ctx context.Context, certificateArn *string, inUseBy []string) ([]string, error) {
+	blockingResources := []string{}
+
+	for _, resourceArn := range inUseBy {
+		if !isLoadBalancerArn(resourceArn) {
+			blockingResources = append(blockingResources, resourceArn)
+			continue
+		}
+
+		detached, err := o.detachFromLoadBalancer(ctx, certificateArn, aws.String(resourceArn))
+		if err != nil {
+			return nil, err
+		}
+		if !detached {
+			blockingResources = append(blockingResources, resourceArn)
+		}
+	}
+
+	return blockingResources, nil
+}
+
+func (o *AcmCertificateOperator) detachFromLoadBalancer(ctx context.Context, certificateArn *string, loadBalancerArn *string) (bool, error) {
+	listeners, err := o.elbv2Client.DescribeListeners(ctx, loadBalancerArn)
+	if err != nil {
+		return false, err
+	}
+
+	detached := true
+	for _, listener := range listeners {
+		if len(listener.Certificates) == 0 {
+			continue
+		}
+
+		certificates, err := o.elbv2Client.DescribeListenerCertificates(ctx, listener.ListenerArn)
+		if err != nil {
+			return false, err
+		}
+
+		var isDefault, isAdditional bool
+		var alternativeCertificateArn *string
+		for _, certificate := range certificates {
+			switch {
+			case aws.ToString(certificate.CertificateArn) != *certificateArn:
+				if !aws.ToBool(certificate.IsDefault) && alternativeCertificateArn == nil {
+					alternativeCertificateArn = certificate.CertificateArn
+				}
+			case aws.ToBool(certificate.IsDefault):
+				isDefault = true
+			default:
+				isAdditional = true
+			}
+		}
+
+		if isDefault {
+			if alternativeCertificateArn == nil {
+				io.Logger.Warn().Msgf("Certificate %s is the default certificate of listener %s, which has no alternative certificate.", *certificateArn, aws.ToString(listener.ListenerArn))
+				detached = false
+				continue
+			}
+			if err := o.elbv2Client.ModifyListenerDefaultCertificate(ctx, listener.ListenerArn, alternativeCertificateArn); err != nil {
+				return false, err
+			}
+			io.Logger.Info().Msgf("Replaced the default certificate of listener %s with %s.", aws.ToString(listener.ListenerArn), *alternativeCertificateArn)
+		}
+
+		if isAdditional {
+			if err := o.elbv2Client.RemoveListenerCertificate(ctx, listener.ListenerArn, certificateArn); err != nil {
+				return false, err
+			}
+			io.Logger.Info().Msgf("Removed certificate %s from listener %s.", *certificateArn, aws.ToString(listener.ListenerArn))
+		}
+	}
+
+	return detached, nil
+}
+
+// waitForCertificateNotInUse waits until ACM reflects the detachments, because `InUseBy` is
+// updated asynchronously and the deletion fails until then.
+func (o *AcmCertificateOperator) waitForCertificateNotInUse(ctx context.Context, certificateArn *string) error {
+	var inUseBy []string
+
+	return waitUntilOrTimeout(ctx, certificateArn, o.retryInterval, acmCertificateMaxRetryCount, func() (bool, error) {
+		var err error
+		inUseBy, err = o.acmClient.GetCertificateInUseBy(ctx, certificateArn)
+		if err != nil {
+			return false, err
+		}
+		return len(inUseBy) == 0, nil
+	}, func() error {
+		return o.raiseCertificateInUseError(certificateArn, inUseBy)
+	})
+}
+
+func (o *AcmCertificateOperator) raiseCertificateInUseError(certificateArn *string, blockingResources []string) error {
+	header := []string{"Service", "Resource"}
+	data := [][]string{}
+	for _, resourceArn := range blockingResources {
+		// ARN format: arn:<partition>:<service>:<region>:<account-id>:<resource>
+		service := "-"
+		if parts := strings.SplitN(resourceArn, ":", 6); len(parts) == 6 {
+			service = parts[2]
+		}
+		data = append(data, []string{service, resourceArn})
+	}
+
+	table, err := io.ToStringAsTableFormat(header, data)
+	if err != nil {
+		return fmt.Errorf("CertificateInUseError: failed to create in-use resources table, %w", err)
+	}
+
+	message := "the certificate is still in use by the following resources outside the stack, so failed delete:\n" + *table
+	if !o.forceMode {
+		message += "With the -f option, the certificate is detached from load balancer listeners where possible.\n"
+	}
+
+	return &client.ClientError{
+		ResourceName: certificateArn,
+		Err:          fmt.Errorf("CertificateInUseError: %v", message),
+	}
+}
+
+func isLoadBalancerArn(arn string) bool {
+	return strings.Contains(arn, ":elasticloadbalancing:") && strings.Contains(arn, ":loadbalancer/")
+}
@@ -0,0 +1,295 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testCertificateArn            = "arn:aws:acm:ap-northeast-1:123456789012:certificate/test"
+	testAlternativeCertificateArn = "arn:aws:acm:ap-northeast-1:123456789012:certificate/alternative"
+	testLoadBalancerArn           = "arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:loadbalancer/app/test/1234567890"
+	testListenerArn               = "arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:listener/app/test/1234567890/1234567890"
+	testDistributionArn           = "arn:aws:cloudfront::123456789012:distribution/E1234567890"
+)
+
+func certificateInUseError(t *testing.T, forceMode bool, blockingResources [][]string) error {
+	t.Helper()
+
+	table, err := io.ToStringAsTableFormat([]string{"Service", "Resource"}, blockingResources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := "the certificate is still in use by the following resources outside the stack, so failed delete:\n" + *table
+	if !forceMode {
+		message += "With the -f option, the certificate is detached from load balancer listeners where possible.\n"
+	}
+
+	return &client.ClientError{
+		ResourceName: aws.String(testCertificateArn),
+		Err:          fmt.Errorf("CertificateInUseError: %v", message),
+	}
+}
+
+/*
+	Test Cases
+*/
+
+func TestAcmCertificateOperator_DeleteCertificate(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		forceMode     bool
+		prepareMockFn func(m *client.MockIAcm, e *client.MockIELBV2)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete certificate successfully",
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{}, nil)
+				m.EXPECT().DeleteCertificate(gomock.Any(), aws.String(testCertificateArn)).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete certificate successfully for certificate not exists",
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete certificate failure for check certificate exists errors",
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(false, fmt.Errorf("DescribeCertificateError"))
+			},
+			want:    fmt.Errorf("DescribeCertificateError"),
+			wantErr: true,
+		},
+		{
+			name: "delete certificate failure for get in use by errors",
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return(nil, fmt.Errorf("DescribeCertificateError"))
+			},
+			want:    fmt.Errorf("DescribeCertificateError"),
+			wantErr: true,
+		},
+		{
+			name: "delete certificate failure for in use without force mode",
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn, testDistributionArn}, nil)
+			},
+			want: certificateInUseError(t, false, [][]string{
+				{"elasticloadbalancing", testLoadBalancerArn},
+				{"cloudfront", testDistributionArn},
+			}),
+			wantErr: true,
+		},
+		{
+			name:      "delete certificate successfully after removing additional certificate from listener in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn}, nil)
+				e.EXPECT().DescribeListeners(gomock.Any(), aws.String(testLoadBalancerArn)).Return([]elbv2types.Listener{
+					{
+						ListenerArn: aws.String("HttpListenerArn"),
+					},
+					{
+						ListenerArn: aws.String(testListenerArn),
+						Certificates: []elbv2types.Certificate{
+							{CertificateArn: aws.String(testAlternativeCertificateArn)},
+						},
+					},
+				}, nil)
+				e.EXPECT().DescribeListenerCertificates(gomock.Any(), aws.String(testListenerArn)).Return([]elbv2types.Certificate{
+					{CertificateArn: aws.String(testAlternativeCertificateArn), IsDefault: aws.Bool(true)},
+					{CertificateArn: aws.String(testCertificateArn), IsDefault: aws.Bool(false)},
+				}, nil)
+				e.EXPECT().RemoveListenerCertificate(gomock.Any(), aws.String(testListenerArn), aws.String(testCertificateArn)).Return(nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn}, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{}, nil)
+				m.EXPECT().DeleteCertificate(gomock.Any(), aws.String(testCertificateArn)).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete certificate successfully after replacing default certificate of listener in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn}, nil)
+				e.EXPECT().DescribeListeners(gomock.Any(), aws.String(testLoadBalancerArn)).Return([]elbv2types.Listener{
+					{
+						ListenerArn: aws.String(testListenerArn),
+						Certificates: []elbv2types.Certificate{
+							{CertificateArn: aws.String(testCertificateArn)},
+						},
+					},
+				}, nil)
+				e.EXPECT().DescribeListenerCertificates(gomock.Any(), aws.String(testListenerArn)).Return([]elbv2types.Certificate{
+					{CertificateArn: aws.String(testCertificateArn), IsDefault: aws.Bool(true)},
+					{CertificateArn: aws.String(testAlternativeCertificateArn), IsDefault: aws.Bool(false)},
+				}, nil)
+				e.EXPECT().ModifyListenerDefaultCertificate(gomock.Any(), aws.String(testListenerArn), aws.String(testAlternativeCertificateArn)).Return(nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{}, nil)
+				m.EXPECT().DeleteCertificate(gomock.Any(), aws.String(testCertificateArn)).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete certificate failure for default certificate without alternative and cloudfront in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn, testDistributionArn}, nil)
+				e.EXPECT().DescribeListeners(gomock.Any(), aws.String(testLoadBalancerArn)).Return([]elbv2types.Listener{
+					{
+						ListenerArn: aws.String(testListenerArn),
+						Certificates: []elbv2types.Certificate{
+							{CertificateArn: aws.String(testCertificateArn)},
+						},
+					},
+				}, nil)
+				e.EXPECT().DescribeListenerCertificates(gomock.Any(), aws.String(testListenerArn)).Return([]elbv2types.Certificate{
+					{CertificateArn: aws.String(testCertificateArn), IsDefault: aws.Bool(true)},
+				}, nil)
+			},
+			want: certificateInUseError(t, true, [][]string{
+				{"elasticloadbalancing", testLoadBalancerArn},
+				{"cloudfront", testDistributionArn},
+			}),
+			wantErr: true,
+		},
+		{
+			name:      "delete certificate failure for describe listeners errors in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn}, nil)
+				e.EXPECT().DescribeListeners(gomock.Any(), aws.String(testLoadBalancerArn)).Return(nil, fmt.Errorf("DescribeListenersError"))
+			},
+			want:    fmt.Errorf("DescribeListenersError"),
+			wantErr: true,
+		},
+		{
+			name:      "delete certificate failure for in use after retries in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn}, nil)
+				e.EXPECT().DescribeListeners(gomock.Any(), aws.String(testLoadBalancerArn)).Return([]elbv2types.Listener{}, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{testLoadBalancerArn}, nil).Times(acmCertificateMaxRetryCount + 1)
+			},
+			want: certificateInUseError(t, true, [][]string{
+				{"elasticloadbalancing", testLoadBalancerArn},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "delete certificate failure for delete certificate errors",
+			prepareMockFn: func(m *client.MockIAcm, e *client.MockIELBV2) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{}, nil)
+				m.EXPECT().DeleteCertificate(gomock.Any(), aws.String(testCertificateArn)).Return(fmt.Errorf("DeleteCertificateError"))
+			},
+			want:    fmt.Errorf("DeleteCertificateError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			acmMock := client.NewMockIAcm(ctrl)
+			elbv2Mock := client.NewMockIELBV2(ctrl)
+			tt.prepareMockFn(acmMock, elbv2Mock)
+
+			acmCertificateOperator := NewAcmCertificateOperator(acmMock, elbv2Mock)
+			acmCertificateOperator.forceMode = tt.forceMode
+			acmCertificateOperator.retryInterval = 0
+
+			err := acmCertificateOperator.DeleteCertificate(context.Background(), aws.String(testCertificateArn))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestAcmCertificateOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIAcm)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIAcm) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(true, nil)
+				m.EXPECT().GetCertificateInUseBy(gomock.Any(), aws.String(testCertificateArn)).Return([]string{}, nil)
+				m.EXPECT().DeleteCertificate(gomock.Any(), aws.String(testCertificateArn)).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIAcm) {
+				m.EXPECT().CheckCertificateExists(gomock.Any(), aws.String(testCertificateArn)).Return(false, fmt.Errorf("DescribeCertificateError"))
+			},
+			want:    fmt.Errorf("DescribeCertificateError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			acmMock := client.NewMockIAcm(ctrl)
+			elbv2Mock := client.NewMockIELBV2(ctrl)
+			tt.prepareMockFn(acmMock)
+
+			acmCertificateOperator := NewAcmCertificateOperator(acmMock, elbv2Mock)
+			acmCertificateOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::CertificateManager::Certificate"),
+				PhysicalResourceId: aws.String(testCertificateArn),
+			})
+
+			err := acmCertificateOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
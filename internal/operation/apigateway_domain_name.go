@@ -0,0 +1,84 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*ApiGatewayDomainNameOperator)(nil)
+
+// ApiGatewayDomainNameOperator deletes custom domain names for REST APIs that fail to delete
+// because base path mappings were added to APIs outside the stack.
+type ApiGatewayDomainNameOperator struct {
+	client    client.IApiGateway
+	resources []*types.StackResourceSummary
+}
+
+func NewApiGatewayDomainNameOperator(client client.IApiGateway) *ApiGatewayDomainNameOperator {
+	return &ApiGatewayDomainNameOperator{
+		client:    client,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *ApiGatewayDomainNameOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *ApiGatewayDomainNameOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *ApiGatewayDomainNameOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, domainName := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.DeleteDomainName(ctx, domainName.PhysicalResourceId)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (o *ApiGatewayDomainNameOperator) DeleteDomainName(ctx context.Context, domainName *string) error {
+	return deleteDomainName(ctx, restApiDomainNameClient{o.client}, domainName)
+}
+
+// restApiDomainNameClient adapts the API Gateway client for REST APIs to domainNameClient.
+type restApiDomainNameClient struct {
+	client.IApiGateway
+}
+
+func (c restApiDomainNameClient) getMappings(ctx context.Context, domainName *string) ([]domainNameMapping, error) {
+	mappings, err := c.GetBasePathMappings(ctx, domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	domainNameMappings := make([]domainNameMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		domainNameMappings = append(domainNameMappings, domainNameMapping{
+			id:          mapping.BasePath,
+			description: fmt.Sprintf("base path mapping %s (REST API %s)", aws.ToString(mapping.BasePath), aws.ToString(mapping.RestApiId)),
+		})
+	}
+	return domainNameMappings, nil
+}
+
+func (c restApiDomainNameClient) deleteMapping(ctx context.Context, domainName *string, mappingId *string) error {
+	return c.DeleteBasePathMapping(ctx, domainName, mappingId)
+}
@@ -0,0 +1,126 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	apigatewaytypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestApiGatewayDomainNameOperator_DeleteDomainName(t *testing.T) {
+	io.NewLogger(false)
+
+	for _, tt := range domainNameTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			apiGatewayMock := client.NewMockIApiGateway(ctrl)
+			prepareApiGatewayDomainNameOperatorMock(apiGatewayMock, tt)
+
+			apiGatewayOperator := NewApiGatewayDomainNameOperator(apiGatewayMock)
+
+			err := apiGatewayOperator.DeleteDomainName(context.Background(), aws.String("example.com"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func prepareApiGatewayDomainNameOperatorMock(m *client.MockIApiGateway, tt domainNameTestCase) {
+	domainName := aws.String("example.com")
+
+	m.EXPECT().CheckDomainNameExists(gomock.Any(), domainName).Return(tt.mappingIds != nil, tt.checkExistsErr)
+	if tt.mappingIds == nil || tt.checkExistsErr != nil {
+		return
+	}
+
+	mappings := []apigatewaytypes.BasePathMapping{}
+	for i, mappingId := range tt.mappingIds {
+		mappings = append(mappings, apigatewaytypes.BasePathMapping{
+			BasePath:  aws.String(mappingId),
+			RestApiId: aws.String(fmt.Sprintf("RestApiId%d", i+1)),
+		})
+	}
+	m.EXPECT().GetBasePathMappings(gomock.Any(), domainName).Return(mappings, tt.getMappingsErr)
+	if tt.getMappingsErr != nil {
+		return
+	}
+
+	for _, mappingId := range tt.mappingIds {
+		m.EXPECT().DeleteBasePathMapping(gomock.Any(), domainName, aws.String(mappingId)).Return(tt.deleteMappingErr)
+		if tt.deleteMappingErr != nil {
+			return
+		}
+	}
+
+	m.EXPECT().DeleteDomainName(gomock.Any(), domainName).Return(tt.deleteErr)
+}
+
+func TestApiGatewayDomainNameOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIApiGateway)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIApiGateway) {
+				m.EXPECT().CheckDomainNameExists(gomock.Any(), aws.String("PhysicalResourceId1")).Return(true, nil)
+				m.EXPECT().GetBasePathMappings(gomock.Any(), aws.String("PhysicalResourceId1")).Return([]apigatewaytypes.BasePathMapping{}, nil)
+				m.EXPECT().DeleteDomainName(gomock.Any(), aws.String("PhysicalResourceId1")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIApiGateway) {
+				m.EXPECT().CheckDomainNameExists(gomock.Any(), aws.String("PhysicalResourceId1")).Return(false, fmt.Errorf("GetDomainNameError"))
+			},
+			want:    fmt.Errorf("GetDomainNameError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			apiGatewayMock := client.NewMockIApiGateway(ctrl)
+			tt.prepareMockFn(apiGatewayMock)
+
+			apiGatewayOperator := NewApiGatewayDomainNameOperator(apiGatewayMock)
+			apiGatewayOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::ApiGateway::DomainName"),
+				PhysicalResourceId: aws.String("PhysicalResourceId1"),
+			})
+
+			err := apiGatewayOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*ApiGatewayV2DomainNameOperator)(nil)
+
+// ApiGatewayV2DomainNameOperator deletes custom domain names for HTTP and WebSocket APIs
+// that fail to delete because API mappings were added to APIs outside the stack.
+type ApiGatewayV2DomainNameOperator struct {
+	client    client.IApiGatewayV2
+	resources []*types.StackResourceSummary
+}
+
+func NewApiGatewayV2DomainNameOperator(client client.IApiGatewayV2) *ApiGatewayV2DomainNameOperator {
+	return &ApiGatewayV2DomainNameOperator{
+		client:    client,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *ApiGatewayV2DomainNameOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *ApiGatewayV2DomainNameOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *ApiGatewayV2DomainNameOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, domainName := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.DeleteDomainName(ctx, domainName.PhysicalResourceId)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (o *ApiGatewayV2DomainNameOperator) DeleteDomainName(ctx context.Context, domainName *string) error {
+	return deleteDomainName(ctx, apiV2DomainNameClient{o.client}, domainName)
+}
+
+// apiV2DomainNameClient adapts the API Gateway client for HTTP and WebSocket APIs to domainNameClient.
+type apiV2DomainNameClient struct {
+	client.IApiGatewayV2
+}
+
+func (c apiV2DomainNameClient) getMappings(ctx context.Context, domainName *string) ([]domainNameMapping, error) {
+	mappings, err := c.GetApiMappings(ctx, domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	domainNameMappings := make([]domainNameMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		domainNameMappings = append(domainNameMappings, domainNameMapping{
+			id:          mapping.ApiMappingId,
+			description: fmt.Sprintf("API mapping %s (API %s)", aws.ToString(mapping.ApiMappingId), aws.ToString(mapping.ApiId)),
+		})
+	}
+	return domainNameMappings, nil
+}
+
+func (c apiV2DomainNameClient) deleteMapping(ctx context.Context, domainName *string, mappingId *string) error {
+	return c.DeleteApiMapping(ctx, domainName, mappingId)
+}
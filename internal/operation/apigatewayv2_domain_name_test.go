@@ -0,0 +1,126 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	apigatewayv2types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestApiGatewayV2DomainNameOperator_DeleteDomainName(t *testing.T) {
+	io.NewLogger(false)
+
+	for _, tt := range domainNameTestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			apiGatewayV2Mock := client.NewMockIApiGatewayV2(ctrl)
+			prepareApiGatewayV2DomainNameOperatorMock(apiGatewayV2Mock, tt)
+
+			apiGatewayV2Operator := NewApiGatewayV2DomainNameOperator(apiGatewayV2Mock)
+
+			err := apiGatewayV2Operator.DeleteDomainName(context.Background(), aws.String("api.example.com"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func prepareApiGatewayV2DomainNameOperatorMock(m *client.MockIApiGatewayV2, tt domainNameTestCase) {
+	domainName := aws.String("api.example.com")
+
+	m.EXPECT().CheckDomainNameExists(gomock.Any(), domainName).Return(tt.mappingIds != nil, tt.checkExistsErr)
+	if tt.mappingIds == nil || tt.checkExistsErr != nil {
+		return
+	}
+
+	mappings := []apigatewayv2types.ApiMapping{}
+	for i, mappingId := range tt.mappingIds {
+		mappings = append(mappings, apigatewayv2types.ApiMapping{
+			ApiMappingId: aws.String(mappingId),
+			ApiId:        aws.String(fmt.Sprintf("ApiId%d", i+1)),
+		})
+	}
+	m.EXPECT().GetApiMappings(gomock.Any(), domainName).Return(mappings, tt.getMappingsErr)
+	if tt.getMappingsErr != nil {
+		return
+	}
+
+	for _, mappingId := range tt.mappingIds {
+		m.EXPECT().DeleteApiMapping(gomock.Any(), domainName, aws.String(mappingId)).Return(tt.deleteMappingErr)
+		if tt.deleteMappingErr != nil {
+			return
+		}
+	}
+
+	m.EXPECT().DeleteDomainName(gomock.Any(), domainName).Return(tt.deleteErr)
+}
+
+func TestApiGatewayV2DomainNameOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIApiGatewayV2)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIApiGatewayV2) {
+				m.EXPECT().CheckDomainNameExists(gomock.Any(), aws.String("PhysicalResourceId1")).Return(true, nil)
+				m.EXPECT().GetApiMappings(gomock.Any(), aws.String("PhysicalResourceId1")).Return([]apigatewayv2types.ApiMapping{}, nil)
+				m.EXPECT().DeleteDomainName(gomock.Any(), aws.String("PhysicalResourceId1")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIApiGatewayV2) {
+				m.EXPECT().CheckDomainNameExists(gomock.Any(), aws.String("PhysicalResourceId1")).Return(false, fmt.Errorf("GetDomainNameError"))
+			},
+			want:    fmt.Errorf("GetDomainNameError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			apiGatewayV2Mock := client.NewMockIApiGatewayV2(ctrl)
+			tt.prepareMockFn(apiGatewayV2Mock)
+
+			apiGatewayV2Operator := NewApiGatewayV2DomainNameOperator(apiGatewayV2Mock)
+			apiGatewayV2Operator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::ApiGatewayV2::DomainName"),
+				PhysicalResourceId: aws.String("PhysicalResourceId1"),
+			})
+
+			err := apiGatewayV2Operator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -2,25 +2,43 @@ package operation
 
 import (
 	"context"
+	"fmt"
 	"runtime"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
 	"github.com/go-to-k/delstack/pkg/client"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
 )
 
+const (
+	backupVaultRetryInterval = 30 * time.Second
+
+	// backupVaultMaxRetryCount bounds the waits for in-flight jobs and for recovery points
+	// in DELETING state (about 30 minutes with the default interval).
+	backupVaultMaxRetryCount = 60
+)
+
 var _ IOperator = (*BackupVaultOperator)(nil)
 
 type BackupVaultOperator struct {
 	client    client.IBackup
 	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
 }
 
 func NewBackupVaultOperator(client client.IBackup) *BackupVaultOperator {
 	return &BackupVaultOperator{
-		client:    client,
-		resources: []*types.StackResourceSummary{},
+		client:        client,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: backupVaultRetryInterval,
 	}
 }
 
@@ -59,15 +77,21 @@ func (o *BackupVaultOperator) DeleteBackupVault(ctx context.Context, backupVault
 		return nil
 	}
 
-	recoveryPoints, err := o.client.ListRecoveryPointsByBackupVault(ctx, backupVaultName)
+	vault, err := o.client.DescribeBackupVault(ctx, backupVaultName)
 	if err != nil {
 		return err
 	}
 
-	if len(recoveryPoints) > 0 {
-		if err := o.client.DeleteRecoveryPoints(ctx, backupVaultName, recoveryPoints); err != nil {
-			return err
-		}
+	if err := o.removeVaultLock(ctx, backupVaultName, vault); err != nil {
+		return err
+	}
+
+	if err := o.waitForActiveJobs(ctx, backupVaultName, vault.BackupVaultArn); err != nil {
+		return err
+	}
+
+	if err := o.deleteRecoveryPoints(ctx, backupVaultName); err != nil {
+		return err
 	}
 
 	if err := o.client.DeleteBackupVault(ctx, backupVaultName); err != nil {
@@ -76,3 +100,133 @@ func (o *BackupVaultOperator) DeleteBackupVault(ctx context.Context, backupVault
 
 	return nil
 }
+
+// removeVaultLock removes a Vault Lock in governance mode, or in compliance mode while it is
+// still in the grace time. A compliance-mode lock whose grace time has passed is immutable,
+// so the vault cannot be deleted until all recovery points reach their retention period.
+func (o *BackupVaultOperator) removeVaultLock(ctx context.Context, backupVaultName *string, vault *backup.DescribeBackupVaultOutput) error {
+	if !aws.ToBool(vault.Locked) {
+		return nil
+	}
+
+	if vault.LockDate != nil && !vault.LockDate.After(time.Now()) {
+		return &client.ClientError{
+			ResourceName: backupVaultName,
+			Err: fmt.Errorf(
+				"BackupVaultComplianceLockError: the vault is locked in compliance mode since %s and cannot be deleted until all recovery points expire (min retention: %d days)",
+				vault.LockDate.Format(time.RFC3339),
+				aws.ToInt64(vault.MinRetentionDays),
+			),
+		}
+	}
+
+	if err := o.client.DeleteBackupVaultLockConfiguration(ctx, backupVaultName); err != nil {
+		return err
+	}
+	io.Logger.Info().Msgf("Removed the vault lock of backup vault %s.", *backupVaultName)
+
+	return nil
+}
+
+// waitForActiveJobs stops the in-flight backup jobs for the vault and waits until they, the copy
+// jobs from or into the vault and the restore jobs from its recovery points are finished, because
+// the recovery points cannot be deleted while they are running. Copy jobs and restore jobs cannot be
+// stopped, so they are only waited for.
+func (o *BackupVaultOperator) waitForActiveJobs(ctx context.Context, backupVaultName *string, backupVaultArn *string) error {
+	var (
+		backupJobs  []backuptypes.BackupJob
+		copyJobs    []backuptypes.CopyJob
+		restoreJobs []backuptypes.RestoreJobsListMember
+	)
+
+	return waitUntilOrTimeout(ctx, backupVaultName, o.retryInterval, backupVaultMaxRetryCount, func() (bool, error) {
+		var err error
+		backupJobs, err = o.client.ListActiveBackupJobs(ctx, backupVaultName)
+		if err != nil {
+			return false, err
+		}
+		recoveryPoints, err := o.client.ListRecoveryPointsByBackupVault(ctx, backupVaultName)
+		if err != nil {
+			return false, err
+		}
+		recoveryPointArns := make([]*string, 0, len(recoveryPoints))
+		for _, recoveryPoint := range recoveryPoints {
+			recoveryPointArns = append(recoveryPointArns, recoveryPoint.RecoveryPointArn)
+		}
+		copyJobs, err = o.client.ListActiveCopyJobs(ctx, backupVaultArn, recoveryPointArns)
+		if err != nil {
+			return false, err
+		}
+		restoreJobs, err = o.client.ListActiveRestoreJobs(ctx, recoveryPointArns)
+		if err != nil {
+			return false, err
+		}
+
+		if len(backupJobs) == 0 && len(copyJobs) == 0 && len(restoreJobs) == 0 {
+			return true, nil
+		}
+
+		for _, backupJob := range backupJobs {
+			if backupJob.State == backuptypes.BackupJobStateAborting {
+				continue
+			}
+			if err := o.client.StopBackupJob(ctx, backupJob.BackupJobId); err != nil {
+				return false, err
+			}
+		}
+
+		io.Logger.Info().Msgf(
+			"Backup vault %s has %d backup job(s), %d copy job(s) and %d restore job(s) in progress. Waiting for them to finish.",
+			*backupVaultName, len(backupJobs), len(copyJobs), len(restoreJobs),
+		)
+		return false, nil
+	}, func() error {
+		return &client.ClientError{
+			ResourceName: backupVaultName,
+			Err: fmt.Errorf(
+				"BackupJobsInProgressError: %d backup job(s), %d copy job(s) and %d restore job(s) are still in progress",
+				len(backupJobs), len(copyJobs), len(restoreJobs),
+			),
+		}
+	})
+}
+
+// deleteRecoveryPoints deletes the recovery points in the vault and waits until all of them,
+// including ones already in DELETING state, are removed. Deleting the vault fails while any
+// recovery point is left.
+func (o *BackupVaultOperator) deleteRecoveryPoints(ctx context.Context, backupVaultName *string) error {
+	var recoveryPoints []backuptypes.RecoveryPointByBackupVault
+
+	return waitUntilOrTimeout(ctx, backupVaultName, o.retryInterval, backupVaultMaxRetryCount, func() (bool, error) {
+		var err error
+		recoveryPoints, err = o.client.ListRecoveryPointsByBackupVault(ctx, backupVaultName)
+		if err != nil {
+			return false, err
+		}
+
+		if len(recoveryPoints) == 0 {
+			return true, nil
+		}
+
+		deletableRecoveryPoints := []backuptypes.RecoveryPointByBackupVault{}
+		for _, recoveryPoint := range recoveryPoints {
+			if recoveryPoint.Status != backuptypes.RecoveryPointStatusDeleting {
+				deletableRecoveryPoints = append(deletableRecoveryPoints, recoveryPoint)
+			}
+		}
+
+		if len(deletableRecoveryPoints) > 0 {
+			if err := o.client.DeleteRecoveryPoints(ctx, backupVaultName, deletableRecoveryPoints); err != nil {
+				return false, err
+			}
+		} else {
+			io.Logger.Info().Msgf("Backup vault %s has %d recovery point(s) in DELETING state. Waiting for them to be deleted.", *backupVaultName, len(recoveryPoints))
+		}
+		return false, nil
+	}, func() error {
+		return &client.ClientError{
+			ResourceName: backupVaultName,
+			Err:          fmt.Errorf("RecoveryPointsDeletionTimeoutError: %d recovery point(s) are still left", len(recoveryPoints)),
+		}
+	})
+}
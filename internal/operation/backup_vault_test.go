@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
 	"github.com/aws/aws-sdk-go-v2/service/backup/types"
 	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/go-to-k/delstack/internal/io"
@@ -40,18 +42,44 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockIBackup) {
 				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
 				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
 					[]types.RecoveryPointByBackupVault{
 						{
-							BackupVaultName: aws.String("BackupVaultName1"),
-							BackupVaultArn:  aws.String("BackupVaultArn1"),
+							RecoveryPointArn: aws.String("RecoveryPointArn1"),
+							Status:           types.RecoveryPointStatusCompleted,
 						},
 						{
-							BackupVaultName: aws.String("BackupVaultName2"),
-							BackupVaultArn:  aws.String("BackupVaultArn2"),
+							RecoveryPointArn: aws.String("RecoveryPointArn2"),
+							Status:           types.RecoveryPointStatusCompleted,
+						},
+					}, nil)
+				m.EXPECT().ListActiveCopyJobs(
+					gomock.Any(),
+					aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+					[]*string{aws.String("RecoveryPointArn1"), aws.String("RecoveryPointArn2")},
+				).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(
+					gomock.Any(),
+					[]*string{aws.String("RecoveryPointArn1"), aws.String("RecoveryPointArn2")},
+				).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
+					[]types.RecoveryPointByBackupVault{
+						{
+							RecoveryPointArn: aws.String("RecoveryPointArn1"),
+							Status:           types.RecoveryPointStatusCompleted,
+						},
+						{
+							RecoveryPointArn: aws.String("RecoveryPointArn2"),
+							Status:           types.RecoveryPointStatusCompleted,
 						},
 					}, nil)
 				m.EXPECT().DeleteRecoveryPoints(gomock.Any(), aws.String("test"), gomock.Any()).Return(nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
 				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
 			},
 			want:    nil,
@@ -81,6 +109,271 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "delete backup vault failure for describe backup vault errors",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeBackupVaultError"))
+			},
+			want:    fmt.Errorf("DescribeBackupVaultError"),
+			wantErr: true,
+		},
+		{
+			name: "delete backup vault successfully after removing vault lock in governance mode",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+					Locked:          aws.Bool(true),
+				}, nil)
+				m.EXPECT().DeleteBackupVaultLockConfiguration(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), gomock.Any(), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete backup vault successfully after removing vault lock in compliance mode within grace time",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+					Locked:          aws.Bool(true),
+					LockDate:        aws.Time(time.Now().Add(24 * time.Hour)),
+				}, nil)
+				m.EXPECT().DeleteBackupVaultLockConfiguration(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), gomock.Any(), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete backup vault failure for vault lock in compliance mode after grace time",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName:  aws.String("test"),
+					BackupVaultArn:   aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+					Locked:           aws.Bool(true),
+					LockDate:         aws.Time(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					MinRetentionDays: aws.Int64(7),
+				}, nil)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("BackupVaultComplianceLockError: the vault is locked in compliance mode since 2024-01-01T00:00:00Z and cannot be deleted until all recovery points expire (min retention: 7 days)"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete backup vault failure for delete backup vault lock configuration errors",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+					Locked:          aws.Bool(true),
+				}, nil)
+				m.EXPECT().DeleteBackupVaultLockConfiguration(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteBackupVaultLockConfigurationError"))
+			},
+			want:    fmt.Errorf("DeleteBackupVaultLockConfigurationError"),
+			wantErr: true,
+		},
+		{
+			name: "delete backup vault successfully after stopping backup jobs and waiting for copy jobs",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{
+					{
+						BackupJobId: aws.String("BackupJobId1"),
+						State:       types.BackupJobStateRunning,
+					},
+					{
+						BackupJobId: aws.String("BackupJobId2"),
+						State:       types.BackupJobStateAborting,
+					},
+				}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{
+					{
+						CopyJobId: aws.String("CopyJobId1"),
+						State:     types.CopyJobStateRunning,
+					},
+				}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().StopBackupJob(gomock.Any(), aws.String("BackupJobId1")).Return(nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete backup vault failure for list active backup jobs errors",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListBackupJobsError"))
+			},
+			want:    fmt.Errorf("ListBackupJobsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete backup vault failure for list active copy jobs errors",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return(nil, fmt.Errorf("ListCopyJobsError"))
+			},
+			want:    fmt.Errorf("ListCopyJobsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete backup vault successfully after waiting for restore jobs",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				recoveryPoints := []types.RecoveryPointByBackupVault{
+					{
+						RecoveryPointArn: aws.String("RecoveryPointArn1"),
+						Status:           types.RecoveryPointStatusCompleted,
+					},
+				}
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(recoveryPoints, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), gomock.Any(), []*string{aws.String("RecoveryPointArn1")}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{aws.String("RecoveryPointArn1")}).Return([]types.RestoreJobsListMember{
+					{
+						RestoreJobId:     aws.String("RestoreJobId1"),
+						RecoveryPointArn: aws.String("RecoveryPointArn1"),
+						Status:           types.RestoreJobStatusRunning,
+					},
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(recoveryPoints, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), gomock.Any(), []*string{aws.String("RecoveryPointArn1")}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{aws.String("RecoveryPointArn1")}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(recoveryPoints, nil)
+				m.EXPECT().DeleteRecoveryPoints(gomock.Any(), aws.String("test"), recoveryPoints).Return(nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete backup vault failure for list active restore jobs errors",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), gomock.Any(), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return(nil, fmt.Errorf("ListRestoreJobsError"))
+			},
+			want:    fmt.Errorf("ListRestoreJobsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete backup vault failure for stop backup job errors",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{
+					{
+						BackupJobId: aws.String("BackupJobId1"),
+						State:       types.BackupJobStateRunning,
+					},
+				}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().StopBackupJob(gomock.Any(), aws.String("BackupJobId1")).Return(fmt.Errorf("StopBackupJobError"))
+			},
+			want:    fmt.Errorf("StopBackupJobError"),
+			wantErr: true,
+		},
 		{
 			name: "delete backup vault failure for list recovery points errors",
 			args: args{
@@ -89,6 +382,14 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockIBackup) {
 				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
 				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListRecoveryPointsByBackupVaultError"))
 			},
 			want:    fmt.Errorf("ListRecoveryPointsByBackupVaultError"),
@@ -102,15 +403,23 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockIBackup) {
 				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
 				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
 					[]types.RecoveryPointByBackupVault{
 						{
-							BackupVaultName: aws.String("BackupVaultName1"),
-							BackupVaultArn:  aws.String("BackupVaultArn1"),
+							RecoveryPointArn: aws.String("RecoveryPointArn1"),
+							Status:           types.RecoveryPointStatusCompleted,
 						},
 						{
-							BackupVaultName: aws.String("BackupVaultName2"),
-							BackupVaultArn:  aws.String("BackupVaultArn2"),
+							RecoveryPointArn: aws.String("RecoveryPointArn2"),
+							Status:           types.RecoveryPointStatusCompleted,
 						},
 					}, nil)
 				m.EXPECT().DeleteRecoveryPoints(gomock.Any(), aws.String("test"), gomock.Any()).Return(fmt.Errorf("DeleteRecoveryPointsError"))
@@ -126,12 +435,96 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockIBackup) {
 				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
 				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
 				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
 			},
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "delete backup vault successfully after retrying recovery points in deleting state",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
+					[]types.RecoveryPointByBackupVault{
+						{
+							RecoveryPointArn: aws.String("RecoveryPointArn1"),
+							Status:           types.RecoveryPointStatusCompleted,
+						},
+						{
+							RecoveryPointArn: aws.String("RecoveryPointArn2"),
+							Status:           types.RecoveryPointStatusDeleting,
+						},
+					}, nil)
+				m.EXPECT().DeleteRecoveryPoints(gomock.Any(), aws.String("test"), []types.RecoveryPointByBackupVault{
+					{
+						RecoveryPointArn: aws.String("RecoveryPointArn1"),
+						Status:           types.RecoveryPointStatusCompleted,
+					},
+				}).Return(nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
+					[]types.RecoveryPointByBackupVault{
+						{
+							RecoveryPointArn: aws.String("RecoveryPointArn2"),
+							Status:           types.RecoveryPointStatusDeleting,
+						},
+					}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete backup vault failure for recovery points left in deleting state",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIBackup) {
+				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
+					[]types.RecoveryPointByBackupVault{
+						{
+							RecoveryPointArn: aws.String("RecoveryPointArn1"),
+							Status:           types.RecoveryPointStatusDeleting,
+						},
+					}, nil).Times(backupVaultMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("RecoveryPointsDeletionTimeoutError: 1 recovery point(s) are still left"),
+			},
+			wantErr: true,
+		},
 		{
 			name: "delete backup vault failure for delete backup vault errors",
 			args: args{
@@ -140,18 +533,27 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockIBackup) {
 				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("test")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("test"),
+					BackupVaultArn:  aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("test")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("arn:aws:backup:ap-northeast-1:123456789012:backup-vault:test"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
 				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return(
 					[]types.RecoveryPointByBackupVault{
 						{
-							BackupVaultName: aws.String("BackupVaultName1"),
-							BackupVaultArn:  aws.String("BackupVaultArn1"),
+							RecoveryPointArn: aws.String("RecoveryPointArn1"),
+							Status:           types.RecoveryPointStatusCompleted,
 						},
 						{
-							BackupVaultName: aws.String("BackupVaultName2"),
-							BackupVaultArn:  aws.String("BackupVaultArn2"),
+							RecoveryPointArn: aws.String("RecoveryPointArn2"),
+							Status:           types.RecoveryPointStatusCompleted,
 						},
 					}, nil)
 				m.EXPECT().DeleteRecoveryPoints(gomock.Any(), aws.String("test"), gomock.Any()).Return(nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("test")).Return([]types.RecoveryPointByBackupVault{}, nil)
 				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteBackupVaultError"))
 			},
 			want:    fmt.Errorf("DeleteBackupVaultError"),
@@ -166,6 +568,7 @@ func TestBackupVaultOperator_DeleteBackupVault(t *testing.T) {
 			tt.prepareMockFn(backupMock)
 
 			backupOperator := NewBackupVaultOperator(backupMock)
+			backupOperator.retryInterval = 0
 
 			err := backupOperator.DeleteBackupVault(tt.args.ctx, tt.args.backupVaultName)
 			if (err != nil) != tt.wantErr {
@@ -201,6 +604,14 @@ func TestBackupVaultOperator_DeleteResourcesForBackupVault(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockIBackup) {
 				m.EXPECT().CheckBackupVaultExists(gomock.Any(), aws.String("PhysicalResourceId1")).Return(true, nil)
+				m.EXPECT().DescribeBackupVault(gomock.Any(), aws.String("PhysicalResourceId1")).Return(&backup.DescribeBackupVaultOutput{
+					BackupVaultName: aws.String("PhysicalResourceId1"),
+					BackupVaultArn:  aws.String("BackupVaultArn1"),
+				}, nil)
+				m.EXPECT().ListActiveBackupJobs(gomock.Any(), aws.String("PhysicalResourceId1")).Return([]types.BackupJob{}, nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("PhysicalResourceId1")).Return([]types.RecoveryPointByBackupVault{}, nil)
+				m.EXPECT().ListActiveCopyJobs(gomock.Any(), aws.String("BackupVaultArn1"), []*string{}).Return([]types.CopyJob{}, nil)
+				m.EXPECT().ListActiveRestoreJobs(gomock.Any(), []*string{}).Return([]types.RestoreJobsListMember{}, nil)
 				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("PhysicalResourceId1")).Return(
 					[]types.RecoveryPointByBackupVault{
 						{
@@ -213,6 +624,7 @@ func TestBackupVaultOperator_DeleteResourcesForBackupVault(t *testing.T) {
 						},
 					}, nil)
 				m.EXPECT().DeleteRecoveryPoints(gomock.Any(), aws.String("PhysicalResourceId1"), gomock.Any()).Return(nil)
+				m.EXPECT().ListRecoveryPointsByBackupVault(gomock.Any(), aws.String("PhysicalResourceId1")).Return([]types.RecoveryPointByBackupVault{}, nil)
 				m.EXPECT().DeleteBackupVault(gomock.Any(), aws.String("PhysicalResourceId1")).Return(nil)
 			},
 			want:    nil,
@@ -238,6 +650,7 @@ func TestBackupVaultOperator_DeleteResourcesForBackupVault(t *testing.T) {
 			tt.prepareMockFn(backupMock)
 
 			backupOperator := NewBackupVaultOperator(backupMock)
+			backupOperator.retryInterval = 0
 
 			backupOperator.AddResource(&cfnTypes.StackResourceSummary{
 				LogicalResourceId:  aws.String("LogicalResourceId1"),
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/preprocessor"
 	"github.com/go-to-k/delstack/internal/resourcetype"
 	"github.com/go-to-k/delstack/pkg/client"
 	"golang.org/x/sync/errgroup"
@@ -58,12 +59,22 @@ type StackCheckResult struct {
 
 const TerminationProtectionMarker = "* "
 
+// lambdaLogGroupNamePrefix is the prefix of the log groups that Lambda creates implicitly for functions.
+const lambdaLogGroupNamePrefix = "/aws/lambda/"
+
 type CloudFormationStackOperator struct {
 	config    aws.Config
 	client    client.ICloudFormation
 	s3Client  client.IS3
 	resources []*types.StackResourceSummary
-	forceMode bool
+	// options are also passed down to the operators for nested stacks.
+	options Options
+	// lambdaLogGroupOperator deletes the log groups that Lambda creates implicitly for the
+	// functions in the stack. It is nil unless the cleanup is enabled.
+	lambdaLogGroupOperator *LogGroupOperator
+	// resourceEmptier empties the S3 buckets and the ECR repositories in the stack in parallel with
+	// the first deletion of the stack. It is nil unless pre-emptying is enabled.
+	resourceEmptier *ResourceEmptier
 }
 
 func NewCloudFormationStackOperator(config aws.Config, client client.ICloudFormation, s3Client client.IS3) *CloudFormationStackOperator {
@@ -96,7 +107,10 @@ func (o *CloudFormationStackOperator) DeleteResources(ctx context.Context) error
 			stackName := StackNameRuleRegExp.ReplaceAllString(aws.ToString(stack.PhysicalResourceId), `$1`)
 
 			isRootStack := false
-			operatorFactory := NewOperatorFactory(o.config, o.forceMode)
+			options := o.options
+			// The emptier of the root stack already empties the resources in the nested stacks.
+			options.PreEmpty = false
+			operatorFactory := NewOperatorFactory(o.config, options)
 			operatorCollection := NewOperatorCollection(o.config, operatorFactory)
 			operatorManager := NewOperatorManager(operatorCollection)
 
@@ -107,11 +121,41 @@ func (o *CloudFormationStackOperator) DeleteResources(ctx context.Context) error
 	return eg.Wait()
 }
 
+// ResourceEmptier returns the preprocessor emptying the resources in parallel with the first deletion
+// of the stack, or nil if pre-emptying is disabled.
+func (o *CloudFormationStackOperator) ResourceEmptier() *ResourceEmptier {
+	return o.resourceEmptier
+}
+
 func (o *CloudFormationStackOperator) DeleteCloudFormationStack(ctx context.Context, stackName *string, isRootStack bool, operatorManager IOperatorManager) error {
+	// The root stack covers the functions in its nested stacks, so the cleanup runs only once.
+	if !isRootStack || o.lambdaLogGroupOperator == nil {
+		return o.deleteCloudFormationStack(ctx, stackName, isRootStack, operatorManager)
+	}
+
+	// The functions must be collected before the deletion since the stack cannot be listed afterwards.
+	logGroupNames, err := o.listLambdaLogGroupNames(ctx, stackName)
+	if err != nil {
+		return err
+	}
+
+	if err := o.deleteCloudFormationStack(ctx, stackName, isRootStack, operatorManager); err != nil {
+		return err
+	}
+
+	return o.deleteLambdaLogGroups(ctx, stackName, logGroupNames)
+}
+
+func (o *CloudFormationStackOperator) deleteCloudFormationStack(ctx context.Context, stackName *string, isRootStack bool, operatorManager IOperatorManager) error {
 	isSuccess, err := o.deleteStackNormally(ctx, stackName, isRootStack)
 	if err != nil {
+		// The data must not be lost when the stack is not deleted.
+		o.resourceEmptier.Cancel()
 		return err
 	}
+	// The resources being emptied must be empty before they are force-deleted, and the emptying
+	// must not outlive the deletion of the stack.
+	o.resourceEmptier.Wait(ctx)
 	if isSuccess {
 		return nil
 	}
@@ -122,7 +166,7 @@ func (o *CloudFormationStackOperator) DeleteCloudFormationStack(ctx context.Cont
 			return err
 		}
 
-		operatorManager.SetOperatorCollection(stackName, stackResourceSummaries)
+		operatorManager.SetOperatorCollection(ctx, stackName, stackResourceSummaries)
 
 		if err = operatorManager.CheckResourceCounts(); err != nil {
 			return err
@@ -141,6 +185,8 @@ func (o *CloudFormationStackOperator) DeleteCloudFormationStack(ctx context.Cont
 			return err
 		}
 		if len(stacksAfterDelete) == 0 {
+			// The resources retained from the stack remain only now that the stack has been deleted.
+			o.options.RetainPolicy.confirm(aws.ToString(stackName))
 			break
 		}
 		if stacksAfterDelete[0].StackStatus != types.StackStatusDeleteFailed {
@@ -151,6 +197,109 @@ func (o *CloudFormationStackOperator) DeleteCloudFormationStack(ctx context.Cont
 	return nil
 }
 
+// listLambdaLogGroupNames returns the names of the log groups that Lambda creates implicitly
+// (`/aws/lambda/<function name>`) for the functions in the stack and its nested stacks.
+// Log groups defined in the stacks are excluded because they are managed by CloudFormation, and
+// so are the ones of the functions and the nested stacks retained by their DeletionPolicy, as the
+// functions survive the deletion.
+func (o *CloudFormationStackOperator) listLambdaLogGroupNames(ctx context.Context, stackName *string) ([]string, error) {
+	logGroupNames := []string{}
+	stackLogGroupNames := map[string]struct{}{}
+
+	stackNames := []*string{stackName}
+	for len(stackNames) > 0 {
+		currentStackName := stackNames[0]
+		stackNames = stackNames[1:]
+
+		resources, err := o.client.ListStackResources(ctx, currentStackName)
+		if err != nil {
+			return nil, err
+		}
+
+		template, err := o.client.GetTemplate(ctx, currentStackName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the template to find retained resources: %w", err)
+		}
+		retained, err := preprocessor.RetainedLogicalResourceIds(template)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			if resource.ResourceStatus == types.ResourceStatusDeleteComplete || resource.PhysicalResourceId == nil {
+				continue
+			}
+			_, isRetained := retained[aws.ToString(resource.LogicalResourceId)]
+
+			switch aws.ToString(resource.ResourceType) {
+			case resourcetype.LambdaFunction:
+				if !isRetained {
+					logGroupNames = append(logGroupNames, lambdaLogGroupNamePrefix+aws.ToString(resource.PhysicalResourceId))
+				}
+			case resourcetype.LogsLogGroup:
+				stackLogGroupNames[aws.ToString(resource.PhysicalResourceId)] = struct{}{}
+			case resourcetype.CloudformationStack:
+				if !isRetained {
+					stackNames = append(stackNames, resource.PhysicalResourceId)
+				}
+			}
+		}
+	}
+
+	return slices.DeleteFunc(logGroupNames, func(name string) bool {
+		_, ok := stackLogGroupNames[name]
+		return ok
+	}), nil
+}
+
+func (o *CloudFormationStackOperator) deleteLambdaLogGroups(ctx context.Context, stackName *string, logGroupNames []string) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, logGroupName := range logGroupNames {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			if err := o.lambdaLogGroupOperator.DeleteLogGroup(ctx, aws.String(logGroupName)); err != nil {
+				return fmt.Errorf("LambdaLogGroupDeletionError: %w", err)
+			}
+			io.Logger.Info().Msgf("[%v]: Deleted the log group %s of a Lambda function", *stackName, logGroupName)
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// CheckDeletable returns the error that the deletion of the stack would be refused with, such as for
+// termination protection without force mode or an operation in progress. The preprocessing runs
+// only after this check, so that nothing is changed for a stack which is not deleted.
+func (o *CloudFormationStackOperator) CheckDeletable(ctx context.Context, stackName *string, isRootStack bool) error {
+	stacks, err := o.client.DescribeStacks(ctx, stackName)
+	if err != nil {
+		return err
+	}
+	if len(stacks) == 0 && isRootStack {
+		errMsg := fmt.Sprintf("%s not found", *stackName)
+		return fmt.Errorf("NotExistsError: %v", errMsg)
+	}
+	if len(stacks) == 0 {
+		return nil
+	}
+
+	if stacks[0].EnableTerminationProtection != nil && *stacks[0].EnableTerminationProtection && !o.options.ForceMode {
+		return fmt.Errorf("TerminationProtectionError: %v", *stackName)
+	}
+	if o.isExceptedByStackStatus(stacks[0].StackStatus) {
+		return fmt.Errorf("OperationInProgressError: Stacks with XxxInProgress cannot be deleted, but %v: %v", stacks[0].StackStatus, *stackName)
+	}
+
+	return nil
+}
+
 func (o *CloudFormationStackOperator) deleteStackNormally(ctx context.Context, stackName *string, isRootStack bool) (bool, error) {
 	stacksBeforeDelete, err := o.client.DescribeStacks(ctx, stackName)
 	if err != nil {
@@ -165,7 +314,7 @@ func (o *CloudFormationStackOperator) deleteStackNormally(ctx context.Context, s
 	}
 
 	if stacksBeforeDelete[0].EnableTerminationProtection != nil && *stacksBeforeDelete[0].EnableTerminationProtection {
-		if !o.forceMode {
+		if !o.options.ForceMode {
 			return false, fmt.Errorf("TerminationProtectionError: %v", *stackName)
 		}
 		io.Logger.Info().Msgf("[%v]: Disabling TerminationProtection...", *stackName)
@@ -178,6 +327,8 @@ func (o *CloudFormationStackOperator) deleteStackNormally(ctx context.Context, s
 		return false, fmt.Errorf("OperationInProgressError: Stacks with XxxInProgress cannot be deleted, but %v: %v", stacksBeforeDelete[0].StackStatus, *stackName)
 	}
 
+	// The checks have passed, so the resources are emptied while the stack is being deleted.
+	o.resourceEmptier.Start(ctx)
 	if deleteErr := o.client.DeleteStack(ctx, stackName, []string{}); deleteErr != nil {
 		return false, deleteErr
 	}
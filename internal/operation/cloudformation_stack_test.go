@@ -10,6 +10,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-to-k/delstack/internal/io"
 	"github.com/go-to-k/delstack/pkg/client"
@@ -531,8 +533,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(fmt.Errorf("CheckResourceCountsError"))
 			},
@@ -579,8 +581,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(fmt.Errorf("CheckResourceCountsError"))
 			},
@@ -627,8 +629,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(fmt.Errorf("DeleteResourceCollectionError"))
@@ -676,8 +678,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(fmt.Errorf("DeleteResourceCollectionError"))
@@ -743,8 +745,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -811,8 +813,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -863,8 +865,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{"LogicalResourceId1", "LogicalResourceId2"}).Return(fmt.Errorf("DeleteStackError"))
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -915,8 +917,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{"LogicalResourceId1", "LogicalResourceId2"}).Return(fmt.Errorf("DeleteStackError"))
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1006,16 +1008,16 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
 				// First iteration
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
 				m.EXPECT().GetLogicalResourceIds().Return([]string{"LogicalResourceId1"})
 
 				// Second iteration
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1105,16 +1107,16 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
 				// First iteration
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
 				m.EXPECT().GetLogicalResourceIds().Return([]string{"LogicalResourceId1"})
 
 				// Second iteration
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1181,8 +1183,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1249,8 +1251,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1312,8 +1314,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1375,8 +1377,8 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 				)
 			},
 			prepareMockOperatorManagerFn: func(m *MockIOperatorManager) {
-				m.EXPECT().SetOperatorCollection(aws.String("test"), gomock.Any()).Do(
-					func(stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
+				m.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any()).Do(
+					func(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {},
 				)
 				m.EXPECT().CheckResourceCounts().Return(nil)
 				m.EXPECT().DeleteResourceCollection(gomock.Any()).Return(nil)
@@ -1412,6 +1414,533 @@ func TestCloudFormationStackOperator_DeleteCloudFormationStack(t *testing.T) {
 	}
 }
 
+func TestCloudFormationStackOperator_DeleteCloudFormationStackWithRetainPolicy(t *testing.T) {
+	io.NewLogger(false)
+
+	leftover := Leftover{
+		StackName:          "test",
+		LogicalResourceId:  "Monitor",
+		PhysicalResourceId: "PhysicalResourceId1",
+		ResourceType:       "Datadog::Monitors::Monitor",
+	}
+
+	cases := []struct {
+		name                        string
+		prepareMockCloudFormationFn func(m *client.MockICloudFormation)
+		want                        []Leftover
+		wantErr                     bool
+	}{
+		{
+			name: "retained resources are reported after the stack has been deleted",
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{"Monitor"}).Return(nil)
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return([]types.Stack{}, nil)
+			},
+			want:    []Leftover{leftover},
+			wantErr: false,
+		},
+		{
+			name: "retained resources are not reported when the deletion of the stack fails",
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{"Monitor"}).Return(fmt.Errorf("DeleteStackError"))
+			},
+			want:    []Leftover{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cloudformationMock := client.NewMockICloudFormation(ctrl)
+			operatorManagerMock := NewMockIOperatorManager(ctrl)
+
+			retainPolicy, err := NewRetainPolicy([]string{"Datadog::*"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cloudformationMock.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+				[]types.Stack{
+					{
+						StackName:                   aws.String("test"),
+						StackStatus:                 "DELETE_FAILED",
+						EnableTerminationProtection: aws.Bool(false),
+					},
+				},
+				nil,
+			).Times(2)
+			cloudformationMock.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{}).Return(nil)
+			cloudformationMock.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return(
+				[]types.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("Monitor"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("Datadog::Monitors::Monitor"),
+						PhysicalResourceId: aws.String("PhysicalResourceId1"),
+					},
+				},
+				nil,
+			)
+			tt.prepareMockCloudFormationFn(cloudformationMock)
+
+			operatorManagerMock.EXPECT().SetOperatorCollection(gomock.Any(), aws.String("test"), gomock.Any())
+			operatorManagerMock.EXPECT().CheckResourceCounts().Return(nil)
+			// The retain operator records the resource while the resources of the stack are deleted.
+			operatorManagerMock.EXPECT().DeleteResourceCollection(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+				retainPolicy.record(leftover)
+				return nil
+			})
+			operatorManagerMock.EXPECT().GetLogicalResourceIds().Return([]string{"Monitor"})
+
+			s3Mock := client.NewMockIS3(ctrl)
+			cloudformationStackOperator := NewCloudFormationStackOperator(aws.Config{}, cloudformationMock, s3Mock)
+			cloudformationStackOperator.options.RetainPolicy = retainPolicy
+
+			err = cloudformationStackOperator.DeleteCloudFormationStack(context.Background(), aws.String("test"), true, operatorManagerMock)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if got := retainPolicy.Leftovers(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloudFormationStackOperator_DeleteCloudFormationStackWithLambdaLogGroups(t *testing.T) {
+	io.NewLogger(false)
+
+	type args struct {
+		ctx         context.Context
+		stackName   *string
+		isRootStack bool
+	}
+
+	deleteStackSuccessfully := func(m *client.MockICloudFormation) {
+		m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+			[]types.Stack{
+				{
+					StackName:                   aws.String("test"),
+					StackStatus:                 "CREATE_COMPLETE",
+					EnableTerminationProtection: aws.Bool(false),
+				},
+			},
+			nil,
+		)
+		m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{}).Return(nil)
+		m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+			[]types.Stack{},
+			nil,
+		)
+	}
+
+	cases := []struct {
+		name                        string
+		args                        args
+		prepareMockCloudFormationFn func(m *client.MockICloudFormation)
+		prepareMockLogsFn           func(m *client.MockICloudWatchLogs)
+		want                        error
+		wantErr                     bool
+	}{
+		{
+			name: "delete stack and lambda log groups including nested stacks successfully",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: true,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("Function1"),
+							PhysicalResourceId: aws.String("function1"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+						{
+							LogicalResourceId:  aws.String("Function2"),
+							PhysicalResourceId: aws.String("function2"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+						{
+							LogicalResourceId:  aws.String("Function2LogGroup"),
+							PhysicalResourceId: aws.String("/aws/lambda/function2"),
+							ResourceType:       aws.String("AWS::Logs::LogGroup"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+						{
+							LogicalResourceId:  aws.String("NestedStack"),
+							PhysicalResourceId: aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/nested/id"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+					},
+					nil,
+				)
+				m.EXPECT().GetTemplate(gomock.Any(), aws.String("test")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/nested/id")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("Function3"),
+							PhysicalResourceId: aws.String("function3"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+						{
+							LogicalResourceId:  aws.String("Function4"),
+							PhysicalResourceId: aws.String("function4"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "DELETE_COMPLETE",
+						},
+					},
+					nil,
+				)
+				m.EXPECT().GetTemplate(gomock.Any(), aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/nested/id")).Return(aws.String("Resources: {}"), nil)
+				deleteStackSuccessfully(m)
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("/aws/lambda/function1")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("/aws/lambda/function1")).Return([]cwltypes.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("/aws/lambda/function1")).Return([]cwltypes.MetricFilter{}, nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("/aws/lambda/function1")).Return(nil, nil)
+				m.EXPECT().DeleteLogGroup(gomock.Any(), aws.String("/aws/lambda/function1")).Return(nil)
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("/aws/lambda/function3")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete stack without deleting lambda log groups of retained functions and nested stacks",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: true,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("Function1"),
+							PhysicalResourceId: aws.String("function1"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+						{
+							LogicalResourceId:  aws.String("RetainedFunction"),
+							PhysicalResourceId: aws.String("retained-function"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+						{
+							LogicalResourceId:  aws.String("RetainedNestedStack"),
+							PhysicalResourceId: aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/retained-nested/id"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+					},
+					nil,
+				)
+				m.EXPECT().GetTemplate(gomock.Any(), aws.String("test")).Return(
+					aws.String(`{
+						"Resources": {
+							"Function1": {"Type": "AWS::Lambda::Function"},
+							"RetainedFunction": {"Type": "AWS::Lambda::Function", "DeletionPolicy": "Retain"},
+							"RetainedNestedStack": {"Type": "AWS::CloudFormation::Stack", "DeletionPolicy": "Retain"}
+						}
+					}`),
+					nil,
+				)
+				deleteStackSuccessfully(m)
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("/aws/lambda/function1")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete stack failure for get template errors",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: true,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return([]types.StackResourceSummary{}, nil)
+				m.EXPECT().GetTemplate(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("GetTemplateError"))
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {},
+			want:              fmt.Errorf("failed to get the template to find retained resources: GetTemplateError"),
+			wantErr:           true,
+		},
+		{
+			name: "delete stack without deleting lambda log groups for child stack",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: false,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				deleteStackSuccessfully(m)
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {},
+			want:              nil,
+			wantErr:           false,
+		},
+		{
+			name: "delete stack failure for list stack resources errors",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: true,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListStackResourcesError"))
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {},
+			want:              fmt.Errorf("ListStackResourcesError"),
+			wantErr:           true,
+		},
+		{
+			name: "delete stack failure without deleting lambda log groups for delete stack errors",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: true,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("Function1"),
+							PhysicalResourceId: aws.String("function1"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+					},
+					nil,
+				)
+				m.EXPECT().GetTemplate(gomock.Any(), aws.String("test")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "CREATE_COMPLETE",
+							EnableTerminationProtection: aws.Bool(false),
+						},
+					},
+					nil,
+				)
+				m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{}).Return(fmt.Errorf("DeleteStackError"))
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {},
+			want:              fmt.Errorf("DeleteStackError"),
+			wantErr:           true,
+		},
+		{
+			name: "delete stack failure for delete log group errors",
+			args: args{
+				ctx:         context.Background(),
+				stackName:   aws.String("test"),
+				isRootStack: true,
+			},
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().ListStackResources(gomock.Any(), aws.String("test")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("Function1"),
+							PhysicalResourceId: aws.String("function1"),
+							ResourceType:       aws.String("AWS::Lambda::Function"),
+							ResourceStatus:     "CREATE_COMPLETE",
+						},
+					},
+					nil,
+				)
+				m.EXPECT().GetTemplate(gomock.Any(), aws.String("test")).Return(aws.String("Resources: {}"), nil)
+				deleteStackSuccessfully(m)
+			},
+			prepareMockLogsFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("/aws/lambda/function1")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("/aws/lambda/function1")).Return([]cwltypes.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("/aws/lambda/function1")).Return([]cwltypes.MetricFilter{}, nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("/aws/lambda/function1")).Return(nil, nil)
+				m.EXPECT().DeleteLogGroup(gomock.Any(), aws.String("/aws/lambda/function1")).Return(fmt.Errorf("DeleteLogGroupError"))
+			},
+			want:    fmt.Errorf("LambdaLogGroupDeletionError: DeleteLogGroupError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cloudformationMock := client.NewMockICloudFormation(ctrl)
+			operatorManagerMock := NewMockIOperatorManager(ctrl)
+			logsMock := client.NewMockICloudWatchLogs(ctrl)
+
+			tt.prepareMockCloudFormationFn(cloudformationMock)
+			tt.prepareMockLogsFn(logsMock)
+
+			s3Mock := client.NewMockIS3(ctrl)
+			cloudformationStackOperator := NewCloudFormationStackOperator(aws.Config{}, cloudformationMock, s3Mock)
+			cloudformationStackOperator.lambdaLogGroupOperator = NewLogGroupOperator(logsMock)
+
+			err := cloudformationStackOperator.DeleteCloudFormationStack(tt.args.ctx, tt.args.stackName, tt.args.isRootStack, operatorManagerMock)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+				return
+			}
+		})
+	}
+}
+
+func TestCloudFormationStackOperator_DeleteCloudFormationStackWithResourceEmptier(t *testing.T) {
+	io.NewLogger(false)
+
+	resources := []types.StackResourceSummary{
+		{
+			LogicalResourceId:  aws.String("Bucket"),
+			PhysicalResourceId: aws.String("test-bucket"),
+			ResourceType:       aws.String("AWS::S3::Bucket"),
+		},
+		{
+			LogicalResourceId:  aws.String("Repository"),
+			PhysicalResourceId: aws.String("test-repository"),
+			ResourceType:       aws.String("AWS::ECR::Repository"),
+		},
+	}
+
+	cases := []struct {
+		name                        string
+		prepareMockCloudFormationFn func(m *client.MockICloudFormation)
+		prepareMockS3Fn             func(m *client.MockIS3)
+		prepareMockEcrFn            func(m *client.MockIEcr)
+		want                        error
+		wantErr                     bool
+	}{
+		{
+			name: "empty resources while the stack is being deleted",
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "CREATE_COMPLETE",
+							EnableTerminationProtection: aws.Bool(false),
+						},
+					},
+					nil,
+				)
+				m.EXPECT().DeleteStack(gomock.Any(), aws.String("test"), []string{}).Return(nil)
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{},
+					nil,
+				)
+			},
+			prepareMockS3Fn: func(m *client.MockIS3) {
+				m.EXPECT().CheckBucketExists(gomock.Any(), aws.String("test-bucket")).Return(true, nil)
+				m.EXPECT().ListObjectsOrVersionsByPage(gomock.Any(), aws.String("test-bucket"), nil, nil).Return(
+					&client.ListObjectsOrVersionsByPageOutput{
+						ObjectIdentifiers: []s3types.ObjectIdentifier{
+							{
+								Key:       aws.String("Key"),
+								VersionId: aws.String("VersionId"),
+							},
+						},
+					}, nil,
+				)
+				m.EXPECT().DeleteObjects(gomock.Any(), aws.String("test-bucket"), gomock.Any()).Return([]s3types.Error{}, nil)
+			},
+			prepareMockEcrFn: func(m *client.MockIEcr) {
+				m.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test-repository")).Return(true, nil)
+				m.EXPECT().ListImageIds(gomock.Any(), aws.String("test-repository")).Return(
+					[]ecrtypes.ImageIdentifier{{ImageDigest: aws.String("sha256:1")}}, nil,
+				)
+				m.EXPECT().BatchDeleteImages(gomock.Any(), aws.String("test-repository"), gomock.Any()).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "do not empty resources when the stack has termination protection",
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "CREATE_COMPLETE",
+							EnableTerminationProtection: aws.Bool(true),
+						},
+					},
+					nil,
+				)
+			},
+			prepareMockS3Fn:  func(m *client.MockIS3) {},
+			prepareMockEcrFn: func(m *client.MockIEcr) {},
+			want:             fmt.Errorf("TerminationProtectionError: test"),
+			wantErr:          true,
+		},
+		{
+			name: "do not empty resources when the stack operation is in progress",
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "UPDATE_IN_PROGRESS",
+							EnableTerminationProtection: aws.Bool(false),
+						},
+					},
+					nil,
+				)
+			},
+			prepareMockS3Fn:  func(m *client.MockIS3) {},
+			prepareMockEcrFn: func(m *client.MockIEcr) {},
+			want:             fmt.Errorf("OperationInProgressError: Stacks with XxxInProgress cannot be deleted, but UPDATE_IN_PROGRESS: test"),
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cloudformationMock := client.NewMockICloudFormation(ctrl)
+			operatorManagerMock := NewMockIOperatorManager(ctrl)
+			s3Mock := client.NewMockIS3(ctrl)
+			ecrMock := client.NewMockIEcr(ctrl)
+
+			cloudformationMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test")).Return(aws.String("Resources: {}"), nil)
+			tt.prepareMockCloudFormationFn(cloudformationMock)
+			tt.prepareMockS3Fn(s3Mock)
+			tt.prepareMockEcrFn(ecrMock)
+
+			cloudformationStackOperator := NewCloudFormationStackOperator(aws.Config{}, cloudformationMock, s3Mock)
+			cloudformationStackOperator.resourceEmptier = NewResourceEmptier(cloudformationMock, NewS3BucketOperator(s3Mock), NewEcrRepositoryOperator(ecrMock))
+
+			if err := cloudformationStackOperator.ResourceEmptier().Preprocess(context.Background(), aws.String("test"), resources); err != nil {
+				t.Fatal(err)
+			}
+
+			err := cloudformationStackOperator.DeleteCloudFormationStack(context.Background(), aws.String("test"), true, operatorManagerMock)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
 func TestCloudFormationStackOperator_deleteStackNormally(t *testing.T) {
 	io.NewLogger(false)
 
@@ -1971,7 +2500,7 @@ func TestCloudFormationStackOperator_deleteStackNormally(t *testing.T) {
 
 			s3Mock := client.NewMockIS3(ctrl)
 			cloudformationStackOperator := NewCloudFormationStackOperator(aws.Config{}, cloudformationMock, s3Mock)
-			cloudformationStackOperator.forceMode = tt.forceMode
+			cloudformationStackOperator.options.ForceMode = tt.forceMode
 
 			got, err := cloudformationStackOperator.deleteStackNormally(tt.args.ctx, tt.args.stackName, tt.args.isRootStack)
 			if (err != nil) != tt.wantErr {
@@ -3311,6 +3840,148 @@ func TestCloudFormationStackOperator_CheckStack(t *testing.T) {
 	}
 }
 
+func TestCloudFormationStackOperator_CheckDeletable(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name                        string
+		isRootStack                 bool
+		forceMode                   bool
+		prepareMockCloudFormationFn func(m *client.MockICloudFormation)
+		want                        error
+		wantErr                     bool
+	}{
+		{
+			name:        "deletable stack",
+			isRootStack: true,
+			forceMode:   false,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "CREATE_COMPLETE",
+							EnableTerminationProtection: aws.Bool(false),
+						},
+					},
+					nil,
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:        "termination protection without force mode",
+			isRootStack: true,
+			forceMode:   false,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "CREATE_COMPLETE",
+							EnableTerminationProtection: aws.Bool(true),
+						},
+					},
+					nil,
+				)
+			},
+			want:    fmt.Errorf("TerminationProtectionError: test"),
+			wantErr: true,
+		},
+		{
+			name:        "termination protection with force mode",
+			isRootStack: true,
+			forceMode:   true,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "CREATE_COMPLETE",
+							EnableTerminationProtection: aws.Bool(true),
+						},
+					},
+					nil,
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:        "operation in progress",
+			isRootStack: true,
+			forceMode:   true,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(
+					[]types.Stack{
+						{
+							StackName:                   aws.String("test"),
+							StackStatus:                 "UPDATE_IN_PROGRESS",
+							EnableTerminationProtection: aws.Bool(false),
+						},
+					},
+					nil,
+				)
+			},
+			want:    fmt.Errorf("OperationInProgressError: Stacks with XxxInProgress cannot be deleted, but UPDATE_IN_PROGRESS: test"),
+			wantErr: true,
+		},
+		{
+			name:        "root stack not found",
+			isRootStack: true,
+			forceMode:   false,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return([]types.Stack{}, nil)
+			},
+			want:    fmt.Errorf("NotExistsError: test not found"),
+			wantErr: true,
+		},
+		{
+			name:        "nested stack not found",
+			isRootStack: false,
+			forceMode:   false,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return([]types.Stack{}, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:        "describe stacks failure",
+			isRootStack: true,
+			forceMode:   false,
+			prepareMockCloudFormationFn: func(m *client.MockICloudFormation) {
+				m.EXPECT().DescribeStacks(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeStacksError"))
+			},
+			want:    fmt.Errorf("DescribeStacksError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cloudformationMock := client.NewMockICloudFormation(ctrl)
+			s3Mock := client.NewMockIS3(ctrl)
+
+			tt.prepareMockCloudFormationFn(cloudformationMock)
+
+			cloudformationStackOperator := NewCloudFormationStackOperator(aws.Config{}, cloudformationMock, s3Mock)
+			cloudformationStackOperator.options.ForceMode = tt.forceMode
+
+			err := cloudformationStackOperator.CheckDeletable(context.Background(), aws.String("test"), tt.isRootStack)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
 func TestCloudFormationStackOperator_RemoveDeletionPolicy(t *testing.T) {
 	io.NewLogger(false)
 
@@ -0,0 +1,149 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*CognitoUserPoolOperator)(nil)
+
+// CognitoUserPoolOperator deletes user pools that fail to delete because a domain (including a
+// custom domain), managed login branding or resource servers were added outside the stack.
+// They are removed first, and then the user pool deletion is retried.
+type CognitoUserPoolOperator struct {
+	client    client.ICognito
+	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewCognitoUserPoolOperator(cognitoClient client.ICognito) *CognitoUserPoolOperator {
+	return &CognitoUserPoolOperator{
+		client:        cognitoClient,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: cognitoUserPoolDomainRetryInterval,
+	}
+}
+
+func (o *CognitoUserPoolOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *CognitoUserPoolOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *CognitoUserPoolOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteUserPool(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *CognitoUserPoolOperator) DeleteUserPool(ctx context.Context, userPoolId *string) error {
+	exists, err := o.client.CheckUserPoolExists(ctx, userPoolId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	userPool, err := o.client.DescribeUserPool(ctx, userPoolId)
+	if err != nil {
+		return err
+	}
+
+	if err := o.deleteManagedLoginBrandings(ctx, userPoolId); err != nil {
+		return err
+	}
+
+	if err := o.deleteResourceServers(ctx, userPoolId); err != nil {
+		return err
+	}
+
+	// A user pool has at most one prefix domain and one custom domain.
+	for _, domain := range []*string{userPool.Domain, userPool.CustomDomain} {
+		if aws.ToString(domain) == "" {
+			continue
+		}
+		if err := deleteCognitoUserPoolDomain(ctx, o.client, domain, o.retryInterval); err != nil {
+			return err
+		}
+	}
+
+	return o.client.DeleteUserPool(ctx, userPoolId)
+}
+
+func (o *CognitoUserPoolOperator) deleteManagedLoginBrandings(ctx context.Context, userPoolId *string) error {
+	userPoolClients, err := o.client.ListUserPoolClients(ctx, userPoolId)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, userPoolClient := range userPoolClients {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			branding, err := o.client.DescribeManagedLoginBrandingByClient(ctx, userPoolId, userPoolClient.ClientId)
+			if err != nil {
+				return err
+			}
+			if branding == nil {
+				return nil
+			}
+			return o.client.DeleteManagedLoginBranding(ctx, userPoolId, branding.ManagedLoginBrandingId)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (o *CognitoUserPoolOperator) deleteResourceServers(ctx context.Context, userPoolId *string) error {
+	resourceServers, err := o.client.ListResourceServers(ctx, userPoolId)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resourceServer := range resourceServers {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.client.DeleteResourceServer(ctx, userPoolId, resourceServer.Identifier)
+		})
+	}
+
+	return eg.Wait()
+}
@@ -0,0 +1,110 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	cognitotypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	cognitoUserPoolDomainRetryInterval = 30 * time.Second
+
+	// cognitoUserPoolDomainMaxRetryCount bounds the wait for a domain deletion (about 30 minutes
+	// with the default interval). A custom domain takes a while because its CloudFront
+	// distribution has to be deleted as well.
+	cognitoUserPoolDomainMaxRetryCount = 60
+)
+
+var _ IOperator = (*CognitoUserPoolDomainOperator)(nil)
+
+// CognitoUserPoolDomainOperator deletes user pool domains, including custom domains, and waits
+// until they are gone so that the user pool can be deleted afterwards.
+type CognitoUserPoolDomainOperator struct {
+	client    client.ICognito
+	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewCognitoUserPoolDomainOperator(cognitoClient client.ICognito) *CognitoUserPoolDomainOperator {
+	return &CognitoUserPoolDomainOperator{
+		client:        cognitoClient,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: cognitoUserPoolDomainRetryInterval,
+	}
+}
+
+func (o *CognitoUserPoolDomainOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *CognitoUserPoolDomainOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *CognitoUserPoolDomainOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteUserPoolDomain(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *CognitoUserPoolDomainOperator) DeleteUserPoolDomain(ctx context.Context, domain *string) error {
+	return deleteCognitoUserPoolDomain(ctx, o.client, domain, o.retryInterval)
+}
+
+// deleteCognitoUserPoolDomain deletes the user pool domain and waits until it no longer exists.
+// It is shared with CognitoUserPoolOperator, which has to remove the domains before the user pool.
+func deleteCognitoUserPoolDomain(ctx context.Context, cognitoClient client.ICognito, domain *string, retryInterval time.Duration) error {
+	description, err := cognitoClient.DescribeUserPoolDomain(ctx, domain)
+	if err != nil {
+		return err
+	}
+	if description == nil {
+		return nil
+	}
+
+	if description.Status != cognitotypes.DomainStatusTypeDeleting {
+		if err := cognitoClient.DeleteUserPoolDomain(ctx, domain, description.UserPoolId); err != nil {
+			// CognitoUserPoolOperator and CognitoUserPoolDomainOperator run concurrently and may
+			// delete the same domain, so the error is ignored if the other one already started it.
+			current, describeErr := cognitoClient.DescribeUserPoolDomain(ctx, domain)
+			if describeErr != nil || (current != nil && current.Status != cognitotypes.DomainStatusTypeDeleting) {
+				return err
+			}
+		}
+	}
+
+	if description.CustomDomainConfig != nil {
+		io.Logger.Info().Msgf("Waiting for the custom domain %s of the user pool %s to be deleted along with its CloudFront distribution.", *domain, aws.ToString(description.UserPoolId))
+	}
+
+	return waitUntil(ctx, domain, retryInterval, cognitoUserPoolDomainMaxRetryCount, func() (bool, error) {
+		description, err := cognitoClient.DescribeUserPoolDomain(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+		return description == nil, nil
+	}, "UserPoolDomainDeletionTimeoutError: the domain is still being deleted")
+}
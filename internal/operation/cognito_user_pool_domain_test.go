@@ -0,0 +1,198 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestCognitoUserPoolDomainOperator_DeleteUserPoolDomain(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockICognito)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete user pool domain successfully",
+			prepareMockFn: func(m *client.MockICognito) {
+				gomock.InOrder(
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeActive,
+					}, nil),
+					m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("test"), aws.String("test")).Return(nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool domain successfully for custom domain",
+			prepareMockFn: func(m *client.MockICognito) {
+				gomock.InOrder(
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeActive,
+						CustomDomainConfig: &types.CustomDomainConfigType{
+							CertificateArn: aws.String("arn"),
+						},
+					}, nil),
+					m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("test"), aws.String("test")).Return(nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool domain successfully for domain not exists",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool domain successfully for domain deleted concurrently",
+			prepareMockFn: func(m *client.MockICognito) {
+				gomock.InOrder(
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeActive,
+					}, nil),
+					m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("test"), aws.String("test")).Return(fmt.Errorf("DeleteUserPoolDomainError")),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeDeleting,
+					}, nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool domain failure for describe user pool domain errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeUserPoolDomainError"))
+			},
+			want:    fmt.Errorf("DescribeUserPoolDomainError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool domain failure for delete user pool domain errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(&types.DomainDescriptionType{
+					UserPoolId: aws.String("test"),
+					Status:     types.DomainStatusTypeActive,
+				}, nil).Times(2)
+				m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("test"), aws.String("test")).Return(fmt.Errorf("DeleteUserPoolDomainError"))
+			},
+			want:    fmt.Errorf("DeleteUserPoolDomainError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool domain failure for timeout",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(&types.DomainDescriptionType{
+					UserPoolId: aws.String("test"),
+					Status:     types.DomainStatusTypeDeleting,
+				}, nil).Times(cognitoUserPoolDomainMaxRetryCount + 2)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("UserPoolDomainDeletionTimeoutError: the domain is still being deleted"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cognitoMock := client.NewMockICognito(ctrl)
+			tt.prepareMockFn(cognitoMock)
+
+			cognitoUserPoolDomainOperator := NewCognitoUserPoolDomainOperator(cognitoMock)
+			cognitoUserPoolDomainOperator.retryInterval = 0
+
+			err := cognitoUserPoolDomainOperator.DeleteUserPoolDomain(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestCognitoUserPoolDomainOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockICognito)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeUserPoolDomainError"))
+			},
+			want:    fmt.Errorf("DescribeUserPoolDomainError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cognitoMock := client.NewMockICognito(ctrl)
+			tt.prepareMockFn(cognitoMock)
+
+			cognitoUserPoolDomainOperator := NewCognitoUserPoolDomainOperator(cognitoMock)
+			cognitoUserPoolDomainOperator.retryInterval = 0
+			cognitoUserPoolDomainOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::Cognito::UserPoolDomain"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := cognitoUserPoolDomainOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestCognitoUserPoolOperator_DeleteUserPool(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockICognito)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete user pool successfully",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{}, nil)
+				m.EXPECT().DeleteUserPool(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool successfully for user pool not exists",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool successfully after deleting managed login brandings",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{
+					{
+						ClientId: aws.String("client1"),
+					},
+					{
+						ClientId: aws.String("client2"),
+					},
+				}, nil)
+				m.EXPECT().DescribeManagedLoginBrandingByClient(gomock.Any(), aws.String("test"), aws.String("client1")).Return(&types.ManagedLoginBrandingType{
+					ManagedLoginBrandingId: aws.String("branding1"),
+				}, nil)
+				m.EXPECT().DescribeManagedLoginBrandingByClient(gomock.Any(), aws.String("test"), aws.String("client2")).Return(nil, nil)
+				m.EXPECT().DeleteManagedLoginBranding(gomock.Any(), aws.String("test"), aws.String("branding1")).Return(nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{}, nil)
+				m.EXPECT().DeleteUserPool(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool successfully after deleting resource servers",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{
+					{
+						Identifier: aws.String("server1"),
+					},
+					{
+						Identifier: aws.String("server2"),
+					},
+				}, nil)
+				m.EXPECT().DeleteResourceServer(gomock.Any(), aws.String("test"), aws.String("server1")).Return(nil)
+				m.EXPECT().DeleteResourceServer(gomock.Any(), aws.String("test"), aws.String("server2")).Return(nil)
+				m.EXPECT().DeleteUserPool(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool successfully after deleting domains",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{
+					Domain:       aws.String("prefix"),
+					CustomDomain: aws.String("auth.example.com"),
+				}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("prefix")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeActive,
+					}, nil),
+					m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("prefix"), aws.String("test")).Return(nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("prefix")).Return(nil, nil),
+				)
+				gomock.InOrder(
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("auth.example.com")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeActive,
+						CustomDomainConfig: &types.CustomDomainConfigType{
+							CertificateArn: aws.String("arn"),
+						},
+					}, nil),
+					m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("auth.example.com"), aws.String("test")).Return(nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("auth.example.com")).Return(nil, nil),
+				)
+				m.EXPECT().DeleteUserPool(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool successfully for domain already being deleted",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{
+					Domain: aws.String("prefix"),
+				}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("prefix")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeDeleting,
+					}, nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("prefix")).Return(&types.DomainDescriptionType{
+						UserPoolId: aws.String("test"),
+						Status:     types.DomainStatusTypeDeleting,
+					}, nil),
+					m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("prefix")).Return(nil, nil),
+				)
+				m.EXPECT().DeleteUserPool(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete user pool failure for check user pool exists errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeUserPoolError"))
+			},
+			want:    fmt.Errorf("DescribeUserPoolError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool failure for describe user pool errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeUserPoolError"))
+			},
+			want:    fmt.Errorf("DescribeUserPoolError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool failure for list user pool clients errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListUserPoolClientsError"))
+			},
+			want:    fmt.Errorf("ListUserPoolClientsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool failure for delete managed login branding errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{
+					{
+						ClientId: aws.String("client1"),
+					},
+				}, nil)
+				m.EXPECT().DescribeManagedLoginBrandingByClient(gomock.Any(), aws.String("test"), aws.String("client1")).Return(&types.ManagedLoginBrandingType{
+					ManagedLoginBrandingId: aws.String("branding1"),
+				}, nil)
+				m.EXPECT().DeleteManagedLoginBranding(gomock.Any(), aws.String("test"), aws.String("branding1")).Return(fmt.Errorf("DeleteManagedLoginBrandingError"))
+			},
+			want:    fmt.Errorf("DeleteManagedLoginBrandingError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool failure for delete resource server errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{
+					{
+						Identifier: aws.String("server1"),
+					},
+				}, nil)
+				m.EXPECT().DeleteResourceServer(gomock.Any(), aws.String("test"), aws.String("server1")).Return(fmt.Errorf("DeleteResourceServerError"))
+			},
+			want:    fmt.Errorf("DeleteResourceServerError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool failure for delete user pool domain errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{
+					Domain: aws.String("prefix"),
+				}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{}, nil)
+				m.EXPECT().DescribeUserPoolDomain(gomock.Any(), aws.String("prefix")).Return(&types.DomainDescriptionType{
+					UserPoolId: aws.String("test"),
+					Status:     types.DomainStatusTypeActive,
+				}, nil).Times(2)
+				m.EXPECT().DeleteUserPoolDomain(gomock.Any(), aws.String("prefix"), aws.String("test")).Return(fmt.Errorf("DeleteUserPoolDomainError"))
+			},
+			want:    fmt.Errorf("DeleteUserPoolDomainError"),
+			wantErr: true,
+		},
+		{
+			name: "delete user pool failure for delete user pool errors",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeUserPool(gomock.Any(), aws.String("test")).Return(&types.UserPoolType{}, nil)
+				m.EXPECT().ListUserPoolClients(gomock.Any(), aws.String("test")).Return([]types.UserPoolClientDescription{}, nil)
+				m.EXPECT().ListResourceServers(gomock.Any(), aws.String("test")).Return([]types.ResourceServerType{}, nil)
+				m.EXPECT().DeleteUserPool(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteUserPoolError"))
+			},
+			want:    fmt.Errorf("DeleteUserPoolError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cognitoMock := client.NewMockICognito(ctrl)
+			tt.prepareMockFn(cognitoMock)
+
+			cognitoUserPoolOperator := NewCognitoUserPoolOperator(cognitoMock)
+			cognitoUserPoolOperator.retryInterval = 0
+
+			err := cognitoUserPoolOperator.DeleteUserPool(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestCognitoUserPoolOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockICognito)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockICognito) {
+				m.EXPECT().CheckUserPoolExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeUserPoolError"))
+			},
+			want:    fmt.Errorf("DescribeUserPoolError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cognitoMock := client.NewMockICognito(ctrl)
+			tt.prepareMockFn(cognitoMock)
+
+			cognitoUserPoolOperator := NewCognitoUserPoolOperator(cognitoMock)
+			cognitoUserPoolOperator.retryInterval = 0
+			cognitoUserPoolOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::Cognito::UserPool"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := cognitoUserPoolOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+// domainNameMapping is a mapping of an API to a custom domain name of API Gateway.
+type domainNameMapping struct {
+	// id identifies the mapping in the domain name: the base path for REST APIs, or the API mapping
+	// ID for HTTP and WebSocket APIs.
+	id *string
+	// description describes the mapping in the logs.
+	description string
+}
+
+// domainNameClient is the part of the API Gateway clients of both versions needed to delete a custom
+// domain name together with the mappings added to APIs outside the stack.
+type domainNameClient interface {
+	CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error)
+	DeleteDomainName(ctx context.Context, domainName *string) error
+	getMappings(ctx context.Context, domainName *string) ([]domainNameMapping, error)
+	deleteMapping(ctx context.Context, domainName *string, mappingId *string) error
+}
+
+// deleteDomainName removes the mappings from the custom domain name, which otherwise fails to be
+// deleted, and then deletes the domain name.
+func deleteDomainName(ctx context.Context, client domainNameClient, domainName *string) error {
+	exists, err := client.CheckDomainNameExists(ctx, domainName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	mappings, err := client.getMappings(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	// Deleted one by one because API Gateway throttles the control plane APIs aggressively.
+	for _, mapping := range mappings {
+		if err := client.deleteMapping(ctx, domainName, mapping.id); err != nil {
+			return err
+		}
+		io.Logger.Info().Msgf("Removed %s from custom domain name %s.", mapping.description, *domainName)
+	}
+
+	return client.DeleteDomainName(ctx, domainName)
+}
@@ -0,0 +1,67 @@
+package operation
+
+import (
+	"fmt"
+)
+
+// domainNameTestCase is a case for deleting a custom domain name, shared by the API Gateway operators
+// of both versions, which prepare the mocks of their clients from it.
+type domainNameTestCase struct {
+	name string
+	// mappingIds are the IDs of the mappings in the domain name, or nil if the domain name does not exist.
+	mappingIds       []string
+	checkExistsErr   error
+	getMappingsErr   error
+	deleteMappingErr error
+	deleteErr        error
+	want             error
+	wantErr          bool
+}
+
+var domainNameTestCases = []domainNameTestCase{
+	{
+		name:       "delete domain name successfully after removing mappings",
+		mappingIds: []string{"MappingId1", "MappingId2"},
+		want:       nil,
+		wantErr:    false,
+	},
+	{
+		name:       "delete domain name successfully without mappings",
+		mappingIds: []string{},
+		want:       nil,
+		wantErr:    false,
+	},
+	{
+		name:       "delete domain name successfully for domain name not exists",
+		mappingIds: nil,
+		want:       nil,
+		wantErr:    false,
+	},
+	{
+		name:           "delete domain name failure for check domain name exists errors",
+		checkExistsErr: fmt.Errorf("GetDomainNameError"),
+		want:           fmt.Errorf("GetDomainNameError"),
+		wantErr:        true,
+	},
+	{
+		name:           "delete domain name failure for get mappings errors",
+		mappingIds:     []string{},
+		getMappingsErr: fmt.Errorf("GetMappingsError"),
+		want:           fmt.Errorf("GetMappingsError"),
+		wantErr:        true,
+	},
+	{
+		name:             "delete domain name failure for delete mapping errors",
+		mappingIds:       []string{"MappingId1", "MappingId2"},
+		deleteMappingErr: fmt.Errorf("DeleteMappingError"),
+		want:             fmt.Errorf("DeleteMappingError"),
+		wantErr:          true,
+	},
+	{
+		name:       "delete domain name failure for delete domain name errors",
+		mappingIds: []string{},
+		deleteErr:  fmt.Errorf("DeleteDomainNameError"),
+		want:       fmt.Errorf("DeleteDomainNameError"),
+		wantErr:    true,
+	},
+}
@@ -0,0 +1,241 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	dynamoDBTableRetryInterval = 10 * time.Second
+
+	// dynamoDBTableMaxRetryCount bounds each wait for a replica removal or the table deletion
+	// (about 10 minutes with the default interval). Removing a replica of a large table can take a while.
+	dynamoDBTableMaxRetryCount = 60
+)
+
+var _ IOperator = (*DynamoDBTableOperator)(nil)
+
+// DynamoDBTableOperator deletes DynamoDB tables with replicas in other regions (global tables
+// version 2019.11.21), including replicas added outside CloudFormation.
+//
+// The replicas are removed one by one from the table in the stack region, waiting for the table
+// to become ACTIVE again after each removal, and then the table itself is deleted. In force mode,
+// deletion protection is disabled on the table and on every replica first.
+type DynamoDBTableOperator struct {
+	client client.IDynamoDB
+	// regionalClientFn returns a client for a replica region, built from the same credentials.
+	regionalClientFn func(region string) client.IDynamoDB
+	region           string
+	resources        []*types.StackResourceSummary
+	forceMode        bool
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+
+	regionalClients map[string]client.IDynamoDB
+	mu              sync.Mutex
+}
+
+func NewDynamoDBTableOperator(
+	dynamoDBClient client.IDynamoDB,
+	regionalClientFn func(region string) client.IDynamoDB,
+	region string,
+) *DynamoDBTableOperator {
+	return &DynamoDBTableOperator{
+		client:           dynamoDBClient,
+		regionalClientFn: regionalClientFn,
+		region:           region,
+		resources:        []*types.StackResourceSummary{},
+		retryInterval:    dynamoDBTableRetryInterval,
+		regionalClients:  map[string]client.IDynamoDB{},
+	}
+}
+
+func (o *DynamoDBTableOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *DynamoDBTableOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *DynamoDBTableOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, table := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteTable(ctx, table.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *DynamoDBTableOperator) DeleteTable(ctx context.Context, tableName *string) error {
+	exists, err := o.client.CheckTableExists(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	table, err := o.client.DescribeTable(ctx, tableName)
+	if err != nil {
+		return err
+	}
+
+	if table.TableStatus != dynamodbtypes.TableStatusDeleting {
+		replicaRegions := o.getReplicaRegions(table)
+
+		if o.forceMode {
+			if err := o.disableDeletionProtection(ctx, tableName, table, replicaRegions); err != nil {
+				return err
+			}
+		}
+
+		for _, replica := range table.Replicas {
+			regionName := aws.ToString(replica.RegionName)
+			if regionName == o.region {
+				continue
+			}
+			if replica.ReplicaStatus != dynamodbtypes.ReplicaStatusDeleting {
+				if err := o.client.DeleteReplica(ctx, tableName, replica.RegionName); err != nil {
+					return err
+				}
+			}
+			if err := o.waitForReplicaDeletion(ctx, tableName, regionName); err != nil {
+				return err
+			}
+			io.Logger.Debug().Msgf("Removed the replica in %s from the table %s", regionName, aws.ToString(tableName))
+		}
+
+		if err := o.client.DeleteTable(ctx, tableName); err != nil {
+			return err
+		}
+	}
+
+	return o.waitForTableDeletion(ctx, tableName)
+}
+
+func (o *DynamoDBTableOperator) getReplicaRegions(table *dynamodbtypes.TableDescription) []string {
+	regions := []string{}
+	for _, replica := range table.Replicas {
+		if aws.ToString(replica.RegionName) == o.region || replica.ReplicaStatus == dynamodbtypes.ReplicaStatusDeleting {
+			continue
+		}
+		regions = append(regions, aws.ToString(replica.RegionName))
+	}
+	return regions
+}
+
+func (o *DynamoDBTableOperator) disableDeletionProtection(
+	ctx context.Context,
+	tableName *string,
+	table *dynamodbtypes.TableDescription,
+	replicaRegions []string,
+) error {
+	if aws.ToBool(table.DeletionProtectionEnabled) {
+		if err := o.client.DisableTableDeletionProtection(ctx, tableName); err != nil {
+			return err
+		}
+		if err := o.waitForTableActive(ctx, o.client, tableName); err != nil {
+			return err
+		}
+	}
+
+	// Deletion protection is a per-replica setting, so it must be checked in each region.
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, region := range replicaRegions {
+		eg.Go(func() error {
+			regionalClient := o.getRegionalClient(region)
+
+			replicaTable, err := regionalClient.DescribeTable(ctx, tableName)
+			if err != nil {
+				return err
+			}
+			if !aws.ToBool(replicaTable.DeletionProtectionEnabled) {
+				return nil
+			}
+
+			if err := regionalClient.DisableTableDeletionProtection(ctx, tableName); err != nil {
+				return err
+			}
+			io.Logger.Debug().Msgf("Disabled deletion protection of the replica in %s for the table %s", region, aws.ToString(tableName))
+
+			return o.waitForTableActive(ctx, regionalClient, tableName)
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (o *DynamoDBTableOperator) getRegionalClient(region string) client.IDynamoDB {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if regionalClient, ok := o.regionalClients[region]; ok {
+		return regionalClient
+	}
+	regionalClient := o.regionalClientFn(region)
+	o.regionalClients[region] = regionalClient
+	return regionalClient
+}
+
+func (o *DynamoDBTableOperator) waitForTableActive(ctx context.Context, dynamoDBClient client.IDynamoDB, tableName *string) error {
+	return waitUntil(ctx, tableName, o.retryInterval, dynamoDBTableMaxRetryCount, func() (bool, error) {
+		table, err := dynamoDBClient.DescribeTable(ctx, tableName)
+		if err != nil {
+			return false, err
+		}
+		return table.TableStatus == dynamodbtypes.TableStatusActive, nil
+	}, "TableUpdateTimeoutError: the table did not become ACTIVE")
+}
+
+// waitForReplicaDeletion waits until the replica disappears from the table and the table is ACTIVE
+// again, because the next replica cannot be removed while the table is being updated.
+func (o *DynamoDBTableOperator) waitForReplicaDeletion(ctx context.Context, tableName *string, regionName string) error {
+	return waitUntil(ctx, tableName, o.retryInterval, dynamoDBTableMaxRetryCount, func() (bool, error) {
+		table, err := o.client.DescribeTable(ctx, tableName)
+		if err != nil {
+			return false, err
+		}
+		if table.TableStatus != dynamodbtypes.TableStatusActive {
+			return false, nil
+		}
+		for _, replica := range table.Replicas {
+			if aws.ToString(replica.RegionName) == regionName {
+				return false, nil
+			}
+		}
+		return true, nil
+	}, fmt.Sprintf("ReplicaDeletionTimeoutError: the replica in %s is still being deleted", regionName))
+}
+
+func (o *DynamoDBTableOperator) waitForTableDeletion(ctx context.Context, tableName *string) error {
+	return waitUntil(ctx, tableName, o.retryInterval, dynamoDBTableMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckTableExists(ctx, tableName)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "TableDeletionTimeoutError: the table is still being deleted")
+}
@@ -0,0 +1,329 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testDynamoDBHomeRegion    = "ap-northeast-1"
+	testDynamoDBReplicaRegion = "us-west-2"
+)
+
+/*
+	Test Cases
+*/
+
+func TestDynamoDBTableOperator_DeleteTable(t *testing.T) {
+	io.NewLogger(false)
+
+	activeTableWithReplica := &types.TableDescription{
+		TableStatus: types.TableStatusActive,
+		Replicas: []types.ReplicaDescription{
+			{
+				RegionName:    aws.String(testDynamoDBHomeRegion),
+				ReplicaStatus: types.ReplicaStatusActive,
+			},
+			{
+				RegionName:    aws.String(testDynamoDBReplicaRegion),
+				ReplicaStatus: types.ReplicaStatusActive,
+			},
+		},
+	}
+	activeTableWithoutReplica := &types.TableDescription{
+		TableStatus: types.TableStatusActive,
+		Replicas: []types.ReplicaDescription{
+			{
+				RegionName:    aws.String(testDynamoDBHomeRegion),
+				ReplicaStatus: types.ReplicaStatusActive,
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		forceMode     bool
+		prepareMockFn func(m *client.MockIDynamoDB, r *client.MockIDynamoDB)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete table successfully for table without replicas",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus: types.TableStatusActive,
+				}, nil)
+				m.EXPECT().DeleteTable(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete table successfully for table not exists",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete table successfully for table already deleting",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus: types.TableStatusDeleting,
+				}, nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete table successfully after removing replicas",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil)
+				m.EXPECT().DeleteReplica(gomock.Any(), aws.String("test"), aws.String(testDynamoDBReplicaRegion)).Return(nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus: types.TableStatusUpdating,
+					Replicas: []types.ReplicaDescription{
+						{
+							RegionName:    aws.String(testDynamoDBReplicaRegion),
+							ReplicaStatus: types.ReplicaStatusDeleting,
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithoutReplica, nil)
+				m.EXPECT().DeleteTable(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete table successfully for replica already deleting",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus: types.TableStatusUpdating,
+					Replicas: []types.ReplicaDescription{
+						{
+							RegionName:    aws.String(testDynamoDBReplicaRegion),
+							ReplicaStatus: types.ReplicaStatusDeleting,
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithoutReplica, nil)
+				m.EXPECT().DeleteTable(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete table successfully after disabling deletion protection of table and replicas in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus:               types.TableStatusActive,
+					DeletionProtectionEnabled: aws.Bool(true),
+					Replicas:                  activeTableWithReplica.Replicas,
+				}, nil)
+				m.EXPECT().DisableTableDeletionProtection(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil)
+				r.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus:               types.TableStatusActive,
+					DeletionProtectionEnabled: aws.Bool(true),
+				}, nil)
+				r.EXPECT().DisableTableDeletionProtection(gomock.Any(), aws.String("test")).Return(nil)
+				r.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus: types.TableStatusActive,
+				}, nil)
+				m.EXPECT().DeleteReplica(gomock.Any(), aws.String("test"), aws.String(testDynamoDBReplicaRegion)).Return(nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithoutReplica, nil)
+				m.EXPECT().DeleteTable(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete table successfully for replicas without deletion protection in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil)
+				r.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus:               types.TableStatusActive,
+					DeletionProtectionEnabled: aws.Bool(false),
+				}, nil)
+				m.EXPECT().DeleteReplica(gomock.Any(), aws.String("test"), aws.String(testDynamoDBReplicaRegion)).Return(nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithoutReplica, nil)
+				m.EXPECT().DeleteTable(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete table failure for disable deletion protection of replica errors in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil)
+				r.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(&types.TableDescription{
+					TableStatus:               types.TableStatusActive,
+					DeletionProtectionEnabled: aws.Bool(true),
+				}, nil)
+				r.EXPECT().DisableTableDeletionProtection(gomock.Any(), aws.String("test")).Return(fmt.Errorf("UpdateTableError"))
+			},
+			want:    fmt.Errorf("UpdateTableError"),
+			wantErr: true,
+		},
+		{
+			name: "delete table failure for check table exists errors",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeTableError"))
+			},
+			want:    fmt.Errorf("DescribeTableError"),
+			wantErr: true,
+		},
+		{
+			name: "delete table failure for describe table errors",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeTableError"))
+			},
+			want:    fmt.Errorf("DescribeTableError"),
+			wantErr: true,
+		},
+		{
+			name: "delete table failure for delete replica errors",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil)
+				m.EXPECT().DeleteReplica(gomock.Any(), aws.String("test"), aws.String(testDynamoDBReplicaRegion)).Return(fmt.Errorf("UpdateTableError"))
+			},
+			want:    fmt.Errorf("UpdateTableError"),
+			wantErr: true,
+		},
+		{
+			name: "delete table failure for replica deletion timeout",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil)
+				m.EXPECT().DeleteReplica(gomock.Any(), aws.String("test"), aws.String(testDynamoDBReplicaRegion)).Return(nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithReplica, nil).Times(dynamoDBTableMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("ReplicaDeletionTimeoutError: the replica in us-west-2 is still being deleted"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete table failure for delete table errors",
+			prepareMockFn: func(m *client.MockIDynamoDB, r *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeTable(gomock.Any(), aws.String("test")).Return(activeTableWithoutReplica, nil)
+				m.EXPECT().DeleteTable(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteTableError"))
+			},
+			want:    fmt.Errorf("DeleteTableError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			dynamoDBMock := client.NewMockIDynamoDB(ctrl)
+			regionalDynamoDBMock := client.NewMockIDynamoDB(ctrl)
+			tt.prepareMockFn(dynamoDBMock, regionalDynamoDBMock)
+
+			regionalClientFn := func(region string) client.IDynamoDB {
+				if region != testDynamoDBReplicaRegion {
+					t.Fatalf("unexpected region: %s", region)
+				}
+				return regionalDynamoDBMock
+			}
+
+			dynamoDBTableOperator := NewDynamoDBTableOperator(dynamoDBMock, regionalClientFn, testDynamoDBHomeRegion)
+			dynamoDBTableOperator.forceMode = tt.forceMode
+			dynamoDBTableOperator.retryInterval = 0
+
+			err := dynamoDBTableOperator.DeleteTable(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestDynamoDBTableOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIDynamoDB)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIDynamoDB) {
+				m.EXPECT().CheckTableExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeTableError"))
+			},
+			want:    fmt.Errorf("DescribeTableError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			dynamoDBMock := client.NewMockIDynamoDB(ctrl)
+			tt.prepareMockFn(dynamoDBMock)
+
+			dynamoDBTableOperator := NewDynamoDBTableOperator(dynamoDBMock, nil, testDynamoDBHomeRegion)
+			dynamoDBTableOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::DynamoDB::GlobalTable"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := dynamoDBTableOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
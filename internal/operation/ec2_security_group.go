@@ -16,12 +16,16 @@ import (
 // for a now-deleted VPC-attached function. The Lambda function itself is already
 // gone, but its ENIs remain in "available" state attached to this security group.
 // This operator first removes those orphan Lambda ENIs, then deletes the security
-// group itself.
+// group itself. With the ENI cleanup policy, the orphan ENIs of the other AWS services
+// it allows are removed as well.
 var _ IOperator = (*EC2SecurityGroupOperator)(nil)
 
 type EC2SecurityGroupOperator struct {
 	client    client.IEC2
 	resources []*types.StackResourceSummary
+	// eniCleanupPolicy allows the orphan ENIs of other AWS services to be deleted too. It is nil
+	// when only the orphan Lambda ENIs are deleted.
+	eniCleanupPolicy *ENICleanupPolicy
 }
 
 func NewEC2SecurityGroupOperator(client client.IEC2) *EC2SecurityGroupOperator {
@@ -58,7 +62,7 @@ func (o *EC2SecurityGroupOperator) DeleteResources(ctx context.Context) error {
 }
 
 func (o *EC2SecurityGroupOperator) DeleteEC2SecurityGroup(ctx context.Context, securityGroupId *string) error {
-	if err := cleanupOrphanLambdaENIsByFilter(ctx, o.client, "group-id", aws.ToString(securityGroupId)); err != nil {
+	if err := cleanupOrphanENIsByFilter(ctx, o.client, o.eniCleanupPolicy, "group-id", aws.ToString(securityGroupId)); err != nil {
 		return err
 	}
 
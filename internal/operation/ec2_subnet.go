@@ -15,12 +15,16 @@ import (
 // AWS Lambda has not yet released the VPC ENIs it provisioned for a now-deleted
 // VPC-attached function. The Lambda function itself is already gone, but its ENIs
 // remain in "available" state and block the subnet deletion. This operator first
-// removes those orphan Lambda ENIs, then deletes the subnet itself.
+// removes those orphan Lambda ENIs, then deletes the subnet itself. With the ENI cleanup policy,
+// the orphan ENIs of the other AWS services it allows are removed as well.
 var _ IOperator = (*EC2SubnetOperator)(nil)
 
 type EC2SubnetOperator struct {
 	client    client.IEC2
 	resources []*types.StackResourceSummary
+	// eniCleanupPolicy allows the orphan ENIs of other AWS services to be deleted too. It is nil
+	// when only the orphan Lambda ENIs are deleted.
+	eniCleanupPolicy *ENICleanupPolicy
 }
 
 func NewEC2SubnetOperator(client client.IEC2) *EC2SubnetOperator {
@@ -57,7 +61,7 @@ func (o *EC2SubnetOperator) DeleteResources(ctx context.Context) error {
 }
 
 func (o *EC2SubnetOperator) DeleteEC2Subnet(ctx context.Context, subnetId *string) error {
-	if err := cleanupOrphanLambdaENIsByFilter(ctx, o.client, "subnet-id", aws.ToString(subnetId)); err != nil {
+	if err := cleanupOrphanENIsByFilter(ctx, o.client, o.eniCleanupPolicy, "subnet-id", aws.ToString(subnetId)); err != nil {
 		return err
 	}
 
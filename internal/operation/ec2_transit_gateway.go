@@ -0,0 +1,243 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	ec2TransitGatewayRetryInterval = 15 * time.Second
+
+	// ec2TransitGatewayMaxRetryCount bounds each wait for the attachments or the transit gateway
+	// to be deleted (about 30 minutes with the default interval).
+	ec2TransitGatewayMaxRetryCount = 120
+)
+
+var _ IOperator = (*EC2TransitGatewayOperator)(nil)
+
+// EC2TransitGatewayOperator deletes transit gateways that cannot be deleted by CloudFormation because
+// attachments or route tables created outside the stack (e.g. by other accounts or stacks) remain.
+//
+// The propagations and associations of the route tables are removed first. Then the attachments are
+// rejected if they are pending acceptance or deleted otherwise, and the non-default route tables are
+// deleted before the transit gateway itself.
+type EC2TransitGatewayOperator struct {
+	client    client.IEC2
+	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewEC2TransitGatewayOperator(ec2Client client.IEC2) *EC2TransitGatewayOperator {
+	return &EC2TransitGatewayOperator{
+		client:        ec2Client,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: ec2TransitGatewayRetryInterval,
+	}
+}
+
+func (o *EC2TransitGatewayOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *EC2TransitGatewayOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *EC2TransitGatewayOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteTransitGateway(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *EC2TransitGatewayOperator) DeleteTransitGateway(ctx context.Context, transitGatewayId *string) error {
+	transitGateway, err := o.client.DescribeTransitGateway(ctx, transitGatewayId)
+	if err != nil {
+		return err
+	}
+	if transitGateway == nil || transitGateway.State == ec2types.TransitGatewayStateDeleted {
+		return nil
+	}
+
+	if transitGateway.State != ec2types.TransitGatewayStateDeleting {
+		routeTables, err := o.client.DescribeTransitGatewayRouteTables(ctx, transitGatewayId)
+		if err != nil {
+			return err
+		}
+
+		for _, routeTable := range routeTables {
+			if routeTable.State == ec2types.TransitGatewayRouteTableStateDeleting ||
+				routeTable.State == ec2types.TransitGatewayRouteTableStateDeleted {
+				continue
+			}
+			if err := o.clearRouteTable(ctx, transitGatewayId, routeTable.TransitGatewayRouteTableId); err != nil {
+				return err
+			}
+		}
+
+		if err := o.deleteAttachments(ctx, transitGatewayId); err != nil {
+			return err
+		}
+
+		for _, routeTable := range routeTables {
+			// The default route table is deleted along with the transit gateway.
+			if aws.ToBool(routeTable.DefaultAssociationRouteTable) ||
+				routeTable.State == ec2types.TransitGatewayRouteTableStateDeleting ||
+				routeTable.State == ec2types.TransitGatewayRouteTableStateDeleted {
+				continue
+			}
+			if err := o.client.DeleteTransitGatewayRouteTable(ctx, routeTable.TransitGatewayRouteTableId); err != nil {
+				return err
+			}
+			io.Logger.Info().Msgf("Deleted route table %s of transit gateway %s.", aws.ToString(routeTable.TransitGatewayRouteTableId), *transitGatewayId)
+		}
+
+		if err := o.client.DeleteTransitGateway(ctx, transitGatewayId); err != nil {
+			return err
+		}
+	}
+
+	return waitUntil(ctx, transitGatewayId, o.retryInterval, ec2TransitGatewayMaxRetryCount, func() (bool, error) {
+		transitGateway, err := o.client.DescribeTransitGateway(ctx, transitGatewayId)
+		if err != nil {
+			return false, err
+		}
+		return transitGateway == nil || transitGateway.State == ec2types.TransitGatewayStateDeleted, nil
+	}, "TransitGatewayDeletionTimeoutError: the transit gateway is still being deleted")
+}
+
+// clearRouteTable disables the propagations and removes the associations of the route table, so that
+// the attachments and the route table itself can be deleted.
+func (o *EC2TransitGatewayOperator) clearRouteTable(ctx context.Context, transitGatewayId *string, routeTableId *string) error {
+	propagations, err := o.client.GetTransitGatewayRouteTablePropagations(ctx, routeTableId)
+	if err != nil {
+		return err
+	}
+	for _, propagation := range propagations {
+		if propagation.State != ec2types.TransitGatewayPropagationStateEnabled {
+			continue
+		}
+		if err := o.client.DisableTransitGatewayRouteTablePropagation(ctx, routeTableId, propagation.TransitGatewayAttachmentId); err != nil {
+			return err
+		}
+		io.Logger.Info().Msgf("Disabled propagation of attachment %s to route table %s of transit gateway %s.", aws.ToString(propagation.TransitGatewayAttachmentId), *routeTableId, *transitGatewayId)
+	}
+
+	associations, err := o.client.GetTransitGatewayRouteTableAssociations(ctx, routeTableId)
+	if err != nil {
+		return err
+	}
+	for _, association := range associations {
+		if association.State != ec2types.TransitGatewayAssociationStateAssociated {
+			continue
+		}
+		if err := o.client.DisassociateTransitGatewayRouteTable(ctx, routeTableId, association.TransitGatewayAttachmentId); err != nil {
+			return err
+		}
+		io.Logger.Info().Msgf("Disassociated attachment %s from route table %s of transit gateway %s.", aws.ToString(association.TransitGatewayAttachmentId), *routeTableId, *transitGatewayId)
+	}
+
+	return nil
+}
+
+// deleteAttachments rejects the attachments pending acceptance and deletes the others, then waits
+// until all of them are gone.
+func (o *EC2TransitGatewayOperator) deleteAttachments(ctx context.Context, transitGatewayId *string) error {
+	attachments, err := o.client.DescribeTransitGatewayAttachments(ctx, transitGatewayId)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		attachmentId := attachment.TransitGatewayAttachmentId
+
+		switch attachment.State {
+		case ec2types.TransitGatewayAttachmentStatePendingAcceptance:
+			if err := o.rejectAttachment(ctx, transitGatewayId, attachment); err != nil {
+				return err
+			}
+			io.Logger.Info().Msgf("Rejected %s attachment %s of transit gateway %s.", attachment.ResourceType, aws.ToString(attachmentId), *transitGatewayId)
+		case ec2types.TransitGatewayAttachmentStateAvailable,
+			ec2types.TransitGatewayAttachmentStatePending,
+			ec2types.TransitGatewayAttachmentStateModifying:
+			if err := o.deleteAttachment(ctx, transitGatewayId, attachment); err != nil {
+				return err
+			}
+			io.Logger.Info().Msgf("Deleted %s attachment %s of transit gateway %s.", attachment.ResourceType, aws.ToString(attachmentId), *transitGatewayId)
+		}
+	}
+
+	return waitUntil(ctx, transitGatewayId, o.retryInterval, ec2TransitGatewayMaxRetryCount, func() (bool, error) {
+		attachments, err := o.client.DescribeTransitGatewayAttachments(ctx, transitGatewayId)
+		if err != nil {
+			return false, err
+		}
+		for _, attachment := range attachments {
+			switch attachment.State {
+			case ec2types.TransitGatewayAttachmentStateDeleted,
+				ec2types.TransitGatewayAttachmentStateRejected,
+				ec2types.TransitGatewayAttachmentStateFailed:
+			default:
+				return false, nil
+			}
+		}
+		return true, nil
+	}, "TransitGatewayAttachmentDeletionTimeoutError: the attachments of the transit gateway are still being deleted")
+}
+
+func (o *EC2TransitGatewayOperator) rejectAttachment(ctx context.Context, transitGatewayId *string, attachment ec2types.TransitGatewayAttachment) error {
+	switch attachment.ResourceType {
+	case ec2types.TransitGatewayAttachmentResourceTypeVpc:
+		return o.client.RejectTransitGatewayVpcAttachment(ctx, attachment.TransitGatewayAttachmentId)
+	case ec2types.TransitGatewayAttachmentResourceTypePeering:
+		return o.client.RejectTransitGatewayPeeringAttachment(ctx, attachment.TransitGatewayAttachmentId)
+	default:
+		return o.unsupportedAttachmentError(transitGatewayId, attachment)
+	}
+}
+
+func (o *EC2TransitGatewayOperator) deleteAttachment(ctx context.Context, transitGatewayId *string, attachment ec2types.TransitGatewayAttachment) error {
+	switch attachment.ResourceType {
+	case ec2types.TransitGatewayAttachmentResourceTypeVpc:
+		return o.client.DeleteTransitGatewayVpcAttachment(ctx, attachment.TransitGatewayAttachmentId)
+	case ec2types.TransitGatewayAttachmentResourceTypePeering:
+		return o.client.DeleteTransitGatewayPeeringAttachment(ctx, attachment.TransitGatewayAttachmentId)
+	default:
+		return o.unsupportedAttachmentError(transitGatewayId, attachment)
+	}
+}
+
+func (o *EC2TransitGatewayOperator) unsupportedAttachmentError(transitGatewayId *string, attachment ec2types.TransitGatewayAttachment) error {
+	return &client.ClientError{
+		ResourceName: transitGatewayId,
+		Err: fmt.Errorf(
+			"UnsupportedAttachmentError: the %s attachment %s cannot be deleted by delstack, delete it manually",
+			attachment.ResourceType,
+			aws.ToString(attachment.TransitGatewayAttachmentId),
+		),
+	}
+}
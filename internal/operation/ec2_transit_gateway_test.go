@@ -0,0 +1,315 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestEC2TransitGatewayOperator_DeleteTransitGateway(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIEC2)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete transit gateway successfully",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateAvailable,
+				}, nil)
+				m.EXPECT().DescribeTransitGatewayRouteTables(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayRouteTable{
+					{
+						TransitGatewayRouteTableId:   aws.String("tgw-rtb-1"),
+						DefaultAssociationRouteTable: aws.Bool(true),
+						State:                        types.TransitGatewayRouteTableStateAvailable,
+					},
+					{
+						TransitGatewayRouteTableId:   aws.String("tgw-rtb-2"),
+						DefaultAssociationRouteTable: aws.Bool(false),
+						State:                        types.TransitGatewayRouteTableStateAvailable,
+					},
+				}, nil)
+				m.EXPECT().GetTransitGatewayRouteTablePropagations(gomock.Any(), aws.String("tgw-rtb-1")).Return([]types.TransitGatewayRouteTablePropagation{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						State:                      types.TransitGatewayPropagationStateEnabled,
+					},
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-3"),
+						State:                      types.TransitGatewayPropagationStateDisabled,
+					},
+				}, nil)
+				m.EXPECT().DisableTransitGatewayRouteTablePropagation(gomock.Any(), aws.String("tgw-rtb-1"), aws.String("tgw-attach-1")).Return(nil)
+				m.EXPECT().GetTransitGatewayRouteTableAssociations(gomock.Any(), aws.String("tgw-rtb-1")).Return([]types.TransitGatewayRouteTableAssociation{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						State:                      types.TransitGatewayAssociationStateAssociated,
+					},
+				}, nil)
+				m.EXPECT().DisassociateTransitGatewayRouteTable(gomock.Any(), aws.String("tgw-rtb-1"), aws.String("tgw-attach-1")).Return(nil)
+				m.EXPECT().GetTransitGatewayRouteTablePropagations(gomock.Any(), aws.String("tgw-rtb-2")).Return([]types.TransitGatewayRouteTablePropagation{}, nil)
+				m.EXPECT().GetTransitGatewayRouteTableAssociations(gomock.Any(), aws.String("tgw-rtb-2")).Return([]types.TransitGatewayRouteTableAssociation{}, nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+						State:                      types.TransitGatewayAttachmentStateAvailable,
+					},
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypePeering,
+						State:                      types.TransitGatewayAttachmentStatePendingAcceptance,
+					},
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-3"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+						State:                      types.TransitGatewayAttachmentStateDeleted,
+					},
+				}, nil)
+				m.EXPECT().DeleteTransitGatewayVpcAttachment(gomock.Any(), aws.String("tgw-attach-1")).Return(nil)
+				m.EXPECT().RejectTransitGatewayPeeringAttachment(gomock.Any(), aws.String("tgw-attach-2")).Return(nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+						State:                      types.TransitGatewayAttachmentStateDeleting,
+					},
+				}, nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+						State:                      types.TransitGatewayAttachmentStateDeleted,
+					},
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypePeering,
+						State:                      types.TransitGatewayAttachmentStateRejected,
+					},
+				}, nil)
+				m.EXPECT().DeleteTransitGatewayRouteTable(gomock.Any(), aws.String("tgw-rtb-2")).Return(nil)
+				m.EXPECT().DeleteTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(nil)
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateDeleting,
+				}, nil)
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(nil, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway successfully for not found",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(nil, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway successfully for already deleted",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateDeleted,
+				}, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway successfully for already deleting",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateDeleting,
+				}, nil)
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateDeleted,
+				}, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway failure for describe transit gateway errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(nil, fmt.Errorf("DescribeTransitGatewayError"))
+			},
+			want:    fmt.Errorf("DescribeTransitGatewayError"),
+			wantErr: true,
+		},
+		{
+			name: "delete transit gateway failure for disable propagation errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateAvailable,
+				}, nil)
+				m.EXPECT().DescribeTransitGatewayRouteTables(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayRouteTable{
+					{
+						TransitGatewayRouteTableId: aws.String("tgw-rtb-1"),
+						State:                      types.TransitGatewayRouteTableStateAvailable,
+					},
+				}, nil)
+				m.EXPECT().GetTransitGatewayRouteTablePropagations(gomock.Any(), aws.String("tgw-rtb-1")).Return([]types.TransitGatewayRouteTablePropagation{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						State:                      types.TransitGatewayPropagationStateEnabled,
+					},
+				}, nil)
+				m.EXPECT().DisableTransitGatewayRouteTablePropagation(gomock.Any(), aws.String("tgw-rtb-1"), aws.String("tgw-attach-1")).Return(fmt.Errorf("DisableTransitGatewayRouteTablePropagationError"))
+			},
+			want:    fmt.Errorf("DisableTransitGatewayRouteTablePropagationError"),
+			wantErr: true,
+		},
+		{
+			name: "delete transit gateway failure for unsupported attachments",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateAvailable,
+				}, nil)
+				m.EXPECT().DescribeTransitGatewayRouteTables(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayRouteTable{}, nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpn,
+						State:                      types.TransitGatewayAttachmentStateAvailable,
+					},
+				}, nil)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("tgw-0123456789abcdef0"),
+				Err:          fmt.Errorf("UnsupportedAttachmentError: the vpn attachment tgw-attach-1 cannot be deleted by delstack, delete it manually"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete transit gateway failure for delete attachment errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateAvailable,
+				}, nil)
+				m.EXPECT().DescribeTransitGatewayRouteTables(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayRouteTable{}, nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{
+					{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypePeering,
+						State:                      types.TransitGatewayAttachmentStateAvailable,
+					},
+				}, nil)
+				m.EXPECT().DeleteTransitGatewayPeeringAttachment(gomock.Any(), aws.String("tgw-attach-1")).Return(fmt.Errorf("DeleteTransitGatewayPeeringAttachmentError"))
+			},
+			want:    fmt.Errorf("DeleteTransitGatewayPeeringAttachmentError"),
+			wantErr: true,
+		},
+		{
+			name: "delete transit gateway failure for delete transit gateway errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(&types.TransitGateway{
+					TransitGatewayId: aws.String("tgw-0123456789abcdef0"),
+					State:            types.TransitGatewayStateAvailable,
+				}, nil)
+				m.EXPECT().DescribeTransitGatewayRouteTables(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayRouteTable{}, nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{}, nil)
+				m.EXPECT().DescribeTransitGatewayAttachments(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return([]types.TransitGatewayAttachment{}, nil)
+				m.EXPECT().DeleteTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(fmt.Errorf("DeleteTransitGatewayError"))
+			},
+			want:    fmt.Errorf("DeleteTransitGatewayError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ec2Mock := client.NewMockIEC2(ctrl)
+			tt.prepareMockFn(ec2Mock)
+
+			eC2TransitGatewayOperator := NewEC2TransitGatewayOperator(ec2Mock)
+			eC2TransitGatewayOperator.retryInterval = 0
+
+			err := eC2TransitGatewayOperator.DeleteTransitGateway(context.Background(), aws.String("tgw-0123456789abcdef0"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestEC2TransitGatewayOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIEC2)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(nil, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeTransitGateway(gomock.Any(), aws.String("tgw-0123456789abcdef0")).Return(nil, fmt.Errorf("DescribeTransitGatewayError"))
+			},
+			want:    fmt.Errorf("DescribeTransitGatewayError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ec2Mock := client.NewMockIEC2(ctrl)
+			tt.prepareMockFn(ec2Mock)
+
+			eC2TransitGatewayOperator := NewEC2TransitGatewayOperator(ec2Mock)
+			eC2TransitGatewayOperator.retryInterval = 0
+			eC2TransitGatewayOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::EC2::TransitGateway"),
+				PhysicalResourceId: aws.String("tgw-0123456789abcdef0"),
+			})
+
+			err := eC2TransitGatewayOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
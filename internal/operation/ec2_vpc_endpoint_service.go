@@ -0,0 +1,92 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// EC2VPCEndpointServiceOperator deletes VPC endpoint services (PrivateLink) that cannot be deleted by
+// CloudFormation because endpoints in other VPCs or accounts are still connected. The active and
+// pending endpoint connections are rejected before the service configuration is deleted.
+var _ IOperator = (*EC2VPCEndpointServiceOperator)(nil)
+
+type EC2VPCEndpointServiceOperator struct {
+	client    client.IEC2
+	resources []*types.StackResourceSummary
+}
+
+func NewEC2VPCEndpointServiceOperator(ec2Client client.IEC2) *EC2VPCEndpointServiceOperator {
+	return &EC2VPCEndpointServiceOperator{
+		client:    ec2Client,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *EC2VPCEndpointServiceOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *EC2VPCEndpointServiceOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *EC2VPCEndpointServiceOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteVPCEndpointService(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *EC2VPCEndpointServiceOperator) DeleteVPCEndpointService(ctx context.Context, serviceId *string) error {
+	exists, err := o.client.CheckVpcEndpointServiceExists(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	connections, err := o.client.DescribeVpcEndpointConnections(ctx, serviceId)
+	if err != nil {
+		return err
+	}
+
+	vpcEndpointIds := []string{}
+	for _, connection := range connections {
+		switch connection.VpcEndpointState {
+		case ec2types.StatePendingAcceptance, ec2types.StateAvailable:
+			vpcEndpointIds = append(vpcEndpointIds, aws.ToString(connection.VpcEndpointId))
+		}
+	}
+
+	if len(vpcEndpointIds) > 0 {
+		if err := o.client.RejectVpcEndpointConnections(ctx, serviceId, vpcEndpointIds); err != nil {
+			return err
+		}
+		for _, vpcEndpointId := range vpcEndpointIds {
+			io.Logger.Info().Msgf("Rejected connection from VPC endpoint %s to endpoint service %s.", vpcEndpointId, *serviceId)
+		}
+	}
+
+	return o.client.DeleteVpcEndpointServiceConfiguration(ctx, serviceId)
+}
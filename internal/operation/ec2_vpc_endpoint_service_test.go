@@ -0,0 +1,186 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestEC2VPCEndpointServiceOperator_DeleteVPCEndpointService(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIEC2)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete vpc endpoint service successfully",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(true, nil)
+				m.EXPECT().DescribeVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return([]types.VpcEndpointConnection{
+					{
+						VpcEndpointId:    aws.String("vpce-1"),
+						VpcEndpointState: types.StateAvailable,
+					},
+					{
+						VpcEndpointId:    aws.String("vpce-2"),
+						VpcEndpointState: types.StatePendingAcceptance,
+					},
+					{
+						VpcEndpointId:    aws.String("vpce-3"),
+						VpcEndpointState: types.StateRejected,
+					},
+				}, nil)
+				m.EXPECT().RejectVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0"), []string{"vpce-1", "vpce-2"}).Return(nil)
+				m.EXPECT().DeleteVpcEndpointServiceConfiguration(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete vpc endpoint service successfully for no connections",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(true, nil)
+				m.EXPECT().DescribeVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return([]types.VpcEndpointConnection{}, nil)
+				m.EXPECT().DeleteVpcEndpointServiceConfiguration(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete vpc endpoint service successfully for not found",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete vpc endpoint service failure for check vpc endpoint service exists errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(false, fmt.Errorf("CheckVpcEndpointServiceExistsError"))
+			},
+			want:    fmt.Errorf("CheckVpcEndpointServiceExistsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete vpc endpoint service failure for describe vpc endpoint connections errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(true, nil)
+				m.EXPECT().DescribeVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(nil, fmt.Errorf("DescribeVpcEndpointConnectionsError"))
+			},
+			want:    fmt.Errorf("DescribeVpcEndpointConnectionsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete vpc endpoint service failure for reject vpc endpoint connections errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(true, nil)
+				m.EXPECT().DescribeVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return([]types.VpcEndpointConnection{
+					{
+						VpcEndpointId:    aws.String("vpce-1"),
+						VpcEndpointState: types.StateAvailable,
+					},
+				}, nil)
+				m.EXPECT().RejectVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0"), []string{"vpce-1"}).Return(fmt.Errorf("RejectVpcEndpointConnectionsError"))
+			},
+			want:    fmt.Errorf("RejectVpcEndpointConnectionsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete vpc endpoint service failure for delete vpc endpoint service configuration errors",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(true, nil)
+				m.EXPECT().DescribeVpcEndpointConnections(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return([]types.VpcEndpointConnection{}, nil)
+				m.EXPECT().DeleteVpcEndpointServiceConfiguration(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(fmt.Errorf("DeleteVpcEndpointServiceConfigurationError"))
+			},
+			want:    fmt.Errorf("DeleteVpcEndpointServiceConfigurationError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ec2Mock := client.NewMockIEC2(ctrl)
+			tt.prepareMockFn(ec2Mock)
+
+			eC2VPCEndpointServiceOperator := NewEC2VPCEndpointServiceOperator(ec2Mock)
+
+			err := eC2VPCEndpointServiceOperator.DeleteVPCEndpointService(context.Background(), aws.String("vpce-svc-0123456789abcdef0"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestEC2VPCEndpointServiceOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIEC2)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().CheckVpcEndpointServiceExists(gomock.Any(), aws.String("vpce-svc-0123456789abcdef0")).Return(false, fmt.Errorf("CheckVpcEndpointServiceExistsError"))
+			},
+			want:    fmt.Errorf("CheckVpcEndpointServiceExistsError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ec2Mock := client.NewMockIEC2(ctrl)
+			tt.prepareMockFn(ec2Mock)
+
+			eC2VPCEndpointServiceOperator := NewEC2VPCEndpointServiceOperator(ec2Mock)
+			eC2VPCEndpointServiceOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::EC2::VPCEndpointService"),
+				PhysicalResourceId: aws.String("vpce-svc-0123456789abcdef0"),
+			})
+
+			err := eC2VPCEndpointServiceOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*EcrPublicRepositoryOperator)(nil)
+
+type EcrPublicRepositoryOperator struct {
+	client    client.IEcrPublic
+	resources []*types.StackResourceSummary
+}
+
+func NewEcrPublicRepositoryOperator(client client.IEcrPublic) *EcrPublicRepositoryOperator {
+	return &EcrPublicRepositoryOperator{
+		client:    client,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *EcrPublicRepositoryOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *EcrPublicRepositoryOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *EcrPublicRepositoryOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, repository := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteEcrPublicRepository(ctx, repository.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *EcrPublicRepositoryOperator) DeleteEcrPublicRepository(ctx context.Context, repositoryName *string) error {
+	exists, err := o.client.CheckEcrPublicExists(ctx, repositoryName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	return o.client.DeleteRepository(ctx, repositoryName)
+}
@@ -62,3 +62,24 @@ func (o *EcrRepositoryOperator) DeleteEcrRepository(ctx context.Context, reposit
 
 	return o.client.DeleteRepository(ctx, repositoryName)
 }
+
+// EmptyEcrRepository deletes all the images in the repository, keeping the repository itself.
+func (o *EcrRepositoryOperator) EmptyEcrRepository(ctx context.Context, repositoryName *string) error {
+	exists, err := o.client.CheckEcrExists(ctx, repositoryName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	imageIds, err := o.client.ListImageIds(ctx, repositoryName)
+	if err != nil {
+		return err
+	}
+	if len(imageIds) == 0 {
+		return nil
+	}
+
+	return o.client.BatchDeleteImages(ctx, repositoryName, imageIds)
+}
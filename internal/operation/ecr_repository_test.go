@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/go-to-k/delstack/internal/io"
 	"github.com/go-to-k/delstack/pkg/client"
 	gomock "go.uber.org/mock/gomock"
@@ -169,3 +170,114 @@ func TestEcrRepositoryOperator_DeleteResourcesForEcrRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestEcrRepositoryOperator_EmptyEcrRepository(t *testing.T) {
+	io.NewLogger(false)
+
+	type args struct {
+		ctx            context.Context
+		repositoryName *string
+	}
+
+	imageIds := []ecrtypes.ImageIdentifier{
+		{
+			ImageDigest: aws.String("sha256:1"),
+			ImageTag:    aws.String("latest"),
+		},
+	}
+
+	cases := []struct {
+		name          string
+		args          args
+		prepareMockFn func(m *client.MockIEcr)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "empty ecr repository successfully",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIEcr) {
+				m.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListImageIds(gomock.Any(), aws.String("test")).Return(imageIds, nil)
+				m.EXPECT().BatchDeleteImages(gomock.Any(), aws.String("test"), imageIds).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "empty ecr repository successfully for no images",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIEcr) {
+				m.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListImageIds(gomock.Any(), aws.String("test")).Return([]ecrtypes.ImageIdentifier{}, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "empty ecr repository successfully for ecr repository not exists",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIEcr) {
+				m.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "empty ecr repository failure for list images errors",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIEcr) {
+				m.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListImageIds(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListImagesError"))
+			},
+			want:    fmt.Errorf("ListImagesError"),
+			wantErr: true,
+		},
+		{
+			name: "empty ecr repository failure for batch delete images errors",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+			},
+			prepareMockFn: func(m *client.MockIEcr) {
+				m.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListImageIds(gomock.Any(), aws.String("test")).Return(imageIds, nil)
+				m.EXPECT().BatchDeleteImages(gomock.Any(), aws.String("test"), imageIds).Return(fmt.Errorf("BatchDeleteImageError"))
+			},
+			want:    fmt.Errorf("BatchDeleteImageError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ecrMock := client.NewMockIEcr(ctrl)
+			tt.prepareMockFn(ecrMock)
+
+			ecrRepositoryOperator := NewEcrRepositoryOperator(ecrMock)
+
+			err := ecrRepositoryOperator.EmptyEcrRepository(tt.args.ctx, tt.args.repositoryName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	elastiCacheRetryInterval = 15 * time.Second
+
+	// elastiCacheMaxRetryCount bounds each wait for a modification or the deletion
+	// (about 30 minutes with the default interval). Leaving a global datastore can take a while.
+	elastiCacheMaxRetryCount = 120
+
+	elastiCacheStatusAvailable = "available"
+	elastiCacheStatusDeleting  = "deleting"
+
+	elastiCacheGlobalReplicationGroupRolePrimary = "PRIMARY"
+)
+
+var _ IOperator = (*ElastiCacheReplicationGroupOperator)(nil)
+
+// ElastiCacheReplicationGroupOperator deletes ElastiCache replication groups that cannot be deleted
+// by CloudFormation because they became a member of a global datastore or have user groups attached
+// outside the stack.
+//
+// The replication group is disassociated from the global datastore (or the datastore is deleted while
+// retaining the group when it is the primary or the only member), the user groups are removed, and then
+// the group is deleted. A final snapshot is taken only when requested.
+type ElastiCacheReplicationGroupOperator struct {
+	client client.IElastiCache
+	// regionalClientFn returns a client for a secondary region, built from the same credentials.
+	regionalClientFn func(region string) client.IElastiCache
+	region           string
+	resources        []*types.StackResourceSummary
+	finalSnapshot    bool
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+
+	mu              sync.Mutex
+	regionalClients map[string]client.IElastiCache
+}
+
+func NewElastiCacheReplicationGroupOperator(
+	elastiCacheClient client.IElastiCache,
+	regionalClientFn func(region string) client.IElastiCache,
+	region string,
+) *ElastiCacheReplicationGroupOperator {
+	return &ElastiCacheReplicationGroupOperator{
+		client:           elastiCacheClient,
+		regionalClientFn: regionalClientFn,
+		region:           region,
+		resources:        []*types.StackResourceSummary{},
+		retryInterval:    elastiCacheRetryInterval,
+		regionalClients:  map[string]client.IElastiCache{},
+	}
+}
+
+func (o *ElastiCacheReplicationGroupOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *ElastiCacheReplicationGroupOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *ElastiCacheReplicationGroupOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, replicationGroup := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteReplicationGroup(ctx, replicationGroup.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *ElastiCacheReplicationGroupOperator) DeleteReplicationGroup(ctx context.Context, replicationGroupId *string) error {
+	exists, err := o.client.CheckReplicationGroupExists(ctx, replicationGroupId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	replicationGroup, err := o.client.DescribeReplicationGroup(ctx, replicationGroupId)
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(replicationGroup.Status) != elastiCacheStatusDeleting {
+		if replicationGroup.GlobalReplicationGroupInfo != nil && replicationGroup.GlobalReplicationGroupInfo.GlobalReplicationGroupId != nil {
+			if err := o.leaveGlobalReplicationGroup(ctx, replicationGroupId, replicationGroup.GlobalReplicationGroupInfo.GlobalReplicationGroupId); err != nil {
+				return err
+			}
+		}
+
+		if len(replicationGroup.UserGroupIds) > 0 {
+			if err := o.client.RemoveReplicationGroupUserGroups(ctx, replicationGroupId, replicationGroup.UserGroupIds); err != nil {
+				return err
+			}
+			if err := o.waitForAvailable(ctx, replicationGroupId); err != nil {
+				return err
+			}
+			io.Logger.Debug().Msgf("Removed the user groups from the replication group %s", aws.ToString(replicationGroupId))
+		}
+
+		var finalSnapshotIdentifier *string
+		if o.finalSnapshot {
+			finalSnapshotIdentifier = aws.String(fmt.Sprintf("%s-final-%s", aws.ToString(replicationGroupId), time.Now().Format("20060102150405")))
+		}
+		if err := o.client.DeleteReplicationGroup(ctx, replicationGroupId, finalSnapshotIdentifier); err != nil {
+			return err
+		}
+	}
+
+	return waitUntil(ctx, replicationGroupId, o.retryInterval, elastiCacheMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckReplicationGroupExists(ctx, replicationGroupId)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "ReplicationGroupDeletionTimeoutError: the replication group is still being deleted")
+}
+
+// leaveGlobalReplicationGroup removes the replication group from the global datastore. Neither the
+// primary nor the last member can be disassociated, so the global datastore itself is deleted while
+// retaining the replication group. For a primary, the secondary members are disassociated first, which
+// makes them standalone replication groups in their regions.
+func (o *ElastiCacheReplicationGroupOperator) leaveGlobalReplicationGroup(ctx context.Context, replicationGroupId *string, globalReplicationGroupId *string) error {
+	globalReplicationGroup, err := o.client.DescribeGlobalReplicationGroup(ctx, globalReplicationGroupId)
+	if err != nil {
+		return err
+	}
+
+	isPrimary := false
+	for _, member := range globalReplicationGroup.Members {
+		if aws.ToString(member.ReplicationGroupId) == aws.ToString(replicationGroupId) {
+			isPrimary = aws.ToString(member.Role) == elastiCacheGlobalReplicationGroupRolePrimary
+		}
+	}
+
+	switch {
+	case isPrimary:
+		if err := o.disassociateSecondaries(ctx, replicationGroupId, globalReplicationGroupId, globalReplicationGroup.Members); err != nil {
+			return err
+		}
+		err = o.client.DeleteGlobalReplicationGroup(ctx, globalReplicationGroupId)
+	case len(globalReplicationGroup.Members) > 1:
+		err = o.client.DisassociateGlobalReplicationGroup(ctx, globalReplicationGroupId, replicationGroupId, aws.String(o.region))
+	default:
+		err = o.client.DeleteGlobalReplicationGroup(ctx, globalReplicationGroupId)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := waitUntil(ctx, replicationGroupId, o.retryInterval, elastiCacheMaxRetryCount, func() (bool, error) {
+		replicationGroup, err := o.client.DescribeReplicationGroup(ctx, replicationGroupId)
+		if err != nil {
+			return false, err
+		}
+		return replicationGroup.GlobalReplicationGroupInfo == nil ||
+			replicationGroup.GlobalReplicationGroupInfo.GlobalReplicationGroupId == nil, nil
+	}, "GlobalReplicationGroupDisassociationTimeoutError: the replication group is still a member of the global datastore"); err != nil {
+		return err
+	}
+
+	io.Logger.Debug().Msgf("Removed the replication group %s from the global datastore %s", aws.ToString(replicationGroupId), aws.ToString(globalReplicationGroupId))
+
+	return o.waitForAvailable(ctx, replicationGroupId)
+}
+
+// disassociateSecondaries disassociates the other members of the global datastore through the clients
+// for their regions, and waits until the primary is the only member left.
+func (o *ElastiCacheReplicationGroupOperator) disassociateSecondaries(
+	ctx context.Context,
+	replicationGroupId *string,
+	globalReplicationGroupId *string,
+	members []elasticachetypes.GlobalReplicationGroupMember,
+) error {
+	hasSecondaries := false
+	for _, member := range members {
+		if aws.ToString(member.ReplicationGroupId) == aws.ToString(replicationGroupId) {
+			continue
+		}
+		hasSecondaries = true
+
+		regionalClient := o.getRegionalClient(aws.ToString(member.ReplicationGroupRegion))
+		if err := regionalClient.DisassociateGlobalReplicationGroup(ctx, globalReplicationGroupId, member.ReplicationGroupId, member.ReplicationGroupRegion); err != nil {
+			return err
+		}
+		io.Logger.Info().Msgf("Disassociated the replication group %s in %s from the global datastore %s, and it is now a standalone replication group.",
+			aws.ToString(member.ReplicationGroupId), aws.ToString(member.ReplicationGroupRegion), aws.ToString(globalReplicationGroupId))
+	}
+	if !hasSecondaries {
+		return nil
+	}
+
+	return waitUntil(ctx, replicationGroupId, o.retryInterval, elastiCacheMaxRetryCount, func() (bool, error) {
+		globalReplicationGroup, err := o.client.DescribeGlobalReplicationGroup(ctx, globalReplicationGroupId)
+		if err != nil {
+			return false, err
+		}
+		return len(globalReplicationGroup.Members) <= 1, nil
+	}, "GlobalReplicationGroupDisassociationTimeoutError: the secondary replication groups are still members of the global datastore")
+}
+
+func (o *ElastiCacheReplicationGroupOperator) getRegionalClient(region string) client.IElastiCache {
+	if region == "" || region == o.region {
+		return o.client
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if regionalClient, ok := o.regionalClients[region]; ok {
+		return regionalClient
+	}
+	regionalClient := o.regionalClientFn(region)
+	o.regionalClients[region] = regionalClient
+	return regionalClient
+}
+
+func (o *ElastiCacheReplicationGroupOperator) waitForAvailable(ctx context.Context, replicationGroupId *string) error {
+	return waitUntil(ctx, replicationGroupId, o.retryInterval, elastiCacheMaxRetryCount, func() (bool, error) {
+		replicationGroup, err := o.client.DescribeReplicationGroup(ctx, replicationGroupId)
+		if err != nil {
+			return false, err
+		}
+		return aws.ToString(replicationGroup.Status) == elastiCacheStatusAvailable, nil
+	}, "ReplicationGroupModificationTimeoutError: the replication group did not become available")
+}
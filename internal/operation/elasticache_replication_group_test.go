@@ -0,0 +1,368 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestElastiCacheReplicationGroupOperator_DeleteReplicationGroup(t *testing.T) {
+	io.NewLogger(false)
+
+	globalInfo := &types.GlobalReplicationGroupInfo{
+		GlobalReplicationGroupId:         aws.String("global"),
+		GlobalReplicationGroupMemberRole: aws.String("SECONDARY"),
+	}
+
+	cases := []struct {
+		name          string
+		finalSnapshot bool
+		prepareMockFn func(m *client.MockIElastiCache, r *client.MockIElastiCache)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete replication group successfully",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:          "delete replication group successfully with final snapshot",
+			finalSnapshot: true,
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), gomock.Not(gomock.Nil())).Return(nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group successfully for replication group not exists",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group successfully for replication group already deleting",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("deleting"),
+				}, nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group successfully after disassociating from global datastore with other members",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("available"),
+					GlobalReplicationGroupInfo: globalInfo,
+				}, nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("primary"), ReplicationGroupRegion: aws.String("us-west-2"), Role: aws.String("PRIMARY")},
+						{ReplicationGroupId: aws.String("test"), ReplicationGroupRegion: aws.String("ap-northeast-1"), Role: aws.String("SECONDARY")},
+					},
+				}, nil)
+				m.EXPECT().DisassociateGlobalReplicationGroup(gomock.Any(), aws.String("global"), aws.String("test"), aws.String("ap-northeast-1")).Return(nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("modifying"),
+					GlobalReplicationGroupInfo: globalInfo,
+				}, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("modifying"),
+					GlobalReplicationGroupInfo: &types.GlobalReplicationGroupInfo{},
+				}, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group successfully after deleting global datastore for only member",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("available"),
+					GlobalReplicationGroupInfo: globalInfo,
+				}, nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("test")},
+					},
+				}, nil)
+				m.EXPECT().DeleteGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil).Times(2)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group successfully after disassociating secondaries in other regions for primary",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				primaryGlobalInfo := &types.GlobalReplicationGroupInfo{
+					GlobalReplicationGroupId:         aws.String("global"),
+					GlobalReplicationGroupMemberRole: aws.String("PRIMARY"),
+				}
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("available"),
+					GlobalReplicationGroupInfo: primaryGlobalInfo,
+				}, nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("test"), ReplicationGroupRegion: aws.String("ap-northeast-1"), Role: aws.String("PRIMARY")},
+						{ReplicationGroupId: aws.String("secondary"), ReplicationGroupRegion: aws.String("us-west-2"), Role: aws.String("SECONDARY")},
+					},
+				}, nil)
+				r.EXPECT().DisassociateGlobalReplicationGroup(gomock.Any(), aws.String("global"), aws.String("secondary"), aws.String("us-west-2")).Return(nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("test"), ReplicationGroupRegion: aws.String("ap-northeast-1"), Role: aws.String("PRIMARY")},
+						{ReplicationGroupId: aws.String("secondary"), ReplicationGroupRegion: aws.String("us-west-2"), Role: aws.String("SECONDARY")},
+					},
+				}, nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("test"), ReplicationGroupRegion: aws.String("ap-northeast-1"), Role: aws.String("PRIMARY")},
+					},
+				}, nil)
+				m.EXPECT().DeleteGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil).Times(2)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group failure for disassociating secondary errors for primary",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("available"),
+					GlobalReplicationGroupInfo: globalInfo,
+				}, nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("test"), ReplicationGroupRegion: aws.String("ap-northeast-1"), Role: aws.String("PRIMARY")},
+						{ReplicationGroupId: aws.String("secondary"), ReplicationGroupRegion: aws.String("us-west-2"), Role: aws.String("SECONDARY")},
+					},
+				}, nil)
+				r.EXPECT().DisassociateGlobalReplicationGroup(gomock.Any(), aws.String("global"), aws.String("secondary"), aws.String("us-west-2")).Return(fmt.Errorf("DisassociateGlobalReplicationGroupError"))
+			},
+			want:    fmt.Errorf("DisassociateGlobalReplicationGroupError"),
+			wantErr: true,
+		},
+		{
+			name: "delete replication group successfully after removing user groups",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:       aws.String("available"),
+					UserGroupIds: []string{"user-group"},
+				}, nil)
+				m.EXPECT().RemoveReplicationGroupUserGroups(gomock.Any(), aws.String("test"), []string{"user-group"}).Return(nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("modifying"),
+				}, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group failure for check replication group exists errors",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeReplicationGroupsError"))
+			},
+			want:    fmt.Errorf("DescribeReplicationGroupsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete replication group failure for disassociate global replication group errors",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:                     aws.String("available"),
+					GlobalReplicationGroupInfo: globalInfo,
+				}, nil)
+				m.EXPECT().DescribeGlobalReplicationGroup(gomock.Any(), aws.String("global")).Return(&types.GlobalReplicationGroup{
+					Members: []types.GlobalReplicationGroupMember{
+						{ReplicationGroupId: aws.String("primary"), ReplicationGroupRegion: aws.String("us-west-2"), Role: aws.String("PRIMARY")},
+						{ReplicationGroupId: aws.String("test"), ReplicationGroupRegion: aws.String("ap-northeast-1"), Role: aws.String("SECONDARY")},
+					},
+				}, nil)
+				m.EXPECT().DisassociateGlobalReplicationGroup(gomock.Any(), aws.String("global"), aws.String("test"), aws.String("ap-northeast-1")).Return(fmt.Errorf("DisassociateGlobalReplicationGroupError"))
+			},
+			want:    fmt.Errorf("DisassociateGlobalReplicationGroupError"),
+			wantErr: true,
+		},
+		{
+			name: "delete replication group failure for remove user groups errors",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status:       aws.String("available"),
+					UserGroupIds: []string{"user-group"},
+				}, nil)
+				m.EXPECT().RemoveReplicationGroupUserGroups(gomock.Any(), aws.String("test"), []string{"user-group"}).Return(fmt.Errorf("ModifyReplicationGroupError"))
+			},
+			want:    fmt.Errorf("ModifyReplicationGroupError"),
+			wantErr: true,
+		},
+		{
+			name: "delete replication group failure for delete replication group errors",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteReplicationGroup(gomock.Any(), aws.String("test"), (*string)(nil)).Return(fmt.Errorf("DeleteReplicationGroupError"))
+			},
+			want:    fmt.Errorf("DeleteReplicationGroupError"),
+			wantErr: true,
+		},
+		{
+			name: "delete replication group failure for deletion timeout",
+			prepareMockFn: func(m *client.MockIElastiCache, r *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeReplicationGroup(gomock.Any(), aws.String("test")).Return(&types.ReplicationGroup{
+					Status: aws.String("deleting"),
+				}, nil)
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(true, nil).Times(elastiCacheMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("ReplicationGroupDeletionTimeoutError: the replication group is still being deleted"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			elastiCacheMock := client.NewMockIElastiCache(ctrl)
+			regionalElastiCacheMock := client.NewMockIElastiCache(ctrl)
+			tt.prepareMockFn(elastiCacheMock, regionalElastiCacheMock)
+
+			regionalClientFn := func(region string) client.IElastiCache {
+				if region != "us-west-2" {
+					t.Fatalf("unexpected region: %s", region)
+				}
+				return regionalElastiCacheMock
+			}
+
+			elastiCacheReplicationGroupOperator := NewElastiCacheReplicationGroupOperator(elastiCacheMock, regionalClientFn, "ap-northeast-1")
+			elastiCacheReplicationGroupOperator.finalSnapshot = tt.finalSnapshot
+			elastiCacheReplicationGroupOperator.retryInterval = 0
+
+			err := elastiCacheReplicationGroupOperator.DeleteReplicationGroup(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestElastiCacheReplicationGroupOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIElastiCache)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckReplicationGroupExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeReplicationGroupsError"))
+			},
+			want:    fmt.Errorf("DescribeReplicationGroupsError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			elastiCacheMock := client.NewMockIElastiCache(ctrl)
+			tt.prepareMockFn(elastiCacheMock)
+
+			elastiCacheReplicationGroupOperator := NewElastiCacheReplicationGroupOperator(elastiCacheMock, nil, "ap-northeast-1")
+			elastiCacheReplicationGroupOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::ElastiCache::ReplicationGroup"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := elastiCacheReplicationGroupOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*ElastiCacheServerlessCacheOperator)(nil)
+
+// ElastiCacheServerlessCacheOperator deletes ElastiCache serverless caches that cannot be deleted
+// by CloudFormation because a user group is attached outside the stack.
+// A final snapshot is taken only when requested.
+type ElastiCacheServerlessCacheOperator struct {
+	client        client.IElastiCache
+	resources     []*types.StackResourceSummary
+	finalSnapshot bool
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewElastiCacheServerlessCacheOperator(elastiCacheClient client.IElastiCache) *ElastiCacheServerlessCacheOperator {
+	return &ElastiCacheServerlessCacheOperator{
+		client:        elastiCacheClient,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: elastiCacheRetryInterval,
+	}
+}
+
+func (o *ElastiCacheServerlessCacheOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *ElastiCacheServerlessCacheOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *ElastiCacheServerlessCacheOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, serverlessCache := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteServerlessCache(ctx, serverlessCache.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *ElastiCacheServerlessCacheOperator) DeleteServerlessCache(ctx context.Context, serverlessCacheName *string) error {
+	exists, err := o.client.CheckServerlessCacheExists(ctx, serverlessCacheName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	serverlessCache, err := o.client.DescribeServerlessCache(ctx, serverlessCacheName)
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(serverlessCache.Status) != elastiCacheStatusDeleting {
+		if serverlessCache.UserGroupId != nil {
+			if err := o.client.RemoveServerlessCacheUserGroup(ctx, serverlessCacheName); err != nil {
+				return err
+			}
+			if err := waitUntil(ctx, serverlessCacheName, o.retryInterval, elastiCacheMaxRetryCount, func() (bool, error) {
+				serverlessCache, err := o.client.DescribeServerlessCache(ctx, serverlessCacheName)
+				if err != nil {
+					return false, err
+				}
+				return aws.ToString(serverlessCache.Status) == elastiCacheStatusAvailable, nil
+			}, "ServerlessCacheModificationTimeoutError: the serverless cache did not become available"); err != nil {
+				return err
+			}
+			io.Logger.Debug().Msgf("Removed the user group from the serverless cache %s", aws.ToString(serverlessCacheName))
+		}
+
+		var finalSnapshotName *string
+		if o.finalSnapshot {
+			finalSnapshotName = aws.String(fmt.Sprintf("%s-final-%s", aws.ToString(serverlessCacheName), time.Now().Format("20060102150405")))
+		}
+		if err := o.client.DeleteServerlessCache(ctx, serverlessCacheName, finalSnapshotName); err != nil {
+			return err
+		}
+	}
+
+	return waitUntil(ctx, serverlessCacheName, o.retryInterval, elastiCacheMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckServerlessCacheExists(ctx, serverlessCacheName)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "ServerlessCacheDeletionTimeoutError: the serverless cache is still being deleted")
+}
@@ -0,0 +1,207 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestElastiCacheServerlessCacheOperator_DeleteServerlessCache(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		finalSnapshot bool
+		prepareMockFn func(m *client.MockIElastiCache)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete serverless cache successfully",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteServerlessCache(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:          "delete serverless cache successfully with final snapshot",
+			finalSnapshot: true,
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteServerlessCache(gomock.Any(), aws.String("test"), gomock.Not(gomock.Nil())).Return(nil)
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete serverless cache successfully after removing user group",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status:      aws.String("available"),
+					UserGroupId: aws.String("user-group"),
+				}, nil)
+				m.EXPECT().RemoveServerlessCacheUserGroup(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status: aws.String("modifying"),
+				}, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteServerlessCache(gomock.Any(), aws.String("test"), (*string)(nil)).Return(nil)
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete serverless cache successfully for serverless cache not exists",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete serverless cache successfully for serverless cache already deleting",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status: aws.String("deleting"),
+				}, nil)
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete serverless cache failure for check serverless cache exists errors",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeServerlessCachesError"))
+			},
+			want:    fmt.Errorf("DescribeServerlessCachesError"),
+			wantErr: true,
+		},
+		{
+			name: "delete serverless cache failure for remove user group errors",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status:      aws.String("available"),
+					UserGroupId: aws.String("user-group"),
+				}, nil)
+				m.EXPECT().RemoveServerlessCacheUserGroup(gomock.Any(), aws.String("test")).Return(fmt.Errorf("ModifyServerlessCacheError"))
+			},
+			want:    fmt.Errorf("ModifyServerlessCacheError"),
+			wantErr: true,
+		},
+		{
+			name: "delete serverless cache failure for delete serverless cache errors",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeServerlessCache(gomock.Any(), aws.String("test")).Return(&types.ServerlessCache{
+					Status: aws.String("available"),
+				}, nil)
+				m.EXPECT().DeleteServerlessCache(gomock.Any(), aws.String("test"), (*string)(nil)).Return(fmt.Errorf("DeleteServerlessCacheError"))
+			},
+			want:    fmt.Errorf("DeleteServerlessCacheError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			elastiCacheMock := client.NewMockIElastiCache(ctrl)
+			tt.prepareMockFn(elastiCacheMock)
+
+			elastiCacheServerlessCacheOperator := NewElastiCacheServerlessCacheOperator(elastiCacheMock)
+			elastiCacheServerlessCacheOperator.finalSnapshot = tt.finalSnapshot
+			elastiCacheServerlessCacheOperator.retryInterval = 0
+
+			err := elastiCacheServerlessCacheOperator.DeleteServerlessCache(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestElastiCacheServerlessCacheOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIElastiCache)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIElastiCache) {
+				m.EXPECT().CheckServerlessCacheExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeServerlessCachesError"))
+			},
+			want:    fmt.Errorf("DescribeServerlessCachesError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			elastiCacheMock := client.NewMockIElastiCache(ctrl)
+			tt.prepareMockFn(elastiCacheMock)
+
+			elastiCacheServerlessCacheOperator := NewElastiCacheServerlessCacheOperator(elastiCacheMock)
+			elastiCacheServerlessCacheOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::ElastiCache::ServerlessCache"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := elastiCacheServerlessCacheOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	eniRequesterIdKey   = "requesterId"
+	eniInterfaceTypeKey = "interfaceType"
+)
+
+// ENICleanupPolicy allows the orphan ENIs of AWS services other than AWS Lambda, such as EFS mount
+// targets, VPC endpoints, RDS proxies, ECS tasks and Glue connections, to be deleted when they are
+// left in the subnets and the security groups of the stacks. The orphan AWS Lambda VPC ENIs are
+// always deleted, with or without the policy.
+//
+// Every ENI deleted under the policy is recorded to be reported. It is shared by every stack in a
+// run, including nested stacks.
+type ENICleanupPolicy struct {
+	// requesterIds and interfaceTypes are the patterns in the syntax of path.Match (e.g. `amazon-rds`,
+	// `vpc_endpoint`) of the ENIs allowed to be deleted.
+	requesterIds   []string
+	interfaceTypes []string
+
+	mu      sync.Mutex
+	deleted []DeletedENI
+}
+
+// DeletedENI is an orphan ENI deleted from the subnets or the security groups of the stacks.
+type DeletedENI struct {
+	NetworkInterfaceId string `json:"networkInterfaceId"`
+	InterfaceType      string `json:"interfaceType"`
+	RequesterId        string `json:"requesterId"`
+	Description        string `json:"description"`
+	SubnetId           string `json:"subnetId"`
+	VpcId              string `json:"vpcId"`
+}
+
+// NewENICleanupPolicy creates the policy from the allow-list entries in the form of
+// `requesterId=<pattern>` or `interfaceType=<pattern>`.
+func NewENICleanupPolicy(entries []string) (*ENICleanupPolicy, error) {
+	policy := &ENICleanupPolicy{
+		requesterIds:   []string{},
+		interfaceTypes: []string{},
+		deleted:        []DeletedENI{},
+	}
+
+	for _, entry := range entries {
+		key, pattern, ok := strings.Cut(entry, "=")
+		if !ok || pattern == "" {
+			return nil, fmt.Errorf("InvalidOptionError: invalid entry %q for --cleanupOrphanEni, expected %s=<pattern> or %s=<pattern>", entry, eniRequesterIdKey, eniInterfaceTypeKey)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("InvalidOptionError: invalid pattern %q for --cleanupOrphanEni: %w", pattern, err)
+		}
+
+		switch key {
+		case eniRequesterIdKey:
+			policy.requesterIds = append(policy.requesterIds, pattern)
+		case eniInterfaceTypeKey:
+			policy.interfaceTypes = append(policy.interfaceTypes, pattern)
+		default:
+			return nil, fmt.Errorf("InvalidOptionError: invalid entry %q for --cleanupOrphanEni, expected %s=<pattern> or %s=<pattern>", entry, eniRequesterIdKey, eniInterfaceTypeKey)
+		}
+	}
+
+	return policy, nil
+}
+
+// Matches reports whether the ENI is allowed to be deleted by its requester ID or interface type.
+// A nil policy allows nothing.
+func (p *ENICleanupPolicy) Matches(eni ec2types.NetworkInterface) bool {
+	if p == nil {
+		return false
+	}
+	for _, pattern := range p.requesterIds {
+		if ok, _ := path.Match(pattern, aws.ToString(eni.RequesterId)); ok {
+			return true
+		}
+	}
+	for _, pattern := range p.interfaceTypes {
+		if ok, _ := path.Match(pattern, string(eni.InterfaceType)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ENICleanupPolicy) record(eni ec2types.NetworkInterface) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, d := range p.deleted {
+		if d.NetworkInterfaceId == aws.ToString(eni.NetworkInterfaceId) {
+			return
+		}
+	}
+	p.deleted = append(p.deleted, DeletedENI{
+		NetworkInterfaceId: aws.ToString(eni.NetworkInterfaceId),
+		InterfaceType:      string(eni.InterfaceType),
+		RequesterId:        aws.ToString(eni.RequesterId),
+		Description:        aws.ToString(eni.Description),
+		SubnetId:           aws.ToString(eni.SubnetId),
+		VpcId:              aws.ToString(eni.VpcId),
+	})
+}
+
+// DeletedENIs returns the deleted ENIs sorted by the ID.
+func (p *ENICleanupPolicy) DeletedENIs() []DeletedENI {
+	if p == nil {
+		return []DeletedENI{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	deleted := make([]DeletedENI, len(p.deleted))
+	copy(deleted, p.deleted)
+	sort.Slice(deleted, func(i, j int) bool {
+		return deleted[i].NetworkInterfaceId < deleted[j].NetworkInterfaceId
+	})
+	return deleted
+}
+
+// Report outputs the deleted ENIs as a table, and writes them to reportPath as JSON if it is given.
+// Nothing is output when no ENI has been deleted.
+func (p *ENICleanupPolicy) Report(reportPath string) error {
+	deleted := p.DeletedENIs()
+
+	if reportPath != "" {
+		data, err := json.MarshalIndent(deleted, "", "  ")
+		if err != nil {
+			return fmt.Errorf("OrphanENIReportError: failed to encode the orphan ENI report, %w", err)
+		}
+		if err := os.WriteFile(reportPath, append(data, '\n'), 0o644); err != nil {
+			return fmt.Errorf("OrphanENIReportError: failed to write the orphan ENI report, %w", err)
+		}
+	}
+
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	header := []string{"NetworkInterfaceId", "InterfaceType", "RequesterId", "SubnetId", "Description"}
+	data := [][]string{}
+	for _, eni := range deleted {
+		data = append(data, []string{eni.NetworkInterfaceId, eni.InterfaceType, eni.RequesterId, eni.SubnetId, eni.Description})
+	}
+	table, err := io.ToStringAsTableFormat(header, data)
+	if err != nil {
+		return fmt.Errorf("OrphanENIReportError: failed to create the orphan ENI table, %w", err)
+	}
+
+	io.Logger.Info().Msgf("The following orphan ENIs were deleted:\n%s", *table)
+	if reportPath != "" {
+		io.Logger.Info().Msgf("The orphan ENI report is written to %s.", reportPath)
+	}
+	return nil
+}
+
+// cleanupOrphanENIsByFilter finds available ENIs that match the given filter (e.g. subnet-id or
+// group-id), then deletes in parallel the ones AWS Lambda provisioned and the ones allowed by the
+// policy. Used by EC2SubnetOperator / EC2SecurityGroupOperator to unblock deletion when AWS services
+// have not released their ENIs, and by OrphanENICleaner to delete them up front.
+//
+// Without the policy, only the AWS Lambda VPC ENIs are described, by their description prefix.
+func cleanupOrphanENIsByFilter(ctx context.Context, ec2Client client.IEC2, policy *ENICleanupPolicy, filterName, filterValue string) error {
+	filters := []ec2types.Filter{
+		{
+			Name:   aws.String(filterName),
+			Values: []string{filterValue},
+		},
+	}
+	if policy == nil {
+		filters = append(filters, ec2types.Filter{
+			Name:   aws.String("description"),
+			Values: []string{lambdaVPCENIDescriptionPrefix + "*"},
+		})
+	}
+	filters = append(filters, ec2types.Filter{
+		Name:   aws.String("status"),
+		Values: []string{string(ec2types.NetworkInterfaceStatusAvailable)},
+	})
+
+	enis, err := ec2Client.DescribeNetworkInterfaces(ctx, filters)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+	for _, eni := range enis {
+		// Without the policy, the description filter has already narrowed them down to the Lambda ones.
+		if policy != nil && !isLambdaVPCENI(eni) && !policy.Matches(eni) {
+			continue
+		}
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+			if err := ec2Client.DeleteNetworkInterface(ctx, eni.NetworkInterfaceId); err != nil {
+				return err
+			}
+			policy.record(eni)
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
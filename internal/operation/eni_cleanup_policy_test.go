@@ -0,0 +1,273 @@
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewENICleanupPolicy(t *testing.T) {
+	cases := []struct {
+		name    string
+		entries []string
+		want    error
+		wantErr bool
+	}{
+		{
+			name:    "valid entries",
+			entries: []string{"requesterId=amazon-rds", "interfaceType=vpc_endpoint", "requesterId=*:glue-*"},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "unknown key",
+			entries: []string{"description=foo"},
+			want:    fmt.Errorf("InvalidOptionError: invalid entry %q for --cleanupOrphanEni, expected requesterId=<pattern> or interfaceType=<pattern>", "description=foo"),
+			wantErr: true,
+		},
+		{
+			name:    "missing pattern",
+			entries: []string{"interfaceType"},
+			want:    fmt.Errorf("InvalidOptionError: invalid entry %q for --cleanupOrphanEni, expected requesterId=<pattern> or interfaceType=<pattern>", "interfaceType"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid pattern",
+			entries: []string{"interfaceType=vpc_endpoint["},
+			want:    fmt.Errorf("InvalidOptionError: invalid pattern %q for --cleanupOrphanEni: syntax error in pattern", "vpc_endpoint["),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewENICleanupPolicy(tt.entries)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestENICleanupPolicy_Matches(t *testing.T) {
+	eniCleanupPolicy, err := NewENICleanupPolicy([]string{"requesterId=amazon-rds", "interfaceType=vpc_endpoint", "interfaceType=gateway_load_balancer*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name   string
+		policy *ENICleanupPolicy
+		eni    ec2types.NetworkInterface
+		want   bool
+	}{
+		{
+			name:   "requester ID",
+			policy: eniCleanupPolicy,
+			eni:    ec2types.NetworkInterface{RequesterId: aws.String("amazon-rds"), InterfaceType: ec2types.NetworkInterfaceTypeInterface},
+			want:   true,
+		},
+		{
+			name:   "exact interface type",
+			policy: eniCleanupPolicy,
+			eni:    ec2types.NetworkInterface{InterfaceType: ec2types.NetworkInterfaceTypeVpcEndpoint},
+			want:   true,
+		},
+		{
+			name:   "wildcard interface type",
+			policy: eniCleanupPolicy,
+			eni:    ec2types.NetworkInterface{InterfaceType: ec2types.NetworkInterfaceTypeGatewayLoadBalancerEndpoint},
+			want:   true,
+		},
+		{
+			name:   "unmatched ENI",
+			policy: eniCleanupPolicy,
+			eni:    ec2types.NetworkInterface{RequesterId: aws.String("amazon-elb"), InterfaceType: ec2types.NetworkInterfaceTypeInterface},
+			want:   false,
+		},
+		{
+			name:   "nil policy",
+			policy: nil,
+			eni:    ec2types.NetworkInterface{InterfaceType: ec2types.NetworkInterfaceTypeVpcEndpoint},
+			want:   false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Matches(tt.eni); got != tt.want {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cleanupOrphanENIsByFilter(t *testing.T) {
+	io.NewLogger(false)
+
+	eniCleanupPolicy, err := NewENICleanupPolicy([]string{"interfaceType=vpc_endpoint"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lambdaENI := ec2types.NetworkInterface{
+		NetworkInterfaceId: aws.String("eni-lambda"),
+		Description:        aws.String("AWS Lambda VPC ENI-test-function"),
+		InterfaceType:      ec2types.NetworkInterfaceTypeLambda,
+	}
+	endpointENI := ec2types.NetworkInterface{
+		NetworkInterfaceId: aws.String("eni-endpoint"),
+		Description:        aws.String("VPC Endpoint Interface vpce-123"),
+		InterfaceType:      ec2types.NetworkInterfaceTypeVpcEndpoint,
+	}
+	elbENI := ec2types.NetworkInterface{
+		NetworkInterfaceId: aws.String("eni-elb"),
+		Description:        aws.String("ELB app/test/123"),
+		InterfaceType:      ec2types.NetworkInterfaceTypeInterface,
+		RequesterId:        aws.String("amazon-elb"),
+	}
+
+	cases := []struct {
+		name          string
+		policy        *ENICleanupPolicy
+		prepareMockFn func(m *client.MockIEC2)
+		wantErr       bool
+	}{
+		{
+			name:   "delete only Lambda ENIs described by the description filter without policy",
+			policy: nil,
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), []ec2types.Filter{
+					{Name: aws.String("subnet-id"), Values: []string{"subnet-111"}},
+					{Name: aws.String("description"), Values: []string{"AWS Lambda VPC ENI*"}},
+					{Name: aws.String("status"), Values: []string{"available"}},
+				}).Return([]ec2types.NetworkInterface{lambdaENI}, nil)
+				m.EXPECT().DeleteNetworkInterface(gomock.Any(), aws.String("eni-lambda")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "delete Lambda ENIs and ENIs allowed by policy",
+			policy: eniCleanupPolicy,
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), []ec2types.Filter{
+					{Name: aws.String("subnet-id"), Values: []string{"subnet-111"}},
+					{Name: aws.String("status"), Values: []string{"available"}},
+				}).Return([]ec2types.NetworkInterface{lambdaENI, endpointENI, elbENI}, nil)
+				m.EXPECT().DeleteNetworkInterface(gomock.Any(), aws.String("eni-lambda")).Return(nil)
+				m.EXPECT().DeleteNetworkInterface(gomock.Any(), aws.String("eni-endpoint")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "ENI deletion failure",
+			policy: eniCleanupPolicy,
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any()).Return([]ec2types.NetworkInterface{endpointENI}, nil)
+				m.EXPECT().DeleteNetworkInterface(gomock.Any(), aws.String("eni-endpoint")).Return(fmt.Errorf("DeleteNetworkInterfaceError"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ec2Mock := client.NewMockIEC2(ctrl)
+			tt.prepareMockFn(ec2Mock)
+
+			err := cleanupOrphanENIsByFilter(context.Background(), ec2Mock, tt.policy, "subnet-id", "subnet-111")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %#v, wantErr %#v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Only the ENIs deleted successfully are recorded.
+	want := []DeletedENI{
+		{NetworkInterfaceId: "eni-endpoint", InterfaceType: "vpc_endpoint", Description: "VPC Endpoint Interface vpce-123"},
+		{NetworkInterfaceId: "eni-lambda", InterfaceType: "lambda", Description: "AWS Lambda VPC ENI-test-function"},
+	}
+	if got := eniCleanupPolicy.DeletedENIs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %#v, want %#v", got, want)
+	}
+}
+
+func TestENICleanupPolicy_Report(t *testing.T) {
+	io.NewLogger(false)
+
+	eniCleanupPolicy, err := NewENICleanupPolicy([]string{"requesterId=amazon-rds"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same ENI is recorded twice, as the subnet and the security group share it.
+	for _, id := range []string{"eni-2", "eni-1", "eni-2"} {
+		eniCleanupPolicy.record(ec2types.NetworkInterface{
+			NetworkInterfaceId: aws.String(id),
+			InterfaceType:      ec2types.NetworkInterfaceTypeInterface,
+			RequesterId:        aws.String("amazon-rds"),
+			Description:        aws.String("RDSNetworkInterface"),
+			SubnetId:           aws.String("subnet-111"),
+			VpcId:              aws.String("vpc-111"),
+		})
+	}
+
+	reportPath := filepath.Join(t.TempDir(), "enis.json")
+	if err := eniCleanupPolicy.Report(reportPath); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []DeletedENI{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []DeletedENI{
+		{NetworkInterfaceId: "eni-1", InterfaceType: "interface", RequesterId: "amazon-rds", Description: "RDSNetworkInterface", SubnetId: "subnet-111", VpcId: "vpc-111"},
+		{NetworkInterfaceId: "eni-2", InterfaceType: "interface", RequesterId: "amazon-rds", Description: "RDSNetworkInterface", SubnetId: "subnet-111", VpcId: "vpc-111"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %#v, want %#v", got, want)
+	}
+}
+
+func TestENICleanupPolicy_Report_Empty(t *testing.T) {
+	io.NewLogger(false)
+
+	eniCleanupPolicy, err := NewENICleanupPolicy([]string{"interfaceType=vpc_endpoint"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reportPath := filepath.Join(t.TempDir(), "enis.json")
+	if err := eniCleanupPolicy.Report(reportPath); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "[]\n" {
+		t.Errorf("got = %q, want %q", string(data), "[]\n")
+	}
+}
@@ -0,0 +1,108 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	firehosetypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	firehoseDeliveryStreamRetryInterval = 10 * time.Second
+
+	// firehoseDeliveryStreamMaxRetryCount bounds the wait for the delivery stream to be deleted
+	// (about 5 minutes with the default interval).
+	firehoseDeliveryStreamMaxRetryCount = 30
+)
+
+var _ IOperator = (*FirehoseDeliveryStreamOperator)(nil)
+
+// FirehoseDeliveryStreamOperator deletes Firehose delivery streams, including ones stuck in
+// CREATING_FAILED or DELETING_FAILED (e.g. after the KMS key for server-side encryption became
+// unusable). Such streams can only be deleted with AllowForceDelete.
+type FirehoseDeliveryStreamOperator struct {
+	client    client.IFirehose
+	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewFirehoseDeliveryStreamOperator(client client.IFirehose) *FirehoseDeliveryStreamOperator {
+	return &FirehoseDeliveryStreamOperator{
+		client:        client,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: firehoseDeliveryStreamRetryInterval,
+	}
+}
+
+func (o *FirehoseDeliveryStreamOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *FirehoseDeliveryStreamOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *FirehoseDeliveryStreamOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, deliveryStream := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteDeliveryStream(ctx, deliveryStream.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *FirehoseDeliveryStreamOperator) DeleteDeliveryStream(ctx context.Context, deliveryStreamName *string) error {
+	exists, err := o.client.CheckDeliveryStreamExists(ctx, deliveryStreamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	description, err := o.client.DescribeDeliveryStream(ctx, deliveryStreamName)
+	if err != nil {
+		return err
+	}
+
+	switch description.DeliveryStreamStatus {
+	case firehosetypes.DeliveryStreamStatusDeleting:
+	case firehosetypes.DeliveryStreamStatusCreatingFailed, firehosetypes.DeliveryStreamStatusDeletingFailed:
+		if err := o.client.DeleteDeliveryStream(ctx, deliveryStreamName, true); err != nil {
+			return err
+		}
+	default:
+		if err := o.client.DeleteDeliveryStream(ctx, deliveryStreamName, false); err != nil {
+			return err
+		}
+	}
+
+	return o.waitForDeliveryStreamDeletion(ctx, deliveryStreamName)
+}
+
+func (o *FirehoseDeliveryStreamOperator) waitForDeliveryStreamDeletion(ctx context.Context, deliveryStreamName *string) error {
+	return waitUntil(ctx, deliveryStreamName, o.retryInterval, firehoseDeliveryStreamMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckDeliveryStreamExists(ctx, deliveryStreamName)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "DeliveryStreamDeletionTimeoutError: the delivery stream is still being deleted")
+}
@@ -0,0 +1,207 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestFirehoseDeliveryStreamOperator_DeleteDeliveryStream(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIFirehose)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete delivery stream successfully",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(&types.DeliveryStreamDescription{
+					DeliveryStreamStatus: types.DeliveryStreamStatusActive,
+				}, nil)
+				m.EXPECT().DeleteDeliveryStream(gomock.Any(), aws.String("test"), false).Return(nil)
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete delivery stream successfully with force delete for creating failed",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(&types.DeliveryStreamDescription{
+					DeliveryStreamStatus: types.DeliveryStreamStatusCreatingFailed,
+				}, nil)
+				m.EXPECT().DeleteDeliveryStream(gomock.Any(), aws.String("test"), true).Return(nil)
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete delivery stream successfully with force delete for deleting failed",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(&types.DeliveryStreamDescription{
+					DeliveryStreamStatus: types.DeliveryStreamStatusDeletingFailed,
+				}, nil)
+				m.EXPECT().DeleteDeliveryStream(gomock.Any(), aws.String("test"), true).Return(nil)
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete delivery stream successfully for delivery stream already deleting",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(&types.DeliveryStreamDescription{
+					DeliveryStreamStatus: types.DeliveryStreamStatusDeleting,
+				}, nil)
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete delivery stream successfully for delivery stream not exists",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete delivery stream failure for check delivery stream exists errors",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeDeliveryStreamError"))
+			},
+			want:    fmt.Errorf("DescribeDeliveryStreamError"),
+			wantErr: true,
+		},
+		{
+			name: "delete delivery stream failure for describe delivery stream errors",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeDeliveryStreamError"))
+			},
+			want:    fmt.Errorf("DescribeDeliveryStreamError"),
+			wantErr: true,
+		},
+		{
+			name: "delete delivery stream failure for delete delivery stream errors",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(&types.DeliveryStreamDescription{
+					DeliveryStreamStatus: types.DeliveryStreamStatusCreatingFailed,
+				}, nil)
+				m.EXPECT().DeleteDeliveryStream(gomock.Any(), aws.String("test"), true).Return(fmt.Errorf("DeleteDeliveryStreamError"))
+			},
+			want:    fmt.Errorf("DeleteDeliveryStreamError"),
+			wantErr: true,
+		},
+		{
+			name: "delete delivery stream failure for deletion timeout",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDeliveryStream(gomock.Any(), aws.String("test")).Return(&types.DeliveryStreamDescription{
+					DeliveryStreamStatus: types.DeliveryStreamStatusDeleting,
+				}, nil)
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(true, nil).Times(firehoseDeliveryStreamMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("DeliveryStreamDeletionTimeoutError: the delivery stream is still being deleted"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			firehoseMock := client.NewMockIFirehose(ctrl)
+			tt.prepareMockFn(firehoseMock)
+
+			firehoseDeliveryStreamOperator := NewFirehoseDeliveryStreamOperator(firehoseMock)
+			firehoseDeliveryStreamOperator.retryInterval = 0
+
+			err := firehoseDeliveryStreamOperator.DeleteDeliveryStream(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestFirehoseDeliveryStreamOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIFirehose)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIFirehose) {
+				m.EXPECT().CheckDeliveryStreamExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeDeliveryStreamError"))
+			},
+			want:    fmt.Errorf("DescribeDeliveryStreamError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			firehoseMock := client.NewMockIFirehose(ctrl)
+			tt.prepareMockFn(firehoseMock)
+
+			firehoseDeliveryStreamOperator := NewFirehoseDeliveryStreamOperator(firehoseMock)
+			firehoseDeliveryStreamOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::KinesisFirehose::DeliveryStream"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := firehoseDeliveryStreamOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
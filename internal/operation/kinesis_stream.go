@@ -0,0 +1,132 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	kinesistypes "github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	kinesisStreamRetryInterval = 10 * time.Second
+
+	// kinesisStreamMaxRetryCount bounds the wait for the stream to be deleted
+	// (about 5 minutes with the default interval).
+	kinesisStreamMaxRetryCount = 30
+)
+
+var _ IOperator = (*KinesisStreamOperator)(nil)
+
+// KinesisStreamOperator deletes Kinesis data streams that fail to delete because enhanced
+// fan-out consumers are still registered to them. The consumers are usually registered by
+// applications outside the stack, so CloudFormation does not know about them.
+type KinesisStreamOperator struct {
+	client    client.IKinesis
+	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewKinesisStreamOperator(client client.IKinesis) *KinesisStreamOperator {
+	return &KinesisStreamOperator{
+		client:        client,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: kinesisStreamRetryInterval,
+	}
+}
+
+func (o *KinesisStreamOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *KinesisStreamOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *KinesisStreamOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, stream := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteStream(ctx, stream.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *KinesisStreamOperator) DeleteStream(ctx context.Context, streamName *string) error {
+	exists, err := o.client.CheckStreamExists(ctx, streamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	summary, err := o.client.DescribeStreamSummary(ctx, streamName)
+	if err != nil {
+		return err
+	}
+
+	if summary.StreamStatus != kinesistypes.StreamStatusDeleting {
+		if err := o.deregisterConsumers(ctx, summary.StreamARN); err != nil {
+			return err
+		}
+
+		// EnforceConsumerDeletion also removes consumers registered after the listing above.
+		if err := o.client.DeleteStream(ctx, streamName, true); err != nil {
+			return err
+		}
+	}
+
+	return o.waitForStreamDeletion(ctx, streamName)
+}
+
+func (o *KinesisStreamOperator) deregisterConsumers(ctx context.Context, streamArn *string) error {
+	consumers, err := o.client.ListStreamConsumers(ctx, streamArn)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, consumer := range consumers {
+		if consumer.ConsumerStatus == kinesistypes.ConsumerStatusDeleting {
+			continue
+		}
+		eg.Go(func() error {
+			if err := o.client.DeregisterStreamConsumer(ctx, streamArn, consumer.ConsumerARN); err != nil {
+				return err
+			}
+			io.Logger.Debug().Msgf("Deregistered the consumer %s from the stream %s", aws.ToString(consumer.ConsumerName), aws.ToString(streamArn))
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (o *KinesisStreamOperator) waitForStreamDeletion(ctx context.Context, streamName *string) error {
+	return waitUntil(ctx, streamName, o.retryInterval, kinesisStreamMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckStreamExists(ctx, streamName)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "StreamDeletionTimeoutError: the stream is still being deleted")
+}
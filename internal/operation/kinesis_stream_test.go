@@ -0,0 +1,227 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+const testStreamArn = "arn:aws:kinesis:ap-northeast-1:123456789012:stream/test"
+
+/*
+	Test Cases
+*/
+
+func TestKinesisStreamOperator_DeleteStream(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIKinesis)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete stream successfully after deregistering consumers",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeStreamSummary(gomock.Any(), aws.String("test")).Return(&types.StreamDescriptionSummary{
+					StreamARN:    aws.String(testStreamArn),
+					StreamStatus: types.StreamStatusActive,
+				}, nil)
+				m.EXPECT().ListStreamConsumers(gomock.Any(), aws.String(testStreamArn)).Return([]types.Consumer{
+					{
+						ConsumerARN:    aws.String("ConsumerArn1"),
+						ConsumerName:   aws.String("Consumer1"),
+						ConsumerStatus: types.ConsumerStatusActive,
+					},
+					{
+						ConsumerARN:    aws.String("ConsumerArn2"),
+						ConsumerName:   aws.String("Consumer2"),
+						ConsumerStatus: types.ConsumerStatusDeleting,
+					},
+				}, nil)
+				m.EXPECT().DeregisterStreamConsumer(gomock.Any(), aws.String(testStreamArn), aws.String("ConsumerArn1")).Return(nil)
+				m.EXPECT().DeleteStream(gomock.Any(), aws.String("test"), true).Return(nil)
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete stream successfully for stream not exists",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete stream successfully for stream already deleting",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeStreamSummary(gomock.Any(), aws.String("test")).Return(&types.StreamDescriptionSummary{
+					StreamARN:    aws.String(testStreamArn),
+					StreamStatus: types.StreamStatusDeleting,
+				}, nil)
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete stream failure for check stream exists errors",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeStreamSummaryError"))
+			},
+			want:    fmt.Errorf("DescribeStreamSummaryError"),
+			wantErr: true,
+		},
+		{
+			name: "delete stream failure for list stream consumers errors",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeStreamSummary(gomock.Any(), aws.String("test")).Return(&types.StreamDescriptionSummary{
+					StreamARN:    aws.String(testStreamArn),
+					StreamStatus: types.StreamStatusActive,
+				}, nil)
+				m.EXPECT().ListStreamConsumers(gomock.Any(), aws.String(testStreamArn)).Return(nil, fmt.Errorf("ListStreamConsumersError"))
+			},
+			want:    fmt.Errorf("ListStreamConsumersError"),
+			wantErr: true,
+		},
+		{
+			name: "delete stream failure for deregister stream consumer errors",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeStreamSummary(gomock.Any(), aws.String("test")).Return(&types.StreamDescriptionSummary{
+					StreamARN:    aws.String(testStreamArn),
+					StreamStatus: types.StreamStatusActive,
+				}, nil)
+				m.EXPECT().ListStreamConsumers(gomock.Any(), aws.String(testStreamArn)).Return([]types.Consumer{
+					{
+						ConsumerARN:    aws.String("ConsumerArn1"),
+						ConsumerName:   aws.String("Consumer1"),
+						ConsumerStatus: types.ConsumerStatusActive,
+					},
+				}, nil)
+				m.EXPECT().DeregisterStreamConsumer(gomock.Any(), aws.String(testStreamArn), aws.String("ConsumerArn1")).Return(fmt.Errorf("DeregisterStreamConsumerError"))
+			},
+			want:    fmt.Errorf("DeregisterStreamConsumerError"),
+			wantErr: true,
+		},
+		{
+			name: "delete stream failure for delete stream errors",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeStreamSummary(gomock.Any(), aws.String("test")).Return(&types.StreamDescriptionSummary{
+					StreamARN:    aws.String(testStreamArn),
+					StreamStatus: types.StreamStatusActive,
+				}, nil)
+				m.EXPECT().ListStreamConsumers(gomock.Any(), aws.String(testStreamArn)).Return([]types.Consumer{}, nil)
+				m.EXPECT().DeleteStream(gomock.Any(), aws.String("test"), true).Return(fmt.Errorf("DeleteStreamError"))
+			},
+			want:    fmt.Errorf("DeleteStreamError"),
+			wantErr: true,
+		},
+		{
+			name: "delete stream failure for deletion timeout",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeStreamSummary(gomock.Any(), aws.String("test")).Return(&types.StreamDescriptionSummary{
+					StreamARN:    aws.String(testStreamArn),
+					StreamStatus: types.StreamStatusActive,
+				}, nil)
+				m.EXPECT().ListStreamConsumers(gomock.Any(), aws.String(testStreamArn)).Return([]types.Consumer{}, nil)
+				m.EXPECT().DeleteStream(gomock.Any(), aws.String("test"), true).Return(nil)
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(true, nil).Times(kinesisStreamMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("StreamDeletionTimeoutError: the stream is still being deleted"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kinesisMock := client.NewMockIKinesis(ctrl)
+			tt.prepareMockFn(kinesisMock)
+
+			kinesisStreamOperator := NewKinesisStreamOperator(kinesisMock)
+			kinesisStreamOperator.retryInterval = 0
+
+			err := kinesisStreamOperator.DeleteStream(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestKinesisStreamOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIKinesis)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIKinesis) {
+				m.EXPECT().CheckStreamExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeStreamSummaryError"))
+			},
+			want:    fmt.Errorf("DescribeStreamSummaryError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kinesisMock := client.NewMockIKinesis(ctrl)
+			tt.prepareMockFn(kinesisMock)
+
+			kinesisStreamOperator := NewKinesisStreamOperator(kinesisMock)
+			kinesisStreamOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::Kinesis::Stream"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := kinesisStreamOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -26,9 +26,11 @@ const (
 )
 
 // LambdaFunctionOperator handles deletion of Lambda functions that fail to delete due to
-// Lambda@Edge replicas. When a CloudFront distribution uses Lambda@Edge, CloudFormation
-// deletes the distribution first, but the Lambda function deletion fails because edge
-// replicas are still being cleaned up asynchronously by AWS.
+// Lambda@Edge replicas, or due to provisioned concurrency configs and event source
+// mappings added outside the stack, which are removed before the function is deleted.
+// When a CloudFront distribution uses Lambda@Edge, CloudFormation deletes the
+// distribution first, but the Lambda function deletion fails because edge replicas are
+// still being cleaned up asynchronously by AWS.
 //
 // This is implemented as an Operator (not a Preprocessor) because pre-detaching
 // Lambda@Edge associations from CloudFront before stack deletion does not reduce the
@@ -89,6 +91,14 @@ func (o *LambdaFunctionOperator) DeleteLambdaFunction(ctx context.Context, funct
 		return nil
 	}
 
+	if err := o.deleteProvisionedConcurrencyConfigs(ctx, functionName); err != nil {
+		return err
+	}
+
+	if err := deleteLambdaEventSourceMappings(ctx, o.client, functionName, nil); err != nil {
+		return err
+	}
+
 	err = o.client.DeleteFunction(ctx, functionName)
 	if err == nil {
 		return nil
@@ -122,53 +132,41 @@ func (o *LambdaFunctionOperator) DeleteLambdaFunction(ctx context.Context, funct
 	}
 }
 
-func (o *LambdaFunctionOperator) isReplicatedFunctionError(err error) bool {
-	return strings.Contains(err.Error(), "replicated function")
-}
-
-// cleanupOrphanLambdaENIsByFilter finds available ENIs whose description starts with
-// "AWS Lambda VPC ENI" and that match the given filter (e.g. subnet-id or group-id),
-// then deletes them in parallel. Used by EC2SubnetOperator / EC2SecurityGroupOperator
-// to unblock deletion when AWS Lambda has not yet released its VPC ENIs after the
-// function was already deleted. The helper lives here because it encodes Lambda VPC
-// ENI domain knowledge (description prefix, asynchronous release semantics), even
-// though it operates on EC2 APIs.
-func cleanupOrphanLambdaENIsByFilter(ctx context.Context, ec2Client client.IEC2, filterName, filterValue string) error {
-	filters := []ec2types.Filter{
-		{
-			Name:   aws.String(filterName),
-			Values: []string{filterValue},
-		},
-		{
-			Name:   aws.String("description"),
-			Values: []string{lambdaVPCENIDescriptionPrefix + "*"},
-		},
-		{
-			Name:   aws.String("status"),
-			Values: []string{string(ec2types.NetworkInterfaceStatusAvailable)},
-		},
-	}
-
-	enis, err := ec2Client.DescribeNetworkInterfaces(ctx, filters)
+func (o *LambdaFunctionOperator) deleteProvisionedConcurrencyConfigs(ctx context.Context, functionName *string) error {
+	configs, err := o.client.ListProvisionedConcurrencyConfigs(ctx, functionName)
 	if err != nil {
 		return err
 	}
-	if len(enis) == 0 {
-		return nil
-	}
 
 	eg, ctx := errgroup.WithContext(ctx)
 	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
-	for _, eni := range enis {
-		eniId := eni.NetworkInterfaceId
+
+	for _, config := range configs {
+		// The qualifier (alias or version) is the last part of the function ARN.
+		functionArn := aws.ToString(config.FunctionArn)
+		qualifier := functionArn[strings.LastIndex(functionArn, ":")+1:]
+
 		if err := sem.Acquire(ctx, 1); err != nil {
 			return err
 		}
 		eg.Go(func() error {
 			defer sem.Release(1)
-			return ec2Client.DeleteNetworkInterface(ctx, eniId)
+
+			return o.client.DeleteProvisionedConcurrencyConfig(ctx, functionName, aws.String(qualifier))
 		})
 	}
 
 	return eg.Wait()
 }
+
+func (o *LambdaFunctionOperator) isReplicatedFunctionError(err error) bool {
+	return strings.Contains(err.Error(), "replicated function")
+}
+
+// isLambdaVPCENI reports whether the ENI was provisioned by AWS Lambda for a VPC-attached function,
+// by its description prefix "AWS Lambda VPC ENI". These ENIs are always deleted by
+// cleanupOrphanENIsByFilter because AWS Lambda releases them asynchronously after the function was
+// deleted.
+func isLambdaVPCENI(eni ec2types.NetworkInterface) bool {
+	return strings.HasPrefix(aws.ToString(eni.Description), lambdaVPCENIDescriptionPrefix)
+}
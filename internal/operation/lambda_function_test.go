@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/go-to-k/delstack/internal/io"
 	"github.com/go-to-k/delstack/pkg/client"
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,8 @@ func TestLambdaFunctionOperator_DeleteLambdaFunction(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockILambda) {
 				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-function"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
 				m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-function")).Return(nil)
 			},
 			want:    nil,
@@ -53,11 +56,107 @@ func TestLambdaFunctionOperator_DeleteLambdaFunction(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockILambda) {
 				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-function"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
 				m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-function")).Return(fmt.Errorf("DeleteFunctionError"))
 			},
 			want:    fmt.Errorf("DeleteFunctionError"),
 			wantErr: true,
 		},
+		{
+			name: "delete lambda function successfully after deleting provisioned concurrency configs",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+			},
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return([]types.ProvisionedConcurrencyConfigListItem{
+					{
+						FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:test-function:live"),
+					},
+					{
+						FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:test-function:1"),
+					},
+				}, nil)
+				m.EXPECT().DeleteProvisionedConcurrencyConfig(gomock.Any(), aws.String("test-function"), aws.String("live")).Return(nil)
+				m.EXPECT().DeleteProvisionedConcurrencyConfig(gomock.Any(), aws.String("test-function"), aws.String("1")).Return(nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-function"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
+				m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-function")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete lambda function successfully after deleting event source mappings",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+			},
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-function"), nil).Return([]types.EventSourceMappingConfiguration{
+					{
+						UUID:  aws.String("uuid1"),
+						State: aws.String("Enabled"),
+					},
+					{
+						UUID:  aws.String("uuid2"),
+						State: aws.String("Deleting"),
+					},
+				}, nil)
+				m.EXPECT().DeleteEventSourceMapping(gomock.Any(), aws.String("uuid1")).Return(nil)
+				m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-function")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete lambda function failure for list provisioned concurrency configs errors",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+			},
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return(nil, fmt.Errorf("ListProvisionedConcurrencyConfigsError"))
+			},
+			want:    fmt.Errorf("ListProvisionedConcurrencyConfigsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete lambda function failure for delete provisioned concurrency config errors",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+			},
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return([]types.ProvisionedConcurrencyConfigListItem{
+					{
+						FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:test-function:live"),
+					},
+				}, nil)
+				m.EXPECT().DeleteProvisionedConcurrencyConfig(gomock.Any(), aws.String("test-function"), aws.String("live")).Return(fmt.Errorf("DeleteProvisionedConcurrencyConfigError"))
+			},
+			want:    fmt.Errorf("DeleteProvisionedConcurrencyConfigError"),
+			wantErr: true,
+		},
+		{
+			name: "delete lambda function failure for list event source mappings errors",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+			},
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-function"), nil).Return(nil, fmt.Errorf("ListEventSourceMappingsError"))
+			},
+			want:    fmt.Errorf("ListEventSourceMappingsError"),
+			wantErr: true,
+		},
 		{
 			name: "delete lambda function failure for check lambda function exists errors",
 			args: args{
@@ -90,6 +189,8 @@ func TestLambdaFunctionOperator_DeleteLambdaFunction(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockILambda) {
 				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-edge-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-edge-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-edge-function"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
 				gomock.InOrder(
 					m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-edge-function")).Return(fmt.Errorf("Lambda was unable to delete because it is a replicated function")),
 					m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-edge-function")).Return(nil),
@@ -106,6 +207,8 @@ func TestLambdaFunctionOperator_DeleteLambdaFunction(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockILambda) {
 				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-edge-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-edge-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-edge-function"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
 				gomock.InOrder(
 					m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-edge-function")).Return(fmt.Errorf("Lambda was unable to delete because it is a replicated function")),
 					m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-edge-function")).Return(fmt.Errorf("SomeOtherError")),
@@ -126,6 +229,8 @@ func TestLambdaFunctionOperator_DeleteLambdaFunction(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockILambda) {
 				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("test-edge-function")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("test-edge-function")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("test-edge-function"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
 				m.EXPECT().DeleteFunction(gomock.Any(), aws.String("test-edge-function")).Return(fmt.Errorf("Lambda was unable to delete because it is a replicated function"))
 			},
 			want:    fmt.Errorf("[resource test-edge-function] context canceled"),
@@ -176,6 +281,8 @@ func TestLambdaFunctionOperator_DeleteResourcesForLambdaFunction(t *testing.T) {
 			},
 			prepareMockFn: func(m *client.MockILambda) {
 				m.EXPECT().CheckLambdaFunctionExists(gomock.Any(), aws.String("PhysicalResourceId1")).Return(true, nil)
+				m.EXPECT().ListProvisionedConcurrencyConfigs(gomock.Any(), aws.String("PhysicalResourceId1")).Return([]types.ProvisionedConcurrencyConfigListItem{}, nil)
+				m.EXPECT().ListEventSourceMappings(gomock.Any(), aws.String("PhysicalResourceId1"), nil).Return([]types.EventSourceMappingConfiguration{}, nil)
 				m.EXPECT().DeleteFunction(gomock.Any(), aws.String("PhysicalResourceId1")).Return(nil)
 			},
 			want:    nil,
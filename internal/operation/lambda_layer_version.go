@@ -0,0 +1,70 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/preprocessor"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*LambdaLayerVersionOperator)(nil)
+
+// LambdaLayerVersionOperator deletes Lambda layer versions. The previous versions of the layer
+// that the stack published and retained on updates are deleted by LambdaLayerVersionCleaner in
+// force mode.
+type LambdaLayerVersionOperator struct {
+	client    client.ILambda
+	resources []*types.StackResourceSummary
+}
+
+func NewLambdaLayerVersionOperator(lambdaClient client.ILambda) *LambdaLayerVersionOperator {
+	return &LambdaLayerVersionOperator{
+		client:    lambdaClient,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *LambdaLayerVersionOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *LambdaLayerVersionOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *LambdaLayerVersionOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteLayerVersion(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+// DeleteLayerVersion deletes the layer version from its ARN (`arn:aws:lambda:<region>:<account>:layer:<name>:<version>`).
+func (o *LambdaLayerVersionOperator) DeleteLayerVersion(ctx context.Context, layerVersionArn *string) error {
+	layerArn, versionNumber, err := preprocessor.ParseLayerVersionArn(aws.ToString(layerVersionArn))
+	if err != nil {
+		return &client.ClientError{
+			ResourceName: layerVersionArn,
+			Err:          err,
+		}
+	}
+
+	return o.client.DeleteLayerVersion(ctx, aws.String(layerArn), versionNumber)
+}
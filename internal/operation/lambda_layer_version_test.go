@@ -0,0 +1,130 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestLambdaLayerVersionOperator_DeleteLayerVersion(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name            string
+		layerVersionArn *string
+		prepareMockFn   func(m *client.MockILambda)
+		want            error
+		wantErr         bool
+	}{
+		{
+			name:            "delete only the layer version successfully",
+			layerVersionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test:3"),
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test"), int64(3)).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:            "delete only the layer version failure",
+			layerVersionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test:3"),
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test"), int64(3)).Return(fmt.Errorf("DeleteLayerVersionError"))
+			},
+			want:    fmt.Errorf("DeleteLayerVersionError"),
+			wantErr: true,
+		},
+		{
+			name:            "delete layer version failure for invalid layer version arn",
+			layerVersionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test"),
+			prepareMockFn:   func(m *client.MockILambda) {},
+			want: &client.ClientError{
+				ResourceName: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test"),
+				Err:          fmt.Errorf("InvalidLayerVersionArnError: the version number is not found"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			lambdaMock := client.NewMockILambda(ctrl)
+			tt.prepareMockFn(lambdaMock)
+
+			lambdaLayerVersionOperator := NewLambdaLayerVersionOperator(lambdaMock)
+
+			err := lambdaLayerVersionOperator.DeleteLayerVersion(context.Background(), tt.layerVersionArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestLambdaLayerVersionOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockILambda)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test"), int64(1)).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockILambda) {
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test"), int64(1)).Return(fmt.Errorf("DeleteLayerVersionError"))
+			},
+			want:    fmt.Errorf("DeleteLayerVersionError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			lambdaMock := client.NewMockILambda(ctrl)
+			tt.prepareMockFn(lambdaMock)
+
+			lambdaLayerVersionOperator := NewLambdaLayerVersionOperator(lambdaMock)
+			lambdaLayerVersionOperator.AddResource(&types.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+				PhysicalResourceId: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test:1"),
+			})
+
+			err := lambdaLayerVersionOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*LogGroupOperator)(nil)
+
+// LogGroupOperator deletes log groups after removing the subscription filters, metric filters
+// and data protection policy that are often attached from outside the stack.
+// It is also used to delete the log groups that Lambda creates implicitly for the functions in
+// the stack (`/aws/lambda/<function name>`).
+type LogGroupOperator struct {
+	client    client.ICloudWatchLogs
+	resources []*types.StackResourceSummary
+	forceMode bool
+}
+
+func NewLogGroupOperator(logsClient client.ICloudWatchLogs) *LogGroupOperator {
+	return &LogGroupOperator{
+		client:    logsClient,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *LogGroupOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *LogGroupOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *LogGroupOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteLogGroup(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *LogGroupOperator) DeleteLogGroup(ctx context.Context, logGroupName *string) error {
+	exists, err := o.client.CheckLogGroupExists(ctx, logGroupName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	if o.forceMode {
+		protected, err := o.client.CheckLogGroupDeletionProtection(ctx, logGroupName)
+		if err != nil {
+			return err
+		}
+		if protected {
+			if err := o.client.DisableLogGroupDeletionProtection(ctx, logGroupName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := o.deleteSubscriptionFilters(ctx, logGroupName); err != nil {
+		return err
+	}
+
+	if err := o.deleteMetricFilters(ctx, logGroupName); err != nil {
+		return err
+	}
+
+	policy, err := o.client.GetDataProtectionPolicy(ctx, logGroupName)
+	if err != nil {
+		return err
+	}
+	if policy != nil {
+		if err := o.client.DeleteDataProtectionPolicy(ctx, logGroupName); err != nil {
+			return err
+		}
+	}
+
+	return o.client.DeleteLogGroup(ctx, logGroupName)
+}
+
+func (o *LogGroupOperator) deleteSubscriptionFilters(ctx context.Context, logGroupName *string) error {
+	filters, err := o.client.DescribeSubscriptionFilters(ctx, logGroupName)
+	if err != nil {
+		return err
+	}
+
+	// A log group has at most two subscription filters, so they are deleted sequentially.
+	for _, filter := range filters {
+		if err := o.client.DeleteSubscriptionFilter(ctx, logGroupName, filter.FilterName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *LogGroupOperator) deleteMetricFilters(ctx context.Context, logGroupName *string) error {
+	filters, err := o.client.DescribeMetricFilters(ctx, logGroupName)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, filter := range filters {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.client.DeleteMetricFilter(ctx, logGroupName, filter.FilterName)
+		})
+	}
+
+	return eg.Wait()
+}
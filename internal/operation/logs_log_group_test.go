@@ -0,0 +1,242 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestLogGroupOperator_DeleteLogGroup(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		forceMode     bool
+		prepareMockFn func(m *client.MockICloudWatchLogs)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete log group successfully",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("test")).Return([]types.MetricFilter{}, nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(nil, nil)
+				m.EXPECT().DeleteLogGroup(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete log group successfully for log group not exists",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete log group successfully after removing filters and data protection policy",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{
+					{
+						FilterName: aws.String("subscription1"),
+					},
+					{
+						FilterName: aws.String("subscription2"),
+					},
+				}, nil)
+				m.EXPECT().DeleteSubscriptionFilter(gomock.Any(), aws.String("test"), aws.String("subscription1")).Return(nil)
+				m.EXPECT().DeleteSubscriptionFilter(gomock.Any(), aws.String("test"), aws.String("subscription2")).Return(nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("test")).Return([]types.MetricFilter{
+					{
+						FilterName: aws.String("metric1"),
+					},
+					{
+						FilterName: aws.String("metric2"),
+					},
+				}, nil)
+				m.EXPECT().DeleteMetricFilter(gomock.Any(), aws.String("test"), aws.String("metric1")).Return(nil)
+				m.EXPECT().DeleteMetricFilter(gomock.Any(), aws.String("test"), aws.String("metric2")).Return(nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(aws.String("{}"), nil)
+				m.EXPECT().DeleteDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().DeleteLogGroup(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete log group successfully with deletion protection in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckLogGroupDeletionProtection(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DisableLogGroupDeletionProtection(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("test")).Return([]types.MetricFilter{}, nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(nil, nil)
+				m.EXPECT().DeleteLogGroup(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete log group failure for check log group exists errors",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeLogGroupsError"))
+			},
+			want:    fmt.Errorf("DescribeLogGroupsError"),
+			wantErr: true,
+		},
+		{
+			name:      "delete log group failure for disable deletion protection errors",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckLogGroupDeletionProtection(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DisableLogGroupDeletionProtection(gomock.Any(), aws.String("test")).Return(fmt.Errorf("PutLogGroupDeletionProtectionError"))
+			},
+			want:    fmt.Errorf("PutLogGroupDeletionProtectionError"),
+			wantErr: true,
+		},
+		{
+			name: "delete log group failure for delete subscription filter errors",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{
+					{
+						FilterName: aws.String("subscription1"),
+					},
+				}, nil)
+				m.EXPECT().DeleteSubscriptionFilter(gomock.Any(), aws.String("test"), aws.String("subscription1")).Return(fmt.Errorf("DeleteSubscriptionFilterError"))
+			},
+			want:    fmt.Errorf("DeleteSubscriptionFilterError"),
+			wantErr: true,
+		},
+		{
+			name: "delete log group failure for delete metric filter errors",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("test")).Return([]types.MetricFilter{
+					{
+						FilterName: aws.String("metric1"),
+					},
+				}, nil)
+				m.EXPECT().DeleteMetricFilter(gomock.Any(), aws.String("test"), aws.String("metric1")).Return(fmt.Errorf("DeleteMetricFilterError"))
+			},
+			want:    fmt.Errorf("DeleteMetricFilterError"),
+			wantErr: true,
+		},
+		{
+			name: "delete log group failure for delete data protection policy errors",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("test")).Return([]types.MetricFilter{}, nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(aws.String("{}"), nil)
+				m.EXPECT().DeleteDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteDataProtectionPolicyError"))
+			},
+			want:    fmt.Errorf("DeleteDataProtectionPolicyError"),
+			wantErr: true,
+		},
+		{
+			name: "delete log group failure for delete log group errors",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeSubscriptionFilters(gomock.Any(), aws.String("test")).Return([]types.SubscriptionFilter{}, nil)
+				m.EXPECT().DescribeMetricFilters(gomock.Any(), aws.String("test")).Return([]types.MetricFilter{}, nil)
+				m.EXPECT().GetDataProtectionPolicy(gomock.Any(), aws.String("test")).Return(nil, nil)
+				m.EXPECT().DeleteLogGroup(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteLogGroupError"))
+			},
+			want:    fmt.Errorf("DeleteLogGroupError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logsMock := client.NewMockICloudWatchLogs(ctrl)
+			tt.prepareMockFn(logsMock)
+
+			logGroupOperator := NewLogGroupOperator(logsMock)
+			logGroupOperator.forceMode = tt.forceMode
+
+			err := logGroupOperator.DeleteLogGroup(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestLogGroupOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockICloudWatchLogs)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockICloudWatchLogs) {
+				m.EXPECT().CheckLogGroupExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeLogGroupsError"))
+			},
+			want:    fmt.Errorf("DescribeLogGroupsError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logsMock := client.NewMockICloudWatchLogs(ctrl)
+			tt.prepareMockFn(logsMock)
+
+			logGroupOperator := NewLogGroupOperator(logsMock)
+			logGroupOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::Logs::LogGroup"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := logGroupOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	opensearchtypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	openSearchDomainRetryInterval = 30 * time.Second
+
+	// openSearchDomainMaxRetryCount bounds each wait for the domain processing state, a package
+	// dissociation, the VPC endpoint deletion, and the domain deletion (about 30 minutes with the
+	// default interval).
+	openSearchDomainMaxRetryCount = 60
+)
+
+var _ IOperator = (*OpenSearchDomainOperator)(nil)
+
+// OpenSearchDomainOperator deletes OpenSearch Service domains that fail to delete or hang because
+// packages are associated or OpenSearch-managed VPC endpoints were created outside the stack.
+//
+// The packages are dissociated one by one (the domain only accepts one package operation at a time),
+// the VPC endpoints are deleted, and then the domain is deleted.
+type OpenSearchDomainOperator struct {
+	client    client.IOpenSearch
+	resources []*types.StackResourceSummary
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+}
+
+func NewOpenSearchDomainOperator(openSearchClient client.IOpenSearch) *OpenSearchDomainOperator {
+	return &OpenSearchDomainOperator{
+		client:        openSearchClient,
+		resources:     []*types.StackResourceSummary{},
+		retryInterval: openSearchDomainRetryInterval,
+	}
+}
+
+func (o *OpenSearchDomainOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *OpenSearchDomainOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *OpenSearchDomainOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, domain := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteDomain(ctx, domain.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *OpenSearchDomainOperator) DeleteDomain(ctx context.Context, domainName *string) error {
+	exists, err := o.client.CheckDomainExists(ctx, domainName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	domain, err := o.client.DescribeDomain(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	if !aws.ToBool(domain.Deleted) {
+		if err := o.dissociatePackages(ctx, domainName); err != nil {
+			return err
+		}
+		if err := o.deleteVpcEndpoints(ctx, domainName); err != nil {
+			return err
+		}
+		if err := o.client.DeleteDomain(ctx, domainName); err != nil {
+			return err
+		}
+	}
+
+	return waitUntil(ctx, domainName, o.retryInterval, openSearchDomainMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckDomainExists(ctx, domainName)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "DomainDeletionTimeoutError: the domain is still being deleted")
+}
+
+func (o *OpenSearchDomainOperator) dissociatePackages(ctx context.Context, domainName *string) error {
+	packages, err := o.client.ListPackagesForDomain(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	for _, domainPackage := range packages {
+		if err := o.waitForDomainNotProcessing(ctx, domainName); err != nil {
+			return err
+		}
+
+		if domainPackage.DomainPackageStatus != opensearchtypes.DomainPackageStatusDissociating {
+			if err := o.client.DissociatePackage(ctx, domainName, domainPackage.PackageID); err != nil {
+				return err
+			}
+		}
+
+		if err := waitUntil(ctx, domainName, o.retryInterval, openSearchDomainMaxRetryCount, func() (bool, error) {
+			packages, err := o.client.ListPackagesForDomain(ctx, domainName)
+			if err != nil {
+				return false, err
+			}
+			for _, p := range packages {
+				if aws.ToString(p.PackageID) == aws.ToString(domainPackage.PackageID) {
+					return false, nil
+				}
+			}
+			return true, nil
+		}, fmt.Sprintf("PackageDissociationTimeoutError: the package %s is still associated", aws.ToString(domainPackage.PackageID))); err != nil {
+			return err
+		}
+
+		io.Logger.Debug().Msgf("Dissociated the package %s from the domain %s", aws.ToString(domainPackage.PackageName), aws.ToString(domainName))
+	}
+
+	return nil
+}
+
+func (o *OpenSearchDomainOperator) deleteVpcEndpoints(ctx context.Context, domainName *string) error {
+	vpcEndpoints, err := o.client.ListVpcEndpointsForDomain(ctx, domainName)
+	if err != nil {
+		return err
+	}
+	if len(vpcEndpoints) == 0 {
+		return nil
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, vpcEndpoint := range vpcEndpoints {
+		if vpcEndpoint.Status == opensearchtypes.VpcEndpointStatusDeleting {
+			continue
+		}
+		eg.Go(func() error {
+			if err := o.client.DeleteVpcEndpoint(egCtx, vpcEndpoint.VpcEndpointId); err != nil {
+				return err
+			}
+			io.Logger.Debug().Msgf("Deleted the VPC endpoint %s owned by %s for the domain %s",
+				aws.ToString(vpcEndpoint.VpcEndpointId), aws.ToString(vpcEndpoint.VpcEndpointOwner), aws.ToString(domainName))
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return waitUntil(ctx, domainName, o.retryInterval, openSearchDomainMaxRetryCount, func() (bool, error) {
+		vpcEndpoints, err := o.client.ListVpcEndpointsForDomain(ctx, domainName)
+		if err != nil {
+			return false, err
+		}
+		return len(vpcEndpoints) == 0, nil
+	}, "VpcEndpointDeletionTimeoutError: the VPC endpoints of the domain are still being deleted")
+}
+
+func (o *OpenSearchDomainOperator) waitForDomainNotProcessing(ctx context.Context, domainName *string) error {
+	return waitUntil(ctx, domainName, o.retryInterval, openSearchDomainMaxRetryCount, func() (bool, error) {
+		domain, err := o.client.DescribeDomain(ctx, domainName)
+		if err != nil {
+			return false, err
+		}
+		return !aws.ToBool(domain.Processing) && !aws.ToBool(domain.UpgradeProcessing), nil
+	}, "DomainProcessingTimeoutError: the domain is still processing")
+}
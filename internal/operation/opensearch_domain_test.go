@@ -0,0 +1,289 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestOpenSearchDomainOperator_DeleteDomain(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIOpenSearch)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete domain successfully",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{}, nil)
+				m.EXPECT().ListVpcEndpointsForDomain(gomock.Any(), aws.String("test")).Return([]types.VpcEndpointSummary{}, nil)
+				m.EXPECT().DeleteDomain(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain successfully for domain not exists",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain successfully for domain already being deleted",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{
+					Deleted: aws.Bool(true),
+				}, nil)
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain successfully after dissociating packages and deleting vpc endpoints",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{
+					{
+						PackageID:           aws.String("package1"),
+						PackageName:         aws.String("PackageName1"),
+						DomainPackageStatus: types.DomainPackageStatusActive,
+					},
+					{
+						PackageID:           aws.String("package2"),
+						PackageName:         aws.String("PackageName2"),
+						DomainPackageStatus: types.DomainPackageStatusDissociating,
+					},
+				}, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{
+					Processing: aws.Bool(true),
+				}, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{
+					Processing: aws.Bool(false),
+				}, nil)
+				m.EXPECT().DissociatePackage(gomock.Any(), aws.String("test"), aws.String("package1")).Return(nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{
+					{
+						PackageID:           aws.String("package2"),
+						DomainPackageStatus: types.DomainPackageStatusDissociating,
+					},
+				}, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{}, nil)
+				m.EXPECT().ListVpcEndpointsForDomain(gomock.Any(), aws.String("test")).Return([]types.VpcEndpointSummary{
+					{
+						VpcEndpointId:    aws.String("endpoint1"),
+						VpcEndpointOwner: aws.String("123456789012"),
+						Status:           types.VpcEndpointStatusActive,
+					},
+					{
+						VpcEndpointId:    aws.String("endpoint2"),
+						VpcEndpointOwner: aws.String("123456789012"),
+						Status:           types.VpcEndpointStatusDeleting,
+					},
+				}, nil)
+				m.EXPECT().DeleteVpcEndpoint(gomock.Any(), aws.String("endpoint1")).Return(nil)
+				m.EXPECT().ListVpcEndpointsForDomain(gomock.Any(), aws.String("test")).Return([]types.VpcEndpointSummary{}, nil)
+				m.EXPECT().DeleteDomain(gomock.Any(), aws.String("test")).Return(nil)
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain failure for check domain exists errors",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeDomainError"))
+			},
+			want:    fmt.Errorf("DescribeDomainError"),
+			wantErr: true,
+		},
+		{
+			name: "delete domain failure for list packages errors",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListPackagesForDomainError"))
+			},
+			want:    fmt.Errorf("ListPackagesForDomainError"),
+			wantErr: true,
+		},
+		{
+			name: "delete domain failure for dissociate package errors",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{
+					{
+						PackageID:           aws.String("package1"),
+						DomainPackageStatus: types.DomainPackageStatusActive,
+					},
+				}, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().DissociatePackage(gomock.Any(), aws.String("test"), aws.String("package1")).Return(fmt.Errorf("DissociatePackageError"))
+			},
+			want:    fmt.Errorf("DissociatePackageError"),
+			wantErr: true,
+		},
+		{
+			name: "delete domain failure for domain processing timeout",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{
+					{
+						PackageID:           aws.String("package1"),
+						DomainPackageStatus: types.DomainPackageStatusActive,
+					},
+				}, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{
+					Processing: aws.Bool(true),
+				}, nil).Times(openSearchDomainMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("DomainProcessingTimeoutError: the domain is still processing"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete domain failure for delete vpc endpoint errors",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{}, nil)
+				m.EXPECT().ListVpcEndpointsForDomain(gomock.Any(), aws.String("test")).Return([]types.VpcEndpointSummary{
+					{
+						VpcEndpointId: aws.String("endpoint1"),
+						Status:        types.VpcEndpointStatusActive,
+					},
+				}, nil)
+				m.EXPECT().DeleteVpcEndpoint(gomock.Any(), aws.String("endpoint1")).Return(fmt.Errorf("DeleteVpcEndpointError"))
+			},
+			want:    fmt.Errorf("DeleteVpcEndpointError"),
+			wantErr: true,
+		},
+		{
+			name: "delete domain failure for delete domain errors",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{}, nil)
+				m.EXPECT().ListPackagesForDomain(gomock.Any(), aws.String("test")).Return([]types.DomainPackageDetails{}, nil)
+				m.EXPECT().ListVpcEndpointsForDomain(gomock.Any(), aws.String("test")).Return([]types.VpcEndpointSummary{}, nil)
+				m.EXPECT().DeleteDomain(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteDomainError"))
+			},
+			want:    fmt.Errorf("DeleteDomainError"),
+			wantErr: true,
+		},
+		{
+			name: "delete domain failure for deletion timeout",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDomain(gomock.Any(), aws.String("test")).Return(&types.DomainStatus{
+					Deleted: aws.Bool(true),
+				}, nil)
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(true, nil).Times(openSearchDomainMaxRetryCount + 1)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("DomainDeletionTimeoutError: the domain is still being deleted"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			openSearchMock := client.NewMockIOpenSearch(ctrl)
+			tt.prepareMockFn(openSearchMock)
+
+			openSearchDomainOperator := NewOpenSearchDomainOperator(openSearchMock)
+			openSearchDomainOperator.retryInterval = 0
+
+			err := openSearchDomainOperator.DeleteDomain(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestOpenSearchDomainOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIOpenSearch)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIOpenSearch) {
+				m.EXPECT().CheckDomainExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeDomainError"))
+			},
+			want:    fmt.Errorf("DescribeDomainError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			openSearchMock := client.NewMockIOpenSearch(ctrl)
+			tt.prepareMockFn(openSearchMock)
+
+			openSearchDomainOperator := NewOpenSearchDomainOperator(openSearchMock)
+			openSearchDomainOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::OpenSearchService::Domain"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := openSearchDomainOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -2,17 +2,16 @@
 package operation
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/go-to-k/delstack/internal/io"
-	"github.com/go-to-k/delstack/internal/resourcetype"
 )
 
 type IOperatorCollection interface {
-	SetOperatorCollection(stackName *string, stackResourceSummaries []types.StackResourceSummary)
+	SetOperatorCollection(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary)
 	GetLogicalResourceIds() []string
 	GetOperators() []IOperator
 	RaiseUnsupportedResourceError() error
@@ -34,7 +33,7 @@ func NewOperatorCollection(config aws.Config, operatorFactory *OperatorFactory)
 	}
 }
 
-func (c *OperatorCollection) SetOperatorCollection(stackName *string, stackResourceSummaries []types.StackResourceSummary) {
+func (c *OperatorCollection) SetOperatorCollection(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {
 	c.stackName = aws.ToString(stackName)
 
 	// Reset for each cloudformation delete stack loop
@@ -42,22 +41,20 @@ func (c *OperatorCollection) SetOperatorCollection(stackName *string, stackResou
 	c.unsupportedStackResources = []types.StackResourceSummary{}
 	c.operators = []IOperator{}
 
-	s3BucketOperator := c.operatorFactory.CreateS3BucketOperator()
-	s3DirectoryBucketOperator := c.operatorFactory.CreateS3DirectoryBucketOperator()
-	s3TableBucketOperator := c.operatorFactory.CreateS3TableBucketOperator()
-	S3TableNamespaceOperator := c.operatorFactory.CreateS3TableNamespaceOperator()
-	s3VectorBucketOperator := c.operatorFactory.CreateS3VectorBucketOperator()
-	iamGroupOperator := c.operatorFactory.CreateIamGroupOperator()
-	iamUserOperator := c.operatorFactory.CreateIamUserOperator()
-	ecrRepositoryOperator := c.operatorFactory.CreateEcrRepositoryOperator()
-	backupVaultOperator := c.operatorFactory.CreateBackupVaultOperator()
-	athenaWorkGroupOperator := c.operatorFactory.CreateAthenaWorkGroupOperator()
-	ec2SubnetOperator := c.operatorFactory.CreateEC2SubnetOperator()
-	ec2SecurityGroupOperator := c.operatorFactory.CreateEC2SecurityGroupOperator()
-	lambdaFunctionOperator := c.operatorFactory.CreateLambdaFunctionOperator()
-	cognitoUserPoolUICustomizationAttachmentOperator := c.operatorFactory.CreateCognitoUserPoolUICustomizationAttachmentOperator()
-	cloudformationStackOperator := c.operatorFactory.CreateCloudFormationStackOperator()
-	customOperator := c.operatorFactory.CreateCustomOperator()
+	// The operators are created in the registry order, and nil is kept for the registrations
+	// without an operator so that the indices match the registry.
+	registeredOperators := make([]IOperator, len(OperatorRegistry))
+	for i, registration := range OperatorRegistry {
+		if registration.Create != nil {
+			registeredOperators[i] = registration.Create(c.operatorFactory)
+		}
+	}
+
+	// Plugins take precedence over the built-in operators, so that in-house custom resources can be
+	// cleaned up instead of being left to the custom operator. They are loaded here for the first time
+	// in a run, as this is called only after a stack deletion has failed.
+	pluginOperators := c.operatorFactory.CreatePluginOperators(ctx)
+	retainOperator := c.operatorFactory.CreateRetainOperator(c.stackName)
 
 	for _, resource := range stackResourceSummaries {
 		if resource.ResourceStatus != "DELETE_FAILED" {
@@ -66,75 +63,57 @@ func (c *OperatorCollection) SetOperatorCollection(stackName *string, stackResou
 
 		c.logicalResourceIds = append(c.logicalResourceIds, aws.ToString(resource.LogicalResourceId))
 
-		if !c.containsResourceType(*resource.ResourceType) {
+		if pluginOperator := c.findPluginOperator(pluginOperators, *resource.ResourceType); pluginOperator != nil {
+			pluginOperator.AddResource(&resource)
+			continue
+		}
+
+		index, ok := c.findRegistration(*resource.ResourceType)
+		if !ok && c.operatorFactory.options.RetainPolicy.Matches(*resource.ResourceType) {
+			retainOperator.AddResource(&resource)
+			continue
+		}
+		if !ok {
 			c.unsupportedStackResources = append(c.unsupportedStackResources, resource)
-		} else {
-			switch *resource.ResourceType {
-			case resourcetype.S3Bucket:
-				s3BucketOperator.AddResource(&resource)
-			case resourcetype.S3DirectoryBucket:
-				s3DirectoryBucketOperator.AddResource(&resource)
-			case resourcetype.S3TableBucket:
-				s3TableBucketOperator.AddResource(&resource)
-			case resourcetype.S3TableNamespace:
-				S3TableNamespaceOperator.AddResource(&resource)
-			case resourcetype.S3VectorBucket:
-				s3VectorBucketOperator.AddResource(&resource)
-			case resourcetype.IamGroup:
-				iamGroupOperator.AddResource(&resource)
-			case resourcetype.IamUser:
-				iamUserOperator.AddResource(&resource)
-			case resourcetype.EcrRepository:
-				ecrRepositoryOperator.AddResource(&resource)
-			case resourcetype.BackupVault:
-				backupVaultOperator.AddResource(&resource)
-			case resourcetype.AthenaWorkGroup:
-				athenaWorkGroupOperator.AddResource(&resource)
-			case resourcetype.EC2Subnet:
-				ec2SubnetOperator.AddResource(&resource)
-			case resourcetype.EC2SecurityGroup:
-				ec2SecurityGroupOperator.AddResource(&resource)
-			case resourcetype.LambdaFunction:
-				lambdaFunctionOperator.AddResource(&resource)
-			case resourcetype.CognitoUserPoolUICustomizationAttachment:
-				cognitoUserPoolUICustomizationAttachmentOperator.AddResource(&resource)
-			case resourcetype.CloudformationStack:
-				cloudformationStackOperator.AddResource(&resource)
-			case resourcetype.CloudformationCustomResource:
-				customOperator.AddResource(&resource)
-			default:
-				if strings.Contains(*resource.ResourceType, resourcetype.CustomResource) {
-					customOperator.AddResource(&resource)
-				}
-			}
+			continue
 		}
+		registeredOperators[index].AddResource(&resource)
 	}
 
-	c.operators = append(c.operators, s3BucketOperator)
-	c.operators = append(c.operators, s3DirectoryBucketOperator)
-	c.operators = append(c.operators, s3TableBucketOperator)
-	c.operators = append(c.operators, S3TableNamespaceOperator)
-	c.operators = append(c.operators, s3VectorBucketOperator)
-	c.operators = append(c.operators, iamGroupOperator)
-	c.operators = append(c.operators, iamUserOperator)
-	c.operators = append(c.operators, ecrRepositoryOperator)
-	c.operators = append(c.operators, backupVaultOperator)
-	c.operators = append(c.operators, athenaWorkGroupOperator)
-	c.operators = append(c.operators, ec2SubnetOperator)
-	c.operators = append(c.operators, ec2SecurityGroupOperator)
-	c.operators = append(c.operators, lambdaFunctionOperator)
-	c.operators = append(c.operators, cognitoUserPoolUICustomizationAttachmentOperator)
-	c.operators = append(c.operators, cloudformationStackOperator)
-	c.operators = append(c.operators, customOperator)
+	for _, operator := range registeredOperators {
+		if operator != nil {
+			c.operators = append(c.operators, operator)
+		}
+	}
+	for _, pluginOperator := range pluginOperators {
+		c.operators = append(c.operators, pluginOperator)
+	}
+	c.operators = append(c.operators, retainOperator)
+}
+
+// findPluginOperator returns the operator of the first plugin that handles the resource type.
+func (c *OperatorCollection) findPluginOperator(pluginOperators []*PluginOperator, resourceType string) *PluginOperator {
+	for _, pluginOperator := range pluginOperators {
+		if pluginOperator.plugin.Matches(resourceType) {
+			return pluginOperator
+		}
+	}
+	return nil
 }
 
-func (c *OperatorCollection) containsResourceType(resource string) bool {
-	for _, t := range resourcetype.ResourceTypes {
-		if t == resource || (t == resourcetype.CustomResource && strings.Contains(resource, resourcetype.CustomResource)) {
-			return true
+// findRegistration returns the index of the registration whose operator force-deletes the resource type.
+func (c *OperatorCollection) findRegistration(resourceType string) (int, bool) {
+	for i, registration := range OperatorRegistry {
+		if registration.Create == nil {
+			continue
+		}
+		for _, resource := range registration.Resources {
+			if resource.Matches(resourceType) {
+				return i, true
+			}
 		}
 	}
-	return false
+	return 0, false
 }
 
 func (c *OperatorCollection) GetLogicalResourceIds() []string {
@@ -162,24 +141,9 @@ func (c *OperatorCollection) RaiseUnsupportedResourceError() error {
 	unsupportedStackResources := "\nThese are the resources unsupported, so failed delete:\n" + *unsupportedTable
 
 	supportedStackResourcesHeader := []string{"ResourceType", "Description"}
-	supportedStackResourcesData := [][]string{
-		{resourcetype.S3Bucket, "S3 Buckets, including buckets with Non-empty or Versioning enabled and DeletionPolicy not Retain."},
-		{resourcetype.S3DirectoryBucket, "S3 Directory Buckets for S3 Express One Zone, including buckets with Non-empty and DeletionPolicy not Retain."},
-		{resourcetype.S3TableBucket, "S3 Table Buckets, including buckets with any namespaces or tables and DeletionPolicy not Retain."},
-		{resourcetype.S3TableNamespace, "S3 Table Namespaces, including namespaces with any tables and DeletionPolicy not Retain."},
-		{resourcetype.S3VectorBucket, "S3 Vector Buckets, including buckets with any indexes and DeletionPolicy not Retain."},
-		{resourcetype.IamGroup, "IAM Groups, including groups with IAM users from outside the stack."},
-		{resourcetype.IamUser, "IAM Users, including users with policies, MFA devices, access keys, login profiles, or other dependencies from outside the stack."},
-		{resourcetype.EcrRepository, "ECR Repositories, including repositories that contain images and where the `EmptyOnDelete` is not true."},
-		{resourcetype.BackupVault, "Backup Vaults, including vaults containing recovery points."},
-		{resourcetype.AthenaWorkGroup, "Athena WorkGroups, including workgroups containing named queries or prepared statements."},
-		{resourcetype.EC2Subnet, "EC2 Subnets blocked by orphan AWS Lambda VPC ENIs left in `available` state after the function was deleted."},
-		{resourcetype.EC2SecurityGroup, "EC2 SecurityGroups blocked by orphan AWS Lambda VPC ENIs left in `available` state after the function was deleted."},
-		{resourcetype.LambdaFunction, "Lambda Functions, including Lambda@Edge functions with replicas still being cleaned up by AWS. Waits for AWS to finish removing edge replicas."},
-		{resourcetype.CognitoUserPoolUICustomizationAttachment, "Cognito UserPool UI Customization Attachments left in DELETE_FAILED as phantoms (e.g. a failed create with no UserPoolDomain), where no actual customization exists in AWS."},
-		{resourcetype.CloudformationStack, "Nested Child Stacks that failed to delete."},
-		{resourcetype.CloudformationCustomResource, "Custom Resources (AWS::CloudFormation::CustomResource), including resources that do not return a SUCCESS status."},
-		{"Custom::Xxx", "Custom Resources (Custom::Xxx), including resources that do not return a SUCCESS status."},
+	supportedStackResourcesData := [][]string{}
+	for _, resource := range SupportedResources() {
+		supportedStackResourcesData = append(supportedStackResourcesData, []string{resource.DisplayName(), PlainText(resource.Description)})
 	}
 
 	supportedTable, err := io.ToStringAsTableFormat(supportedStackResourcesHeader, supportedStackResourcesData)
@@ -188,7 +152,7 @@ func (c *OperatorCollection) RaiseUnsupportedResourceError() error {
 	}
 	supportedStackResources := "\nSupported resources for force deletion of DELETE_FAILED resources are followings.\n" + *supportedTable
 
-	issueLink := "\nIf you want to delete the unsupported resources, please create an issue at GitHub(https://github.com/go-to-k/delstack/issues).\n"
+	issueLink := "\nIf you want to delete the unsupported resources, please create an issue at GitHub(https://github.com/go-to-k/delstack/issues), handle them with a plugin (" + PluginPrefix + "<name>), or retain them from the stack with the --retainUnsupported option.\n"
 
 	unsupportedResourceError := title + unsupportedStackResources + supportedStackResources + issueLink
 
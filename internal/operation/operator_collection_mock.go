@@ -9,6 +9,7 @@
 package operation
 
 import (
+	context "context"
 	reflect "reflect"
 
 	types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -82,13 +83,13 @@ func (mr *MockIOperatorCollectionMockRecorder) RaiseUnsupportedResourceError() *
 }
 
 // SetOperatorCollection mocks base method.
-func (m *MockIOperatorCollection) SetOperatorCollection(stackName *string, stackResourceSummaries []types.StackResourceSummary) {
+func (m *MockIOperatorCollection) SetOperatorCollection(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetOperatorCollection", stackName, stackResourceSummaries)
+	m.ctrl.Call(m, "SetOperatorCollection", ctx, stackName, stackResourceSummaries)
 }
 
 // SetOperatorCollection indicates an expected call of SetOperatorCollection.
-func (mr *MockIOperatorCollectionMockRecorder) SetOperatorCollection(stackName, stackResourceSummaries any) *gomock.Call {
+func (mr *MockIOperatorCollectionMockRecorder) SetOperatorCollection(ctx, stackName, stackResourceSummaries any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperatorCollection", reflect.TypeOf((*MockIOperatorCollection)(nil).SetOperatorCollection), stackName, stackResourceSummaries)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperatorCollection", reflect.TypeOf((*MockIOperatorCollection)(nil).SetOperatorCollection), ctx, stackName, stackResourceSummaries)
 }
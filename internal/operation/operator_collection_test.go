@@ -41,6 +41,26 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 		lambdaFunctionOperatorResourcesLength                           int
 		cognitoUserPoolUICustomizationAttachmentOperatorResourcesLength int
 		cloudformationStackOperatorResourcesLength                      int
+		ecrPublicRepositoryOperatorResourcesLength                      int
+		apiGatewayDomainNameOperatorResourcesLength                     int
+		apiGatewayV2DomainNameOperatorResourcesLength                   int
+		acmCertificateOperatorResourcesLength                           int
+		kinesisStreamOperatorResourcesLength                            int
+		firehoseDeliveryStreamOperatorResourcesLength                   int
+		dynamoDBTableOperatorResourcesLength                            int
+		elastiCacheReplicationGroupOperatorResourcesLength              int
+		elastiCacheServerlessCacheOperatorResourcesLength               int
+		openSearchDomainOperatorResourcesLength                         int
+		logGroupOperatorResourcesLength                                 int
+		snsTopicOperatorResourcesLength                                 int
+		sqsQueueOperatorResourcesLength                                 int
+		stepFunctionsStateMachineOperatorResourcesLength                int
+		cognitoUserPoolOperatorResourcesLength                          int
+		cognitoUserPoolDomainOperatorResourcesLength                    int
+		lambdaLayerVersionOperatorResourcesLength                       int
+		rdsDBClusterOperatorResourcesLength                             int
+		ec2TransitGatewayOperatorResourcesLength                        int
+		ec2VPCEndpointServiceOperatorResourcesLength                    int
 		customOperatorResourcesLength                                   int
 	}
 
@@ -157,10 +177,136 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 						ResourceType:       aws.String("AWS::Cognito::UserPoolUICustomizationAttachment"),
 						PhysicalResourceId: aws.String("PhysicalResourceId17"),
 					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId18"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::ECR::PublicRepository"),
+						PhysicalResourceId: aws.String("PhysicalResourceId18"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId19"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::ApiGateway::DomainName"),
+						PhysicalResourceId: aws.String("example.com"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId20"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::ApiGatewayV2::DomainName"),
+						PhysicalResourceId: aws.String("api.example.com"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId21"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::CertificateManager::Certificate"),
+						PhysicalResourceId: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId22"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::Kinesis::Stream"),
+						PhysicalResourceId: aws.String("test-stream"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId23"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::KinesisFirehose::DeliveryStream"),
+						PhysicalResourceId: aws.String("test-delivery-stream"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId24"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						PhysicalResourceId: aws.String("test-table"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId25"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::DynamoDB::GlobalTable"),
+						PhysicalResourceId: aws.String("test-global-table"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId26"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::ElastiCache::ReplicationGroup"),
+						PhysicalResourceId: aws.String("test-replication-group"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId27"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::ElastiCache::ServerlessCache"),
+						PhysicalResourceId: aws.String("test-serverless-cache"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId28"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::OpenSearchService::Domain"),
+						PhysicalResourceId: aws.String("test-domain"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId29"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::Logs::LogGroup"),
+						PhysicalResourceId: aws.String("/aws/test/log-group"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId30"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::SNS::Topic"),
+						PhysicalResourceId: aws.String("arn:aws:sns:us-east-1:123456789012:test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId31"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::SQS::Queue"),
+						PhysicalResourceId: aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId32"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+						PhysicalResourceId: aws.String("arn:aws:states:us-east-1:123456789012:stateMachine:test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId33"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::Cognito::UserPool"),
+						PhysicalResourceId: aws.String("test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId34"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::Cognito::UserPoolDomain"),
+						PhysicalResourceId: aws.String("test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId35"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String("arn:aws:lambda:us-east-1:123456789012:layer:test:1"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId36"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::RDS::DBCluster"),
+						PhysicalResourceId: aws.String("test"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId37"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::EC2::TransitGateway"),
+						PhysicalResourceId: aws.String("tgw-0123456789abcdef0"),
+					},
+					{
+						LogicalResourceId:  aws.String("LogicalResourceId38"),
+						ResourceStatus:     "DELETE_FAILED",
+						ResourceType:       aws.String("AWS::EC2::VPCEndpointService"),
+						PhysicalResourceId: aws.String("vpce-svc-0123456789abcdef0"),
+					},
 				},
 			},
 			want: want{
-				logicalResourceIdsLength:                                        17,
+				logicalResourceIdsLength:                                        38,
 				unsupportedStackResourcesLength:                                 0,
 				s3BucketOperatorResourcesLength:                                 1,
 				s3DirectoryBucketOperatorResourcesLength:                        1,
@@ -177,6 +323,26 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 				lambdaFunctionOperatorResourcesLength:                           1,
 				cognitoUserPoolUICustomizationAttachmentOperatorResourcesLength: 1,
 				cloudformationStackOperatorResourcesLength:                      1,
+				ecrPublicRepositoryOperatorResourcesLength:                      1,
+				apiGatewayDomainNameOperatorResourcesLength:                     1,
+				apiGatewayV2DomainNameOperatorResourcesLength:                   1,
+				acmCertificateOperatorResourcesLength:                           1,
+				kinesisStreamOperatorResourcesLength:                            1,
+				firehoseDeliveryStreamOperatorResourcesLength:                   1,
+				dynamoDBTableOperatorResourcesLength:                            2,
+				elastiCacheReplicationGroupOperatorResourcesLength:              1,
+				elastiCacheServerlessCacheOperatorResourcesLength:               1,
+				openSearchDomainOperatorResourcesLength:                         1,
+				logGroupOperatorResourcesLength:                                 1,
+				snsTopicOperatorResourcesLength:                                 1,
+				sqsQueueOperatorResourcesLength:                                 1,
+				stepFunctionsStateMachineOperatorResourcesLength:                1,
+				cognitoUserPoolOperatorResourcesLength:                          1,
+				cognitoUserPoolDomainOperatorResourcesLength:                    1,
+				lambdaLayerVersionOperatorResourcesLength:                       1,
+				rdsDBClusterOperatorResourcesLength:                             1,
+				ec2TransitGatewayOperatorResourcesLength:                        1,
+				ec2VPCEndpointServiceOperatorResourcesLength:                    1,
 				customOperatorResourcesLength:                                   2,
 			},
 		},
@@ -195,7 +361,7 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId2"),
 						ResourceStatus:     "DELETE_FAILED",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId2"),
 					},
 				},
@@ -241,13 +407,13 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId3"),
 						ResourceStatus:     "DELETE_FAILED",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId3"),
 					},
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId4"),
 						ResourceStatus:     "DELETE_FAILED",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId4"),
 					},
 				},
@@ -287,7 +453,7 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId2"),
 						ResourceStatus:     "DELETE_FAILED",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId2"),
 					},
 				},
@@ -333,13 +499,13 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId3"),
 						ResourceStatus:     "DELETE_FAILED",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId3"),
 					},
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId4"),
 						ResourceStatus:     "DELETE_FAILED",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId4"),
 					},
 				},
@@ -379,7 +545,7 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId2"),
 						ResourceStatus:     "DELETE_COMPLETE",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId2"),
 					},
 				},
@@ -425,13 +591,13 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId3"),
 						ResourceStatus:     "DELETE_COMPLETE",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId3"),
 					},
 					{
 						LogicalResourceId:  aws.String("LogicalResourceId4"),
 						ResourceStatus:     "DELETE_COMPLETE",
-						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						ResourceType:       aws.String("AWS::EC2::VPC"),
 						PhysicalResourceId: aws.String("PhysicalResourceId4"),
 					},
 				},
@@ -461,10 +627,10 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
 			config := aws.Config{}
-			operatorFactory := NewOperatorFactory(config, false)
+			operatorFactory := NewOperatorFactory(config, Options{})
 			operatorCollection := NewOperatorCollection(config, operatorFactory)
 
-			operatorCollection.SetOperatorCollection(tt.args.stackName, tt.args.stackResourceSummaries)
+			operatorCollection.SetOperatorCollection(context.Background(), tt.args.stackName, tt.args.stackResourceSummaries)
 
 			s3BucketOperatorResourcesLength := 0
 			s3DirectoryBucketOperatorResourcesLength := 0
@@ -481,6 +647,26 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 			lambdaFunctionOperatorResourcesLength := 0
 			cognitoUserPoolUICustomizationAttachmentOperatorResourcesLength := 0
 			cloudformationStackOperatorResourcesLength := 0
+			ecrPublicRepositoryOperatorResourcesLength := 0
+			apiGatewayDomainNameOperatorResourcesLength := 0
+			apiGatewayV2DomainNameOperatorResourcesLength := 0
+			acmCertificateOperatorResourcesLength := 0
+			kinesisStreamOperatorResourcesLength := 0
+			firehoseDeliveryStreamOperatorResourcesLength := 0
+			dynamoDBTableOperatorResourcesLength := 0
+			elastiCacheReplicationGroupOperatorResourcesLength := 0
+			elastiCacheServerlessCacheOperatorResourcesLength := 0
+			openSearchDomainOperatorResourcesLength := 0
+			logGroupOperatorResourcesLength := 0
+			snsTopicOperatorResourcesLength := 0
+			sqsQueueOperatorResourcesLength := 0
+			stepFunctionsStateMachineOperatorResourcesLength := 0
+			cognitoUserPoolOperatorResourcesLength := 0
+			cognitoUserPoolDomainOperatorResourcesLength := 0
+			lambdaLayerVersionOperatorResourcesLength := 0
+			rdsDBClusterOperatorResourcesLength := 0
+			ec2TransitGatewayOperatorResourcesLength := 0
+			ec2VPCEndpointServiceOperatorResourcesLength := 0
 			customOperatorResourcesLength := 0
 
 			for _, operator := range operatorCollection.GetOperators() {
@@ -517,6 +703,46 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 					cognitoUserPoolUICustomizationAttachmentOperatorResourcesLength += operator.GetResourcesLength()
 				case *CloudFormationStackOperator:
 					cloudformationStackOperatorResourcesLength += operator.GetResourcesLength()
+				case *EcrPublicRepositoryOperator:
+					ecrPublicRepositoryOperatorResourcesLength += operator.GetResourcesLength()
+				case *ApiGatewayDomainNameOperator:
+					apiGatewayDomainNameOperatorResourcesLength += operator.GetResourcesLength()
+				case *ApiGatewayV2DomainNameOperator:
+					apiGatewayV2DomainNameOperatorResourcesLength += operator.GetResourcesLength()
+				case *AcmCertificateOperator:
+					acmCertificateOperatorResourcesLength += operator.GetResourcesLength()
+				case *KinesisStreamOperator:
+					kinesisStreamOperatorResourcesLength += operator.GetResourcesLength()
+				case *FirehoseDeliveryStreamOperator:
+					firehoseDeliveryStreamOperatorResourcesLength += operator.GetResourcesLength()
+				case *DynamoDBTableOperator:
+					dynamoDBTableOperatorResourcesLength += operator.GetResourcesLength()
+				case *ElastiCacheReplicationGroupOperator:
+					elastiCacheReplicationGroupOperatorResourcesLength += operator.GetResourcesLength()
+				case *ElastiCacheServerlessCacheOperator:
+					elastiCacheServerlessCacheOperatorResourcesLength += operator.GetResourcesLength()
+				case *OpenSearchDomainOperator:
+					openSearchDomainOperatorResourcesLength += operator.GetResourcesLength()
+				case *LogGroupOperator:
+					logGroupOperatorResourcesLength += operator.GetResourcesLength()
+				case *SNSTopicOperator:
+					snsTopicOperatorResourcesLength += operator.GetResourcesLength()
+				case *SQSQueueOperator:
+					sqsQueueOperatorResourcesLength += operator.GetResourcesLength()
+				case *StepFunctionsStateMachineOperator:
+					stepFunctionsStateMachineOperatorResourcesLength += operator.GetResourcesLength()
+				case *CognitoUserPoolOperator:
+					cognitoUserPoolOperatorResourcesLength += operator.GetResourcesLength()
+				case *CognitoUserPoolDomainOperator:
+					cognitoUserPoolDomainOperatorResourcesLength += operator.GetResourcesLength()
+				case *LambdaLayerVersionOperator:
+					lambdaLayerVersionOperatorResourcesLength += operator.GetResourcesLength()
+				case *RDSDBClusterOperator:
+					rdsDBClusterOperatorResourcesLength += operator.GetResourcesLength()
+				case *EC2TransitGatewayOperator:
+					ec2TransitGatewayOperatorResourcesLength += operator.GetResourcesLength()
+				case *EC2VPCEndpointServiceOperator:
+					ec2VPCEndpointServiceOperatorResourcesLength += operator.GetResourcesLength()
 				case *CustomOperator:
 					customOperatorResourcesLength += operator.GetResourcesLength()
 				default:
@@ -541,6 +767,26 @@ func TestOperatorCollection_SetOperatorCollection(t *testing.T) {
 				lambdaFunctionOperatorResourcesLength:                           lambdaFunctionOperatorResourcesLength,
 				cognitoUserPoolUICustomizationAttachmentOperatorResourcesLength: cognitoUserPoolUICustomizationAttachmentOperatorResourcesLength,
 				cloudformationStackOperatorResourcesLength:                      cloudformationStackOperatorResourcesLength,
+				ecrPublicRepositoryOperatorResourcesLength:                      ecrPublicRepositoryOperatorResourcesLength,
+				apiGatewayDomainNameOperatorResourcesLength:                     apiGatewayDomainNameOperatorResourcesLength,
+				apiGatewayV2DomainNameOperatorResourcesLength:                   apiGatewayV2DomainNameOperatorResourcesLength,
+				acmCertificateOperatorResourcesLength:                           acmCertificateOperatorResourcesLength,
+				kinesisStreamOperatorResourcesLength:                            kinesisStreamOperatorResourcesLength,
+				firehoseDeliveryStreamOperatorResourcesLength:                   firehoseDeliveryStreamOperatorResourcesLength,
+				dynamoDBTableOperatorResourcesLength:                            dynamoDBTableOperatorResourcesLength,
+				elastiCacheReplicationGroupOperatorResourcesLength:              elastiCacheReplicationGroupOperatorResourcesLength,
+				elastiCacheServerlessCacheOperatorResourcesLength:               elastiCacheServerlessCacheOperatorResourcesLength,
+				openSearchDomainOperatorResourcesLength:                         openSearchDomainOperatorResourcesLength,
+				logGroupOperatorResourcesLength:                                 logGroupOperatorResourcesLength,
+				snsTopicOperatorResourcesLength:                                 snsTopicOperatorResourcesLength,
+				sqsQueueOperatorResourcesLength:                                 sqsQueueOperatorResourcesLength,
+				stepFunctionsStateMachineOperatorResourcesLength:                stepFunctionsStateMachineOperatorResourcesLength,
+				cognitoUserPoolOperatorResourcesLength:                          cognitoUserPoolOperatorResourcesLength,
+				cognitoUserPoolDomainOperatorResourcesLength:                    cognitoUserPoolDomainOperatorResourcesLength,
+				lambdaLayerVersionOperatorResourcesLength:                       lambdaLayerVersionOperatorResourcesLength,
+				rdsDBClusterOperatorResourcesLength:                             rdsDBClusterOperatorResourcesLength,
+				ec2TransitGatewayOperatorResourcesLength:                        ec2TransitGatewayOperatorResourcesLength,
+				ec2VPCEndpointServiceOperatorResourcesLength:                    ec2VPCEndpointServiceOperatorResourcesLength,
 				customOperatorResourcesLength:                                   customOperatorResourcesLength,
 			}
 
@@ -555,13 +801,13 @@ func TestOperatorCollection_SetOperatorCollection_MultipleCallsResetState(t *tes
 	io.NewLogger(false)
 
 	config := aws.Config{}
-	operatorFactory := NewOperatorFactory(config, false)
+	operatorFactory := NewOperatorFactory(config, Options{})
 	operatorCollection := NewOperatorCollection(config, operatorFactory)
 
 	stackName := aws.String("test-stack")
 
 	// First call with 2 DELETE_FAILED resources
-	operatorCollection.SetOperatorCollection(stackName, []types.StackResourceSummary{
+	operatorCollection.SetOperatorCollection(context.Background(), stackName, []types.StackResourceSummary{
 		{
 			LogicalResourceId:  aws.String("IamGroup1"),
 			PhysicalResourceId: aws.String("test-group-1"),
@@ -577,7 +823,7 @@ func TestOperatorCollection_SetOperatorCollection_MultipleCallsResetState(t *tes
 	})
 
 	// Second call with 1 DELETE_FAILED resource (simulating loop iteration)
-	operatorCollection.SetOperatorCollection(stackName, []types.StackResourceSummary{
+	operatorCollection.SetOperatorCollection(context.Background(), stackName, []types.StackResourceSummary{
 		{
 			LogicalResourceId:  aws.String("Bucket2"),
 			PhysicalResourceId: aws.String("test-bucket-2"),
@@ -605,7 +851,122 @@ func TestOperatorCollection_SetOperatorCollection_MultipleCallsResetState(t *tes
 	}
 }
 
-func TestOperatorCollection_containsResourceType(t *testing.T) {
+func TestOperatorCollection_SetOperatorCollection_Plugins(t *testing.T) {
+	io.NewLogger(false)
+
+	plugins := []*Plugin{
+		{
+			Name:          "fake",
+			ResourceTypes: []string{"Custom::TeamX*", "MongoDB::Atlas::Cluster"},
+		},
+	}
+
+	// The plugins are given to the loader as if they had been discovered.
+	pluginLoader := NewPluginLoader("")
+	pluginLoader.once.Do(func() { pluginLoader.plugins = plugins })
+
+	config := aws.Config{}
+	operatorFactory := NewOperatorFactory(config, Options{PluginLoader: pluginLoader})
+	operatorCollection := NewOperatorCollection(config, operatorFactory)
+
+	operatorCollection.SetOperatorCollection(context.Background(), aws.String("test"), []types.StackResourceSummary{
+		{
+			LogicalResourceId:  aws.String("TeamXResource"),
+			PhysicalResourceId: aws.String("PhysicalResourceId1"),
+			ResourceType:       aws.String("Custom::TeamXCleaner"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+		{
+			LogicalResourceId:  aws.String("AtlasCluster"),
+			PhysicalResourceId: aws.String("PhysicalResourceId2"),
+			ResourceType:       aws.String("MongoDB::Atlas::Cluster"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+		{
+			LogicalResourceId:  aws.String("OtherCustomResource"),
+			PhysicalResourceId: aws.String("PhysicalResourceId3"),
+			ResourceType:       aws.String("Custom::Other"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+		{
+			LogicalResourceId:  aws.String("AtlasProject"),
+			PhysicalResourceId: aws.String("PhysicalResourceId4"),
+			ResourceType:       aws.String("MongoDB::Atlas::Project"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+	})
+
+	pluginOperatorResourcesLength := 0
+	customOperatorResourcesLength := 0
+	for _, operator := range operatorCollection.GetOperators() {
+		switch operator := operator.(type) {
+		case *PluginOperator:
+			pluginOperatorResourcesLength += operator.GetResourcesLength()
+		case *CustomOperator:
+			customOperatorResourcesLength += operator.GetResourcesLength()
+		}
+	}
+
+	if pluginOperatorResourcesLength != 2 {
+		t.Errorf("expected 2 resources in the plugin operator, got %d", pluginOperatorResourcesLength)
+	}
+	if customOperatorResourcesLength != 1 {
+		t.Errorf("expected 1 resource in the custom operator, got %d", customOperatorResourcesLength)
+	}
+	if len(operatorCollection.unsupportedStackResources) != 1 {
+		t.Errorf("expected 1 unsupported resource, got %d", len(operatorCollection.unsupportedStackResources))
+	}
+}
+
+func TestOperatorCollection_SetOperatorCollection_RetainPolicy(t *testing.T) {
+	io.NewLogger(false)
+
+	retainPolicy, err := NewRetainPolicy([]string{"Datadog::*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := aws.Config{}
+	operatorFactory := NewOperatorFactory(config, Options{RetainPolicy: retainPolicy})
+	operatorCollection := NewOperatorCollection(config, operatorFactory)
+
+	operatorCollection.SetOperatorCollection(context.Background(), aws.String("test"), []types.StackResourceSummary{
+		{
+			LogicalResourceId:  aws.String("DatadogMonitor"),
+			PhysicalResourceId: aws.String("PhysicalResourceId1"),
+			ResourceType:       aws.String("Datadog::Monitors::Monitor"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+		{
+			LogicalResourceId:  aws.String("Bucket"),
+			PhysicalResourceId: aws.String("PhysicalResourceId2"),
+			ResourceType:       aws.String("AWS::S3::Bucket"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+		{
+			LogicalResourceId:  aws.String("AtlasProject"),
+			PhysicalResourceId: aws.String("PhysicalResourceId3"),
+			ResourceType:       aws.String("MongoDB::Atlas::Project"),
+			ResourceStatus:     "DELETE_FAILED",
+		},
+	})
+
+	retainOperatorResourcesLength := 0
+	for _, operator := range operatorCollection.GetOperators() {
+		if operator, ok := operator.(*RetainOperator); ok {
+			retainOperatorResourcesLength += operator.GetResourcesLength()
+		}
+	}
+
+	if retainOperatorResourcesLength != 1 {
+		t.Errorf("expected 1 resource in the retain operator, got %d", retainOperatorResourcesLength)
+	}
+	if len(operatorCollection.unsupportedStackResources) != 1 {
+		t.Errorf("expected 1 unsupported resource, got %d", len(operatorCollection.unsupportedStackResources))
+	}
+}
+
+func TestOperatorCollection_findRegistration(t *testing.T) {
 	io.NewLogger(false)
 
 	type args struct {
@@ -773,12 +1134,201 @@ func TestOperatorCollection_containsResourceType(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "unsupported resource",
+			name: "ECR Public Repository",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::ECR::PublicRepository",
+			},
+			want: true,
+		},
+		{
+			name: "API Gateway Domain Name",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::ApiGateway::DomainName",
+			},
+			want: true,
+		},
+		{
+			name: "API Gateway V2 Domain Name",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::ApiGatewayV2::DomainName",
+			},
+			want: true,
+		},
+		{
+			name: "ACM Certificate",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::CertificateManager::Certificate",
+			},
+			want: true,
+		},
+		{
+			name: "AWS::Kinesis::Stream",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::Kinesis::Stream",
+			},
+			want: true,
+		},
+		{
+			name: "AWS::KinesisFirehose::DeliveryStream",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::KinesisFirehose::DeliveryStream",
+			},
+			want: true,
+		},
+		{
+			name: "AWS::DynamoDB::Table",
 			args: args{
 				ctx:       context.Background(),
 				stackName: aws.String("test"),
 				resource:  "AWS::DynamoDB::Table",
 			},
+			want: true,
+		},
+		{
+			name: "AWS::DynamoDB::GlobalTable",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::DynamoDB::GlobalTable",
+			},
+			want: true,
+		},
+		{
+			name: "AWS::ElastiCache::ReplicationGroup",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::ElastiCache::ReplicationGroup",
+			},
+			want: true,
+		},
+		{
+			name: "AWS::ElastiCache::ServerlessCache",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::ElastiCache::ServerlessCache",
+			},
+			want: true,
+		},
+		{
+			name: "AWS::OpenSearchService::Domain",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::OpenSearchService::Domain",
+			},
+			want: true,
+		},
+		{
+			name: "LogGroup",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::Logs::LogGroup",
+			},
+			want: true,
+		},
+		{
+			name: "SNSTopic",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::SNS::Topic",
+			},
+			want: true,
+		},
+		{
+			name: "SQSQueue",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::SQS::Queue",
+			},
+			want: true,
+		},
+		{
+			name: "StateMachine",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::StepFunctions::StateMachine",
+			},
+			want: true,
+		},
+		{
+			name: "CognitoUserPool",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::Cognito::UserPool",
+			},
+			want: true,
+		},
+		{
+			name: "CognitoUserPoolDomain",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::Cognito::UserPoolDomain",
+			},
+			want: true,
+		},
+		{
+			name: "LambdaLayerVersion",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::Lambda::LayerVersion",
+			},
+			want: true,
+		},
+		{
+			name: "RdsDBCluster",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::RDS::DBCluster",
+			},
+			want: true,
+		},
+		{
+			name: "EC2TransitGateway",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::EC2::TransitGateway",
+			},
+			want: true,
+		},
+		{
+			name: "EC2VPCEndpointService",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::EC2::VPCEndpointService",
+			},
+			want: true,
+		},
+		{
+			name: "unsupported resource",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				resource:  "AWS::EC2::VPC",
+			},
 			want: false,
 		},
 	}
@@ -786,10 +1336,10 @@ func TestOperatorCollection_containsResourceType(t *testing.T) {
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
 			config := aws.Config{}
-			operatorFactory := NewOperatorFactory(config, false)
+			operatorFactory := NewOperatorFactory(config, Options{})
 			operatorCollection := NewOperatorCollection(config, operatorFactory)
 
-			got := operatorCollection.containsResourceType(tt.args.resource)
+			_, got := operatorCollection.findRegistration(tt.args.resource)
 
 			if got != tt.want {
 				t.Errorf("got = %#v, want %#v", got, tt.want)
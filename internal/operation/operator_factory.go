@@ -1,31 +1,71 @@
 package operation
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
 	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/go-to-k/delstack/pkg/client"
 )
 
-const SDKRetryMaxAttempts = 3
+const SDKRetryMaxAttempts = client.SDKRetryMaxAttempts
+
+// Options are the options of a deletion applied to the operators created by OperatorFactory.
+type Options struct {
+	ForceMode bool
+	// FinalSnapshot makes operators for resources that support it take a final snapshot on deletion.
+	FinalSnapshot bool
+	// DeleteLambdaLogGroups makes the stack operators delete the log groups that Lambda creates
+	// implicitly for the functions in the stack once the stack is deleted.
+	DeleteLambdaLogGroups bool
+	// PreEmpty makes the stack operators start emptying the S3 buckets and the ECR repositories in
+	// the stack in parallel with the first deletion of the stack.
+	PreEmpty bool
+	// RetainPolicy retains the resources of the unsupported types it allows from the stacks. It is
+	// nil when no type is allowed.
+	RetainPolicy *RetainPolicy
+	// ENICleanupPolicy allows the orphan ENIs of AWS services other than AWS Lambda to be deleted from
+	// the subnets and the security groups. It is nil when nothing is allowed.
+	ENICleanupPolicy *ENICleanupPolicy
+	// PluginLoader loads the plugins that take precedence over the built-in operators. It is nil when
+	// no plugin is used.
+	PluginLoader *PluginLoader
+}
 
 type OperatorFactory struct {
-	config    aws.Config
-	forceMode bool
+	config  aws.Config
+	options Options
 }
 
-func NewOperatorFactory(config aws.Config, forceMode bool) *OperatorFactory {
+func NewOperatorFactory(config aws.Config, options Options) *OperatorFactory {
 	return &OperatorFactory{
-		config:    config,
-		forceMode: forceMode,
+		config:  config,
+		options: options,
 	}
 }
 
@@ -40,16 +80,23 @@ func (f *OperatorFactory) CreateCloudFormationStackOperator() *CloudFormationSta
 		o.RetryMaxAttempts = SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
+	cfnClient := client.NewCloudFormation(
+		sdkCfnClient,
+		sdkCfnDeleteWaiter,
+		sdkCfnUpdateWaiter,
+	)
 	op := NewCloudFormationStackOperator(
 		f.config,
-		client.NewCloudFormation(
-			sdkCfnClient,
-			sdkCfnDeleteWaiter,
-			sdkCfnUpdateWaiter,
-		),
+		cfnClient,
 		client.NewS3(sdkS3Client, false),
 	)
-	op.forceMode = f.forceMode
+	op.options = f.options
+	if f.options.DeleteLambdaLogGroups {
+		op.lambdaLogGroupOperator = f.CreateLogGroupOperator()
+	}
+	if f.options.PreEmpty {
+		op.resourceEmptier = NewResourceEmptier(cfnClient, f.CreateS3BucketOperator(), f.CreateEcrRepositoryOperator())
+	}
 	return op
 }
 
@@ -79,6 +126,21 @@ func (f *OperatorFactory) CreateEcrRepositoryOperator() *EcrRepositoryOperator {
 	)
 }
 
+func (f *OperatorFactory) CreateEcrPublicRepositoryOperator() *EcrPublicRepositoryOperator {
+	sdkEcrPublicClient := ecrpublic.NewFromConfig(f.config, func(o *ecrpublic.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+		// ECR Public API is only available in us-east-1, regardless of the stack region.
+		o.Region = client.EcrPublicRegion
+	})
+
+	return NewEcrPublicRepositoryOperator(
+		client.NewEcrPublic(
+			sdkEcrPublicClient,
+		),
+	)
+}
+
 func (f *OperatorFactory) CreateIamGroupOperator() *IamGroupOperator {
 	sdkIamClient := iam.NewFromConfig(f.config, func(o *iam.Options) {
 		o.RetryMaxAttempts = SDKRetryMaxAttempts
@@ -196,11 +258,13 @@ func (f *OperatorFactory) CreateEC2SubnetOperator() *EC2SubnetOperator {
 		o.RetryMode = aws.RetryModeStandard
 	})
 
-	return NewEC2SubnetOperator(
+	op := NewEC2SubnetOperator(
 		client.NewEC2Client(
 			sdkEC2Client,
 		),
 	)
+	op.eniCleanupPolicy = f.options.ENICleanupPolicy
+	return op
 }
 
 func (f *OperatorFactory) CreateEC2SecurityGroupOperator() *EC2SecurityGroupOperator {
@@ -209,7 +273,49 @@ func (f *OperatorFactory) CreateEC2SecurityGroupOperator() *EC2SecurityGroupOper
 		o.RetryMode = aws.RetryModeStandard
 	})
 
-	return NewEC2SecurityGroupOperator(
+	op := NewEC2SecurityGroupOperator(
+		client.NewEC2Client(
+			sdkEC2Client,
+		),
+	)
+	op.eniCleanupPolicy = f.options.ENICleanupPolicy
+	return op
+}
+
+func (f *OperatorFactory) CreateOrphanENICleaner() *OrphanENICleaner {
+	sdkEC2Client := ec2.NewFromConfig(f.config, func(o *ec2.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewOrphanENICleaner(
+		client.NewEC2Client(
+			sdkEC2Client,
+		),
+		f.options.ENICleanupPolicy,
+	)
+}
+
+func (f *OperatorFactory) CreateEC2TransitGatewayOperator() *EC2TransitGatewayOperator {
+	sdkEC2Client := ec2.NewFromConfig(f.config, func(o *ec2.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewEC2TransitGatewayOperator(
+		client.NewEC2Client(
+			sdkEC2Client,
+		),
+	)
+}
+
+func (f *OperatorFactory) CreateEC2VPCEndpointServiceOperator() *EC2VPCEndpointServiceOperator {
+	sdkEC2Client := ec2.NewFromConfig(f.config, func(o *ec2.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewEC2VPCEndpointServiceOperator(
 		client.NewEC2Client(
 			sdkEC2Client,
 		),
@@ -231,6 +337,262 @@ func (f *OperatorFactory) CreateLambdaFunctionOperator() *LambdaFunctionOperator
 	)
 }
 
+func (f *OperatorFactory) CreateLambdaLayerVersionOperator() *LambdaLayerVersionOperator {
+	sdkLambdaClient := lambda.NewFromConfig(f.config, func(o *lambda.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	sdkLambdaWaiter := lambda.NewFunctionUpdatedV2Waiter(sdkLambdaClient)
+
+	return NewLambdaLayerVersionOperator(
+		client.NewLambdaClient(
+			sdkLambdaClient,
+			sdkLambdaWaiter,
+		),
+	)
+}
+
+func (f *OperatorFactory) CreateApiGatewayDomainNameOperator() *ApiGatewayDomainNameOperator {
+	sdkApiGatewayClient := apigateway.NewFromConfig(f.config, func(o *apigateway.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewApiGatewayDomainNameOperator(
+		client.NewApiGateway(
+			sdkApiGatewayClient,
+		),
+	)
+}
+
+func (f *OperatorFactory) CreateApiGatewayV2DomainNameOperator() *ApiGatewayV2DomainNameOperator {
+	sdkApiGatewayV2Client := apigatewayv2.NewFromConfig(f.config, func(o *apigatewayv2.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewApiGatewayV2DomainNameOperator(
+		client.NewApiGatewayV2(
+			sdkApiGatewayV2Client,
+		),
+	)
+}
+
+func (f *OperatorFactory) CreateAcmCertificateOperator() *AcmCertificateOperator {
+	sdkAcmClient := acm.NewFromConfig(f.config, func(o *acm.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	sdkELBV2Client := elasticloadbalancingv2.NewFromConfig(f.config, func(o *elasticloadbalancingv2.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	op := NewAcmCertificateOperator(
+		client.NewAcm(sdkAcmClient),
+		client.NewELBV2(sdkELBV2Client),
+	)
+	op.forceMode = f.options.ForceMode
+	return op
+}
+
+func (f *OperatorFactory) CreateKinesisStreamOperator() *KinesisStreamOperator {
+	sdkKinesisClient := kinesis.NewFromConfig(f.config, func(o *kinesis.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewKinesisStreamOperator(
+		client.NewKinesis(sdkKinesisClient),
+	)
+}
+
+func (f *OperatorFactory) CreateFirehoseDeliveryStreamOperator() *FirehoseDeliveryStreamOperator {
+	sdkFirehoseClient := firehose.NewFromConfig(f.config, func(o *firehose.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewFirehoseDeliveryStreamOperator(
+		client.NewFirehose(sdkFirehoseClient),
+	)
+}
+
+func (f *OperatorFactory) CreateDynamoDBTableOperator() *DynamoDBTableOperator {
+	sdkDynamoDBClient := dynamodb.NewFromConfig(f.config, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	// Replicas of a global table live in other regions, so clients for those regions are
+	// built on demand from the same config.
+	regionalClientFn := func(region string) client.IDynamoDB {
+		return client.NewDynamoDB(dynamodb.NewFromConfig(f.config, func(o *dynamodb.Options) {
+			o.RetryMaxAttempts = SDKRetryMaxAttempts
+			o.RetryMode = aws.RetryModeStandard
+			o.Region = region
+		}))
+	}
+
+	op := NewDynamoDBTableOperator(
+		client.NewDynamoDB(sdkDynamoDBClient),
+		regionalClientFn,
+		f.config.Region,
+	)
+	op.forceMode = f.options.ForceMode
+	return op
+}
+
+func (f *OperatorFactory) CreateElastiCacheReplicationGroupOperator() *ElastiCacheReplicationGroupOperator {
+	sdkElastiCacheClient := elasticache.NewFromConfig(f.config, func(o *elasticache.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	// The secondary members of a global datastore live in other regions, so clients for those
+	// regions are built on demand from the same config.
+	regionalClientFn := func(region string) client.IElastiCache {
+		return client.NewElastiCache(elasticache.NewFromConfig(f.config, func(o *elasticache.Options) {
+			o.RetryMaxAttempts = SDKRetryMaxAttempts
+			o.RetryMode = aws.RetryModeStandard
+			o.Region = region
+		}))
+	}
+
+	op := NewElastiCacheReplicationGroupOperator(
+		client.NewElastiCache(sdkElastiCacheClient),
+		regionalClientFn,
+		f.config.Region,
+	)
+	op.finalSnapshot = f.options.FinalSnapshot
+	return op
+}
+
+func (f *OperatorFactory) CreateElastiCacheServerlessCacheOperator() *ElastiCacheServerlessCacheOperator {
+	sdkElastiCacheClient := elasticache.NewFromConfig(f.config, func(o *elasticache.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	op := NewElastiCacheServerlessCacheOperator(
+		client.NewElastiCache(sdkElastiCacheClient),
+	)
+	op.finalSnapshot = f.options.FinalSnapshot
+	return op
+}
+
+func (f *OperatorFactory) CreateOpenSearchDomainOperator() *OpenSearchDomainOperator {
+	sdkOpenSearchClient := opensearch.NewFromConfig(f.config, func(o *opensearch.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewOpenSearchDomainOperator(
+		client.NewOpenSearch(sdkOpenSearchClient),
+	)
+}
+
+func (f *OperatorFactory) CreateLogGroupOperator() *LogGroupOperator {
+	sdkLogsClient := cloudwatchlogs.NewFromConfig(f.config, func(o *cloudwatchlogs.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	op := NewLogGroupOperator(
+		client.NewCloudWatchLogs(sdkLogsClient),
+	)
+	op.forceMode = f.options.ForceMode
+	return op
+}
+
+func (f *OperatorFactory) CreateSNSTopicOperator() *SNSTopicOperator {
+	sdkSNSClient := sns.NewFromConfig(f.config, func(o *sns.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewSNSTopicOperator(
+		client.NewSNS(sdkSNSClient),
+	)
+}
+
+func (f *OperatorFactory) CreateSQSQueueOperator() *SQSQueueOperator {
+	sdkSQSClient := sqs.NewFromConfig(f.config, func(o *sqs.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	sdkLambdaClient := lambda.NewFromConfig(f.config, func(o *lambda.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	sdkLambdaWaiter := lambda.NewFunctionUpdatedV2Waiter(sdkLambdaClient)
+
+	return NewSQSQueueOperator(
+		client.NewSQS(sdkSQSClient),
+		client.NewLambdaClient(
+			sdkLambdaClient,
+			sdkLambdaWaiter,
+		),
+	)
+}
+
+func (f *OperatorFactory) CreateStepFunctionsStateMachineOperator() *StepFunctionsStateMachineOperator {
+	sdkSFNClient := sfn.NewFromConfig(f.config, func(o *sfn.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewStepFunctionsStateMachineOperator(
+		client.NewSFN(sdkSFNClient),
+	)
+}
+
+func (f *OperatorFactory) CreateCognitoUserPoolOperator() *CognitoUserPoolOperator {
+	sdkCognitoClient := cognitoidentityprovider.NewFromConfig(f.config, func(o *cognitoidentityprovider.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewCognitoUserPoolOperator(
+		client.NewCognito(sdkCognitoClient),
+	)
+}
+
+func (f *OperatorFactory) CreateCognitoUserPoolDomainOperator() *CognitoUserPoolDomainOperator {
+	sdkCognitoClient := cognitoidentityprovider.NewFromConfig(f.config, func(o *cognitoidentityprovider.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewCognitoUserPoolDomainOperator(
+		client.NewCognito(sdkCognitoClient),
+	)
+}
+
+func (f *OperatorFactory) CreateRDSDBClusterOperator() *RDSDBClusterOperator {
+	sdkRDSClient := rds.NewFromConfig(f.config, func(o *rds.Options) {
+		o.RetryMaxAttempts = SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	// Secondary clusters and read replicas can live in other regions, so clients for those
+	// regions are built on demand from the same config.
+	regionalClientFn := func(region string) client.IRDS {
+		return client.NewRDS(rds.NewFromConfig(f.config, func(o *rds.Options) {
+			o.RetryMaxAttempts = SDKRetryMaxAttempts
+			o.RetryMode = aws.RetryModeStandard
+			o.Region = region
+		}))
+	}
+
+	op := NewRDSDBClusterOperator(
+		client.NewRDS(sdkRDSClient),
+		regionalClientFn,
+		f.config.Region,
+	)
+	op.forceMode = f.options.ForceMode
+	op.finalSnapshot = f.options.FinalSnapshot
+	return op
+}
+
 func (f *OperatorFactory) CreateCognitoUserPoolUICustomizationAttachmentOperator() *CognitoUserPoolUICustomizationAttachmentOperator {
 	return NewCognitoUserPoolUICustomizationAttachmentOperator() // Implicit instance that does not actually delete resources (phantom only)
 }
@@ -238,3 +600,18 @@ func (f *OperatorFactory) CreateCognitoUserPoolUICustomizationAttachmentOperator
 func (f *OperatorFactory) CreateCustomOperator() *CustomOperator {
 	return NewCustomOperator() // Implicit instances that do not actually delete resources
 }
+
+func (f *OperatorFactory) CreateRetainOperator(stackName string) *RetainOperator {
+	return NewRetainOperator(stackName, f.options.RetainPolicy)
+}
+
+// CreatePluginOperators creates an operator for each plugin, loading the plugins on the first call.
+func (f *OperatorFactory) CreatePluginOperators(ctx context.Context) []*PluginOperator {
+	operators := []*PluginOperator{}
+	for _, plugin := range f.options.PluginLoader.Load(ctx) {
+		op := NewPluginOperator(f.config, plugin)
+		op.forceMode = f.options.ForceMode
+		operators = append(operators, op)
+	}
+	return operators
+}
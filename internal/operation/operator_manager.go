@@ -9,7 +9,7 @@ import (
 )
 
 type IOperatorManager interface {
-	SetOperatorCollection(stackName *string, stackResourceSummaries []types.StackResourceSummary)
+	SetOperatorCollection(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary)
 	CheckResourceCounts() error
 	GetLogicalResourceIds() []string
 	DeleteResourceCollection(ctx context.Context) error
@@ -27,8 +27,8 @@ func NewOperatorManager(operatorCollection IOperatorCollection) *OperatorManager
 	}
 }
 
-func (m *OperatorManager) SetOperatorCollection(stackName *string, stackResourceSummaries []types.StackResourceSummary) {
-	m.operatorCollection.SetOperatorCollection(stackName, stackResourceSummaries)
+func (m *OperatorManager) SetOperatorCollection(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {
+	m.operatorCollection.SetOperatorCollection(ctx, stackName, stackResourceSummaries)
 }
 
 func (m *OperatorManager) getOperatorResourcesLength() int {
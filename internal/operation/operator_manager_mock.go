@@ -83,13 +83,13 @@ func (mr *MockIOperatorManagerMockRecorder) GetLogicalResourceIds() *gomock.Call
 }
 
 // SetOperatorCollection mocks base method.
-func (m *MockIOperatorManager) SetOperatorCollection(stackName *string, stackResourceSummaries []types.StackResourceSummary) {
+func (m *MockIOperatorManager) SetOperatorCollection(ctx context.Context, stackName *string, stackResourceSummaries []types.StackResourceSummary) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetOperatorCollection", stackName, stackResourceSummaries)
+	m.ctrl.Call(m, "SetOperatorCollection", ctx, stackName, stackResourceSummaries)
 }
 
 // SetOperatorCollection indicates an expected call of SetOperatorCollection.
-func (mr *MockIOperatorManagerMockRecorder) SetOperatorCollection(stackName, stackResourceSummaries any) *gomock.Call {
+func (mr *MockIOperatorManagerMockRecorder) SetOperatorCollection(ctx, stackName, stackResourceSummaries any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperatorCollection", reflect.TypeOf((*MockIOperatorManager)(nil).SetOperatorCollection), stackName, stackResourceSummaries)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOperatorCollection", reflect.TypeOf((*MockIOperatorManager)(nil).SetOperatorCollection), ctx, stackName, stackResourceSummaries)
 }
@@ -0,0 +1,569 @@
+package operation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-to-k/delstack/internal/preprocessor"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+)
+
+// PreprocessorKind tells which part of the pre-deletion processing a preprocessor belongs to.
+type PreprocessorKind int
+
+const (
+	// PerformanceOptimization preprocessors speed up the deletion of resources that would not fail.
+	PerformanceOptimization PreprocessorKind = iota
+	// LeftoverCleanup preprocessors remove resources created implicitly outside the stack.
+	LeftoverCleanup
+	// ExternalReferenceCleanup preprocessors remove the references from outside the stack that block
+	// the deletion of resources in the stack.
+	ExternalReferenceCleanup
+)
+
+// SupportedResource is a resource type handled by delstack with its description. The description is
+// written in Markdown for the README, and the markup is stripped for the CLI output.
+type SupportedResource struct {
+	ResourceType string
+	Description  string
+}
+
+// PreprocessorRegistration registers a preprocessor that runs on the resources of a type before the
+// stack deletion starts.
+type PreprocessorRegistration struct {
+	ResourceType string
+	Kind         PreprocessorKind
+	Description  string
+	// ForceModeOnly limits the preprocessor to force mode (-f).
+	ForceModeOnly bool
+	Create        func(config aws.Config) preprocessor.IPreprocessor
+}
+
+// OperatorRegistration registers an operator with the resource types it force-deletes and the optional
+// preprocessors for them. Create is nil for the resource types that only have preprocessors.
+type OperatorRegistration struct {
+	Resources     []SupportedResource
+	Create        func(f *OperatorFactory) IOperator
+	Preprocessors []PreprocessorRegistration
+}
+
+// OperatorRegistry is the single source of truth for the resource types supported by delstack. The
+// operator collection, the supported resources table in the error message, the `delstack resources`
+// command and the tables in the README are all generated from it, so adding a resource type only
+// needs a new operator and its registration here.
+//
+// The order of the registrations is the order of the operators and of the documented tables.
+var OperatorRegistry = []OperatorRegistration{
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.S3Bucket,
+				Description:  "S3 Buckets, including **non-empty buckets or buckets with Versioning enabled**.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateS3BucketOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.S3DirectoryBucket,
+				Description:  "S3 Directory Buckets for S3 Express One Zone, including non-empty buckets.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateS3DirectoryBucketOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.S3TableBucket,
+				Description:  "S3 Table Buckets, including buckets with any namespaces or tables.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateS3TableBucketOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.S3TableNamespace,
+				Description:  "S3 Table Namespaces, including namespaces with any tables.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateS3TableNamespaceOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.S3VectorBucket,
+				Description:  "S3 Vector Buckets, including buckets with any indexes.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateS3VectorBucketOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.IamGroup,
+				Description:  "IAM Groups, including groups **with IAM users from outside the stack.** In that case, this tool detaches the IAM users and then deletes the IAM group (but not the IAM users themselves).",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateIamGroupOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.IamUser,
+				Description:  "IAM Users, including users **with policies, MFA devices, access keys, login profiles, or other dependencies from outside the stack.** This tool removes all dependencies and then deletes the IAM user.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateIamUserOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.EcrRepository,
+				Description:  "ECR Repositories, including repositories that contain images and where **the `EmptyOnDelete` is not true.**",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateEcrRepositoryOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.EcrPublicRepository,
+				Description:  "ECR Public Repositories, including repositories that contain images. The ECR Public API is always called in `us-east-1`, regardless of the stack region.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateEcrPublicRepositoryOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.BackupVault,
+				Description:  "Backup Vaults, including vaults **containing recovery points** or **locked by a Vault Lock in governance mode**. In-progress backup jobs for the vault are stopped, and in-progress copy and restore jobs are waited for. Vaults locked in **compliance mode** after the grace time cannot be deleted and are reported as errors.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateBackupVaultOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.AthenaWorkGroup,
+				Description:  "Athena WorkGroups, including workgroups containing **named queries or prepared statements**.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateAthenaWorkGroupOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.EC2Subnet,
+				Description:  "EC2 Subnets blocked by **orphan AWS Lambda VPC ENIs** that AWS Lambda has not yet released after the function was deleted. This tool deletes those orphan ENIs (`available` state, `AWS Lambda VPC ENI*` description only) and then deletes the subnet. The orphan ENIs of other AWS services are deleted too when allowed with `--cleanupOrphanEni`.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateEC2SubnetOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.EC2SecurityGroup,
+				Description:  "EC2 SecurityGroups blocked by **orphan AWS Lambda VPC ENIs** that AWS Lambda has not yet released after the function was deleted. This tool deletes those orphan ENIs (`available` state, `AWS Lambda VPC ENI*` description only) and then deletes the security group. The orphan ENIs of other AWS services are deleted too when allowed with `--cleanupOrphanEni`.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateEC2SecurityGroupOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.LambdaFunction,
+				Description:  "Lambda Functions, including **Lambda@Edge functions with replicas** still being cleaned up by AWS. Waits for AWS to finish removing edge replicas. Provisioned concurrency configs and event source mappings added outside the stack are also removed.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateLambdaFunctionOperator() },
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.LambdaFunction,
+				Kind:         PerformanceOptimization,
+				Description:  "Automatically detaches VPC configurations from Lambda functions and deletes their ENIs in parallel, **eliminating ENI cleanup wait time**. All Lambda functions within a stack (including nested stacks) are processed in parallel for maximum performance.",
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewLambdaVPCDetacherFromConfig(config)
+				},
+			},
+		},
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.CognitoUserPoolUICustomizationAttachment,
+				Description:  "Cognito UserPool UI Customization Attachments left in `DELETE_FAILED` as **phantoms** (e.g. a failed create because no `UserPoolDomain` existed), where **no actual customization exists in AWS**. There is nothing to delete, so this tool retains the phantom to remove it from the stack.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateCognitoUserPoolUICustomizationAttachmentOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.ApiGatewayDomainName,
+				Description:  "API Gateway custom domain names for REST APIs, including domain names **with base path mappings from outside the stack.** This tool removes the remaining base path mappings (but not the APIs themselves) and then deletes the domain name.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateApiGatewayDomainNameOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.ApiGatewayV2DomainName,
+				Description:  "API Gateway custom domain names for HTTP and WebSocket APIs, including domain names **with API mappings from outside the stack.** This tool removes the remaining API mappings (but not the APIs themselves) and then deletes the domain name.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateApiGatewayV2DomainNameOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.AcmCertificate,
+				Description:  "Certificates still in use by resources outside the stack are reported with the blocking resources. With the `-f` option, the certificate is detached from ALB/NLB listeners (replaced by another certificate of the listener if it is the default one) before deletion.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateAcmCertificateOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.KinesisStream,
+				Description:  "Streams with enhanced fan-out consumers registered from outside the stack. The consumers are deregistered before the stream is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateKinesisStreamOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.FirehoseDeliveryStream,
+				Description:  "Delivery streams stuck in `CREATING_FAILED` or `DELETING_FAILED` state (e.g. due to an unusable KMS key), which are force-deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateFirehoseDeliveryStreamOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.DynamoDBTable,
+				Description:  "Tables with replicas in other regions, including replicas added from outside the stack. The replicas are removed before the table is deleted. With the `-f` option, deletion protection of the table and its replicas is disabled.",
+			},
+			{
+				ResourceType: resourcetype.DynamoDBGlobalTable,
+				Description:  "Global tables with replicas added from outside the stack. The replicas are removed before the table is deleted. With the `-f` option, deletion protection of every replica is disabled.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateDynamoDBTableOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.ElastiCacheReplicationGroup,
+				Description:  "Replication groups in a global datastore or with user groups from outside the stack. The group is removed from the global datastore and the user groups are detached before deletion. For a primary, the secondary groups in other regions are **disassociated into standalone groups** first. A final snapshot is taken only with the `--finalSnapshot` option.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateElastiCacheReplicationGroupOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.ElastiCacheServerlessCache,
+				Description:  "Serverless caches with a user group from outside the stack. The user group is detached before deletion. A final snapshot is taken only with the `--finalSnapshot` option.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateElastiCacheServerlessCacheOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.OpenSearchDomain,
+				Description:  "Domains with associated packages or OpenSearch-managed VPC endpoints created outside the stack. The packages are dissociated and the VPC endpoints deleted before the domain is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateOpenSearchDomainOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.LogsLogGroup,
+				Description:  "Log groups with subscription filters, metric filters or a data protection policy from outside the stack. They are removed before the log group is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateLogGroupOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.SNSTopic,
+				Description:  "Topics with subscriptions from outside the stack. The subscriptions are removed before the topic is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateSNSTopicOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.SQSQueue,
+				Description:  "Queues referenced by Lambda event source mappings created outside the stack. The event source mappings are deleted before the queue is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateSQSQueueOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.StepFunctionsStateMachine,
+				Description:  "State machines with running executions. The executions are stopped before the state machine is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateStepFunctionsStateMachineOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.CognitoUserPool,
+				Description:  "User pools with a domain (including a custom domain), managed login branding or resource servers created outside the stack. They are removed before the user pool deletion is retried.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateCognitoUserPoolOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.CognitoUserPoolDomain,
+				Description:  "User pool domains, including custom domains. For a custom domain, this tool waits until its CloudFront distribution is deleted.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateCognitoUserPoolDomainOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.LambdaLayerVersion,
+				Description:  "Layer versions. In force mode (`-f`), the previous versions of the layer that the stack published and retained on updates are also deleted before the stack deletion.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateLambdaLayerVersionOperator() },
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.LambdaLayerVersion,
+				Kind:         LeftoverCleanup,
+				Description:  "Deletes the **previous versions of the layers** retained on updates (the default in CDK). Only the versions that the stack events show were published by the stack are deleted, so the versions of the same layer published outside the stack (e.g. by hand, by CI or by other stacks) are kept.",
+				// The previous versions live outside the stack, so they are only cleaned up
+				// when the user opts into force deletion.
+				ForceModeOnly: true,
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewLambdaLayerVersionCleanerFromConfig(config)
+				},
+			},
+		},
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.RdsDBCluster,
+				Description:  "DB clusters in a global cluster, with cross-region read replica clusters, or with DB instances added outside the stack (e.g. Aurora Auto Scaling replicas). The other members of the global cluster and the read replica clusters are **promoted to standalone clusters**, and the remaining DB instances are deleted. In force mode (`-f`), the final snapshot is skipped unless the `--finalSnapshot` option is specified.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateRDSDBClusterOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.EC2TransitGateway,
+				Description:  "Transit gateways with attachments or route tables created outside the stack (e.g. by other accounts or stacks). The route table propagations and associations are removed, the attachments **pending acceptance are rejected and the others are deleted**, and the non-default route tables are deleted before the transit gateway. Only VPC and peering attachments are supported. Everything removed is listed in the output.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateEC2TransitGatewayOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.EC2VPCEndpointService,
+				Description:  "VPC endpoint services (PrivateLink) with endpoint connections from other VPCs or accounts. The active and pending **connections are rejected** before the service is deleted, and the rejected endpoints are listed in the output.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateEC2VPCEndpointServiceOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.CloudformationStack,
+				Description:  "**Nested Child Stacks** that failed to delete. If any of the other resources are included in the child stack, **they too will be deleted**.",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateCloudFormationStackOperator() },
+	},
+	{
+		Resources: []SupportedResource{
+			{
+				ResourceType: resourcetype.CloudformationCustomResource,
+				Description:  "Custom Resources (AWS::CloudFormation::CustomResource), including resources that **do not return a SUCCESS status.**",
+			},
+			{
+				ResourceType: resourcetype.CustomResource,
+				Description:  "Custom Resources (Custom::Xxx), including resources that **do not return a SUCCESS status.**",
+			},
+		},
+		Create: func(f *OperatorFactory) IOperator { return f.CreateCustomOperator() },
+	},
+	{
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.CloudFrontDistribution,
+				Kind:         PerformanceOptimization,
+				Description:  "Disables enabled distributions (including those in nested stacks) up front, so that the **disable propagation to edge locations** runs while other resources are deleted instead of when CloudFormation reaches the distribution. This also lets Lambda@Edge replicas be cleaned up earlier. Distributions retained by their `DeletionPolicy`, or in nested stacks retained by it, are left enabled.",
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewCloudFrontDistributionDisablerFromConfig(config)
+				},
+			},
+		},
+	},
+	{
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.AutoScalingGroup,
+				Kind:         PerformanceOptimization,
+				Description:  "Suspends the scaling processes except `Terminate` and sets the minimum size and the desired capacity to 0 up front (including groups in nested stacks), so that the **instances are drained and terminated** while other resources are deleted. Groups retained by their `DeletionPolicy`, or in nested stacks retained by it, are left as they are.",
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewAutoScalingGroupScalerFromConfig(config)
+				},
+			},
+		},
+	},
+	{
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.EcsService,
+				Kind:         PerformanceOptimization,
+				Description:  "Sets the desired count of services to 0 up front (including services in nested stacks), so that the **tasks are drained and stopped** while other resources are deleted. Services retained by their `DeletionPolicy`, or in nested stacks retained by it, are left as they are.",
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewEcsServiceScalerFromConfig(config)
+				},
+			},
+		},
+	},
+	{
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.Elbv2TargetGroup,
+				Kind:         ExternalReferenceCleanup,
+				Description:  "Deletes the listener rules that forward to the target groups but are not in the stack, such as rules added by other stacks or tools to a **shared load balancer**, and deregisters the targets. Rules forwarding to several target groups with weights are deleted as a whole. Listeners outside the stack whose default action forwards to the target groups are only reported as warnings.",
+				// Listener rules of other stacks are deleted, so this only runs when the user
+				// opts into force deletion.
+				ForceModeOnly: true,
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewTargetGroupDetacherFromConfig(config)
+				},
+			},
+		},
+	},
+	{
+		Preprocessors: []PreprocessorRegistration{
+			{
+				ResourceType: resourcetype.EcrPullThroughCacheRule,
+				Kind:         LeftoverCleanup,
+				Description:  "Deletes the repositories that ECR created under the rule's repository prefix (`<prefix>/<upstream-repository>`) when images were pulled through the cache. Rules with the `ROOT` prefix are skipped because they cover every repository in the registry.",
+				// Repositories created by pull through cache rules live outside the stack,
+				// so they are only cleaned up when the user opts into force deletion.
+				ForceModeOnly: true,
+				Create: func(config aws.Config) preprocessor.IPreprocessor {
+					return preprocessor.NewEcrPullThroughCacheCleanerFromConfig(config)
+				},
+			},
+		},
+	},
+}
+
+// Matches reports whether the resource type is handled as this supported resource. Any type with the
+// `Custom::` prefix matches the custom resources.
+func (r SupportedResource) Matches(resourceType string) bool {
+	if r.ResourceType == resourcetype.CustomResource {
+		return strings.HasPrefix(resourceType, resourcetype.CustomResource)
+	}
+	return r.ResourceType == resourceType
+}
+
+// DisplayName returns the resource type as written in the documents.
+func (r SupportedResource) DisplayName() string {
+	if r.ResourceType == resourcetype.CustomResource {
+		return resourcetype.CustomResource + "Xxx"
+	}
+	return r.ResourceType
+}
+
+// SupportedResources returns the resource types that can be forced to delete in the registry order.
+func SupportedResources() []SupportedResource {
+	resources := []SupportedResource{}
+	for _, registration := range OperatorRegistry {
+		resources = append(resources, registration.Resources...)
+	}
+	return resources
+}
+
+// RegisteredPreprocessors returns the preprocessors of the kind in the registry order.
+func RegisteredPreprocessors(kind PreprocessorKind) []PreprocessorRegistration {
+	preprocessors := []PreprocessorRegistration{}
+	for _, registration := range OperatorRegistry {
+		for _, p := range registration.Preprocessors {
+			if p.Kind == kind {
+				preprocessors = append(preprocessors, p)
+			}
+		}
+	}
+	return preprocessors
+}
+
+// CreatePreprocessors creates the registered preprocessors enabled in the mode of the factory, and the
+// orphan ENI cleaner when the ENI cleanup policy is given.
+func (f *OperatorFactory) CreatePreprocessors() []preprocessor.IPreprocessor {
+	preprocessors := []preprocessor.IPreprocessor{}
+	for _, registration := range OperatorRegistry {
+		for _, p := range registration.Preprocessors {
+			if p.ForceModeOnly && !f.options.ForceMode {
+				continue
+			}
+			preprocessors = append(preprocessors, p.Create(f.config))
+		}
+	}
+	if f.options.ENICleanupPolicy != nil {
+		preprocessors = append(preprocessors, f.CreateOrphanENICleaner())
+	}
+	return preprocessors
+}
+
+// SupportedResourcesMarkdown renders the README table of the resource types that can be forced to delete.
+func SupportedResourcesMarkdown() string {
+	rows := [][]string{}
+	for _, resource := range SupportedResources() {
+		rows = append(rows, []string{resource.DisplayName(), resource.Description})
+	}
+	return toMarkdownTable(rows)
+}
+
+// PreprocessorsMarkdown renders the README table of the preprocessors of the kind.
+func PreprocessorsMarkdown(kind PreprocessorKind) string {
+	rows := [][]string{}
+	for _, p := range RegisteredPreprocessors(kind) {
+		rows = append(rows, []string{p.ResourceType, p.Description})
+	}
+	return toMarkdownTable(rows)
+}
+
+func toMarkdownTable(rows [][]string) string {
+	var b strings.Builder
+	b.WriteString("|  RESOURCE TYPE  |  DETAILS  |\n")
+	b.WriteString("| ---- | ---- |\n")
+	for _, row := range rows {
+		fmt.Fprintf(&b, "|  %s  |  %s  |\n", row[0], row[1])
+	}
+	return b.String()
+}
+
+// PlainText strips the Markdown emphasis and code spans from a description for the CLI output.
+func PlainText(markdown string) string {
+	return strings.NewReplacer("**", "", "`", "").Replace(markdown)
+}
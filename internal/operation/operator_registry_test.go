@@ -0,0 +1,169 @@
+package operation
+
+import (
+	"flag"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+var update = flag.Bool("update", false, "update the tables in README.md generated from the registry")
+
+const readmePath = "../../README.md"
+
+/*
+	Test Cases
+*/
+
+func TestOperatorRegistry_README(t *testing.T) {
+	sections := map[string]string{
+		"supported-resources":        SupportedResourcesMarkdown(),
+		"performance-optimization":   PreprocessorsMarkdown(PerformanceOptimization),
+		"leftover-cleanup":           PreprocessorsMarkdown(LeftoverCleanup),
+		"external-reference-cleanup": PreprocessorsMarkdown(ExternalReferenceCleanup),
+	}
+
+	readme, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := string(readme)
+	for name, table := range sections {
+		re := regexp.MustCompile(`(?s)(<!-- BEGIN ` + name + `:[^\n]*-->\n).*?(<!-- END ` + name + ` -->)`)
+		loc := re.FindStringSubmatchIndex(want)
+		if loc == nil {
+			t.Fatalf("the markers of %s are not found in README.md", name)
+		}
+		// Keep the markers and replace only the table between them.
+		want = want[:loc[3]] + "\n" + table + "\n" + want[loc[4]:]
+	}
+
+	if *update {
+		if err := os.WriteFile(readmePath, []byte(want), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if string(readme) != want {
+		t.Errorf("README.md is out of date with OperatorRegistry, run `make docs` to update it")
+	}
+}
+
+func TestOperatorRegistry_NoDuplicateResourceTypes(t *testing.T) {
+	type preprocessorKey struct {
+		resourceType string
+		kind         PreprocessorKind
+	}
+	operatorTypes := map[string]bool{}
+	preprocessorTypes := map[preprocessorKey]bool{}
+
+	for _, registration := range OperatorRegistry {
+		if registration.Create == nil && len(registration.Resources) > 0 {
+			t.Errorf("the resources %v are registered without an operator", registration.Resources)
+		}
+		for _, resource := range registration.Resources {
+			if operatorTypes[resource.ResourceType] {
+				t.Errorf("%s is registered for more than one operator", resource.ResourceType)
+			}
+			operatorTypes[resource.ResourceType] = true
+		}
+		for _, p := range registration.Preprocessors {
+			key := preprocessorKey{p.ResourceType, p.Kind}
+			if preprocessorTypes[key] {
+				t.Errorf("%s is registered for more than one preprocessor of the same kind", p.ResourceType)
+			}
+			preprocessorTypes[key] = true
+		}
+	}
+}
+
+func TestSupportedResource_Matches(t *testing.T) {
+	cases := []struct {
+		name         string
+		resource     SupportedResource
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "same resource type",
+			resource:     SupportedResource{ResourceType: "AWS::S3::Bucket"},
+			resourceType: "AWS::S3::Bucket",
+			want:         true,
+		},
+		{
+			name:         "different resource type",
+			resource:     SupportedResource{ResourceType: "AWS::S3::Bucket"},
+			resourceType: "AWS::S3Express::DirectoryBucket",
+			want:         false,
+		},
+		{
+			name:         "custom resource with any name",
+			resource:     SupportedResource{ResourceType: "Custom::"},
+			resourceType: "Custom::Anything",
+			want:         true,
+		},
+		{
+			name:         "not a custom resource",
+			resource:     SupportedResource{ResourceType: "Custom::"},
+			resourceType: "AWS::CloudFormation::CustomResource",
+			want:         false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.resource.Matches(tt.resourceType)
+			if got != tt.want {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperatorFactory_CreatePreprocessors(t *testing.T) {
+	io.NewLogger(false)
+
+	eniCleanupPolicy, err := NewENICleanupPolicy([]string{"interfaceType=vpc_endpoint"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name             string
+		forceMode        bool
+		eniCleanupPolicy *ENICleanupPolicy
+		want             int
+	}{
+		{
+			name:      "without force mode",
+			forceMode: false,
+			want:      4,
+		},
+		{
+			name:      "with force mode",
+			forceMode: true,
+			want:      7,
+		},
+		{
+			name:             "with ENI cleanup policy",
+			forceMode:        false,
+			eniCleanupPolicy: eniCleanupPolicy,
+			want:             5,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			operatorFactory := NewOperatorFactory(aws.Config{}, Options{ForceMode: tt.forceMode, ENICleanupPolicy: tt.eniCleanupPolicy})
+
+			got := len(operatorFactory.CreatePreprocessors())
+			if got != tt.want {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package operation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/preprocessor"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+var _ preprocessor.IPreprocessor = (*OrphanENICleaner)(nil)
+
+// OrphanENICleaner deletes the orphan ENIs allowed by the ENI cleanup policy from the subnets and the
+// security groups in the stack before the stack deletion starts, so that their deletion does not
+// fail on the ENIs left by other stacks or services. The ENIs released by the services during the
+// deletion are deleted by EC2SubnetOperator and EC2SecurityGroupOperator with the same policy.
+//
+// It lives in the operation package rather than the preprocessor package because it shares the
+// policy and the cleanup logic with the operators.
+type OrphanENICleaner struct {
+	ec2Client client.IEC2
+	policy    *ENICleanupPolicy
+}
+
+func NewOrphanENICleaner(ec2Client client.IEC2, policy *ENICleanupPolicy) *OrphanENICleaner {
+	return &OrphanENICleaner{
+		ec2Client: ec2Client,
+		policy:    policy,
+	}
+}
+
+func (c *OrphanENICleaner) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	subnets := preprocessor.FilterResourcesByType(resources, resourcetype.EC2Subnet)
+	securityGroups := preprocessor.FilterResourcesByType(resources, resourcetype.EC2SecurityGroup)
+
+	if len(subnets) == 0 && len(securityGroups) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d subnet(s) and %d security group(s), checking orphan ENIs",
+		aws.ToString(stackName), len(subnets), len(securityGroups))
+
+	var wg sync.WaitGroup
+	cleanup := func(resource types.StackResourceSummary, filterName string) {
+		if aws.ToString(resource.PhysicalResourceId) == "" {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := cleanupOrphanENIsByFilter(ctx, c.ec2Client, c.policy, filterName, aws.ToString(resource.PhysicalResourceId)); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to delete orphan ENIs in %s: %v",
+					aws.ToString(stackName), aws.ToString(resource.PhysicalResourceId), err)
+			}
+		}()
+	}
+	for _, subnet := range subnets {
+		cleanup(subnet, "subnet-id")
+	}
+	for _, securityGroup := range securityGroups {
+		cleanup(securityGroup, "group-id")
+	}
+
+	wg.Wait()
+
+	return nil
+}
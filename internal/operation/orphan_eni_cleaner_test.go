@@ -0,0 +1,111 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrphanENICleaner_Preprocess(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		resources     []types.StackResourceSummary
+		prepareMockFn func(m *client.MockIEC2)
+		wantErr       bool
+	}{
+		{
+			name: "no subnets nor security groups",
+			resources: []types.StackResourceSummary{
+				{
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+					PhysicalResourceId: aws.String("test-bucket"),
+				},
+			},
+			prepareMockFn: func(m *client.MockIEC2) {},
+			wantErr:       false,
+		},
+		{
+			name: "delete orphan ENIs in subnets and security groups",
+			resources: []types.StackResourceSummary{
+				{
+					ResourceType:       aws.String("AWS::EC2::Subnet"),
+					PhysicalResourceId: aws.String("subnet-111"),
+				},
+				{
+					ResourceType:       aws.String("AWS::EC2::SecurityGroup"),
+					PhysicalResourceId: aws.String("sg-111"),
+				},
+			},
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), []ec2types.Filter{
+					{Name: aws.String("subnet-id"), Values: []string{"subnet-111"}},
+					{Name: aws.String("status"), Values: []string{"available"}},
+				}).Return([]ec2types.NetworkInterface{
+					{NetworkInterfaceId: aws.String("eni-1"), InterfaceType: ec2types.NetworkInterfaceTypeVpcEndpoint},
+				}, nil)
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), []ec2types.Filter{
+					{Name: aws.String("group-id"), Values: []string{"sg-111"}},
+					{Name: aws.String("status"), Values: []string{"available"}},
+				}).Return([]ec2types.NetworkInterface{
+					{NetworkInterfaceId: aws.String("eni-2"), InterfaceType: ec2types.NetworkInterfaceTypeNatGateway},
+				}, nil)
+				m.EXPECT().DeleteNetworkInterface(gomock.Any(), aws.String("eni-1")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip subnets already deleted",
+			resources: []types.StackResourceSummary{
+				{
+					ResourceType:       aws.String("AWS::EC2::Subnet"),
+					PhysicalResourceId: aws.String("subnet-111"),
+					ResourceStatus:     types.ResourceStatusDeleteComplete,
+				},
+			},
+			prepareMockFn: func(m *client.MockIEC2) {},
+			wantErr:       false,
+		},
+		{
+			name: "cleanup errors do not fail preprocessing",
+			resources: []types.StackResourceSummary{
+				{
+					ResourceType:       aws.String("AWS::EC2::Subnet"),
+					PhysicalResourceId: aws.String("subnet-111"),
+				},
+			},
+			prepareMockFn: func(m *client.MockIEC2) {
+				m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("DescribeNetworkInterfacesError"))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			eniCleanupPolicy, err := NewENICleanupPolicy([]string{"interfaceType=vpc_endpoint"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctrl := gomock.NewController(t)
+			ec2Mock := client.NewMockIEC2(ctrl)
+			tt.prepareMockFn(ec2Mock)
+
+			cleaner := NewOrphanENICleaner(ec2Mock, eniCleanupPolicy)
+
+			err = cleaner.Preprocess(context.Background(), aws.String("test-stack"), tt.resources)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package operation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+)
+
+const (
+	// PluginPrefix is the prefix of the executables on PATH that delstack loads as plugins
+	// (`delstack-plugin-<name>`).
+	PluginPrefix = "delstack-plugin-"
+
+	// PluginProtocolVersion is sent in every request so that plugins can reject requests they do
+	// not understand.
+	PluginProtocolVersion = 1
+
+	pluginCommandDescribe = "describe"
+	pluginCommandDelete   = "delete"
+
+	pluginDescribeTimeout = 30 * time.Second
+)
+
+// Plugin is an external executable that force-deletes the resource types it declares. delstack talks
+// to it with a JSON request on stdin and a JSON response on stdout. Anything written to stderr is
+// passed through to the user.
+type Plugin struct {
+	Name string
+	Path string
+	// ResourceTypes are the patterns of the resource types handled by the plugin, in the syntax of
+	// path.Match (e.g. `Custom::TeamX*`).
+	ResourceTypes []string
+	Description   string
+}
+
+// PluginRequest is the JSON written to the stdin of a plugin.
+type PluginRequest struct {
+	ProtocolVersion int              `json:"protocolVersion"`
+	Command         string           `json:"command"`
+	Region          string           `json:"region,omitempty"`
+	ForceMode       bool             `json:"forceMode,omitempty"`
+	Resources       []PluginResource `json:"resources,omitempty"`
+}
+
+// PluginResource is a DELETE_FAILED resource in the stack, taken from its StackResourceSummary.
+type PluginResource struct {
+	LogicalResourceId    string `json:"logicalResourceId"`
+	PhysicalResourceId   string `json:"physicalResourceId"`
+	ResourceType         string `json:"resourceType"`
+	ResourceStatus       string `json:"resourceStatus"`
+	ResourceStatusReason string `json:"resourceStatusReason,omitempty"`
+}
+
+// PluginDescribeResponse is the JSON that a plugin returns for the describe command.
+type PluginDescribeResponse struct {
+	ResourceTypes []string `json:"resourceTypes"`
+	Description   string   `json:"description"`
+}
+
+// PluginDeleteResponse is the JSON that a plugin returns for the delete command. The resources that
+// are not listed in the failures are considered deleted.
+type PluginDeleteResponse struct {
+	Failures []PluginFailure `json:"failures"`
+}
+
+type PluginFailure struct {
+	LogicalResourceId string `json:"logicalResourceId"`
+	Message           string `json:"message"`
+}
+
+// PluginLoader loads the plugins only when they are needed, that is, when the resources of a stack
+// whose deletion failed are to be force-deleted, so that a run that does not need them neither
+// starts them nor fails on a broken one. It is shared by every operator factory in a run, including
+// the ones for nested stacks and other regions, so the plugins are loaded once.
+type PluginLoader struct {
+	pathList string
+
+	once    sync.Once
+	plugins []*Plugin
+}
+
+func NewPluginLoader(pathList string) *PluginLoader {
+	return &PluginLoader{
+		pathList: pathList,
+	}
+}
+
+// Load returns the plugins in the directories of the PATH list, discovering them on the first call.
+// A nil loader has no plugins.
+func (l *PluginLoader) Load(ctx context.Context) []*Plugin {
+	if l == nil {
+		return nil
+	}
+	l.once.Do(func() {
+		l.plugins = DiscoverPlugins(ctx, l.pathList)
+	})
+	return l.plugins
+}
+
+// DiscoverPlugins finds the plugins in the directories of the PATH list. When plugins with the same
+// name are in several directories, the first one wins as the shell does. The plugins that fail to
+// declare their resource types are skipped with a warning.
+func DiscoverPlugins(ctx context.Context, pathList string) []*Plugin {
+	plugins := []*Plugin{}
+	names := map[string]bool{}
+
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			// Directories on PATH that do not exist or cannot be read are skipped as the shell does.
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry)
+			if !ok || names[name] {
+				continue
+			}
+			pluginPath := filepath.Join(dir, entry.Name())
+			if !isExecutable(pluginPath) {
+				continue
+			}
+			names[name] = true
+
+			plugin := &Plugin{
+				Name: name,
+				Path: pluginPath,
+			}
+			if err := plugin.describe(ctx); err != nil {
+				io.Logger.Warn().Msgf("Skipped the plugin %s: %v", pluginPath, err)
+				continue
+			}
+			plugins = append(plugins, plugin)
+			io.Logger.Debug().Msgf("Loaded the plugin %s for %s", pluginPath, strings.Join(plugin.ResourceTypes, ", "))
+		}
+	}
+
+	return plugins
+}
+
+func pluginName(entry os.DirEntry) (string, bool) {
+	if entry.IsDir() || !strings.HasPrefix(entry.Name(), PluginPrefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(entry.Name(), PluginPrefix)
+	if runtime.GOOS == "windows" {
+		if !strings.EqualFold(filepath.Ext(name), ".exe") {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return name, name != ""
+}
+
+func isExecutable(pluginPath string) bool {
+	info, err := os.Stat(pluginPath)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	return runtime.GOOS == "windows" || info.Mode().Perm()&0o111 != 0
+}
+
+// Matches reports whether the plugin handles the resource type.
+func (p *Plugin) Matches(resourceType string) bool {
+	for _, pattern := range p.ResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Plugin) describe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pluginDescribeTimeout)
+	defer cancel()
+
+	response := &PluginDescribeResponse{}
+	if err := p.call(ctx, nil, &PluginRequest{
+		ProtocolVersion: PluginProtocolVersion,
+		Command:         pluginCommandDescribe,
+	}, response); err != nil {
+		return err
+	}
+	if len(response.ResourceTypes) == 0 {
+		return fmt.Errorf("PluginError: %s declares no resource types", p.Path)
+	}
+	for _, pattern := range response.ResourceTypes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("PluginError: %s declares an invalid resource type pattern %q: %w", p.Path, pattern, err)
+		}
+		if resourceType, ok := supportedResourceTypeMatching(pattern); ok {
+			return fmt.Errorf("PluginError: %s declares a resource type pattern %q that matches %s supported by delstack", p.Path, pattern, resourceType)
+		}
+	}
+
+	p.ResourceTypes = response.ResourceTypes
+	p.Description = response.Description
+	return nil
+}
+
+// supportedResourceTypeMatching returns the resource type in the operator registry that the pattern
+// matches. Plugins take precedence over the built-in operators, so they must not take over the types
+// delstack deletes by itself. The custom resources (`Custom::Xxx`) are the exception, since plugins
+// are meant to clean up the in-house ones.
+func supportedResourceTypeMatching(pattern string) (string, bool) {
+	for _, resource := range SupportedResources() {
+		if resource.ResourceType == resourcetype.CustomResource {
+			continue
+		}
+		if ok, _ := path.Match(pattern, resource.ResourceType); ok {
+			return resource.ResourceType, true
+		}
+	}
+	return "", false
+}
+
+// call runs the plugin with the request on stdin and decodes its stdout into the response. env is
+// appended to the environment of delstack.
+func (p *Plugin) call(ctx context.Context, env []string, request *PluginRequest, response any) error {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("PluginError: failed to encode the %s request for %s: %w", request.Command, p.Path, err)
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("PluginError: %s failed on the %s command: %w", p.Path, request.Command, err)
+	}
+	if err := json.Unmarshal(stdout.Bytes(), response); err != nil {
+		return fmt.Errorf("PluginError: %s returned an invalid response to the %s command: %w", p.Path, request.Command, err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+var _ IOperator = (*PluginOperator)(nil)
+
+// PluginOperator hands the resources of the types declared by a plugin over to it. The plugin runs
+// with the credentials and the region of delstack in the standard AWS environment variables, so it
+// works with the same profile as delstack.
+type PluginOperator struct {
+	plugin    *Plugin
+	config    aws.Config
+	forceMode bool
+	resources []*types.StackResourceSummary
+}
+
+func NewPluginOperator(config aws.Config, plugin *Plugin) *PluginOperator {
+	return &PluginOperator{
+		plugin:    plugin,
+		config:    config,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *PluginOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *PluginOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *PluginOperator) DeleteResources(ctx context.Context) error {
+	if len(o.resources) == 0 {
+		return nil
+	}
+
+	env, err := o.awsEnv(ctx)
+	if err != nil {
+		return err
+	}
+
+	request := &PluginRequest{
+		ProtocolVersion: PluginProtocolVersion,
+		Command:         pluginCommandDelete,
+		Region:          o.config.Region,
+		ForceMode:       o.forceMode,
+		Resources:       make([]PluginResource, 0, len(o.resources)),
+	}
+	for _, resource := range o.resources {
+		request.Resources = append(request.Resources, PluginResource{
+			LogicalResourceId:    aws.ToString(resource.LogicalResourceId),
+			PhysicalResourceId:   aws.ToString(resource.PhysicalResourceId),
+			ResourceType:         aws.ToString(resource.ResourceType),
+			ResourceStatus:       string(resource.ResourceStatus),
+			ResourceStatusReason: aws.ToString(resource.ResourceStatusReason),
+		})
+	}
+
+	io.Logger.Info().Msgf("Running the plugin %s for %d resources.", o.plugin.Name, len(o.resources))
+
+	response := &PluginDeleteResponse{}
+	if err := o.plugin.call(ctx, env, request, response); err != nil {
+		return err
+	}
+
+	if len(response.Failures) > 0 {
+		messages := make([]string, 0, len(response.Failures))
+		for _, failure := range response.Failures {
+			messages = append(messages, fmt.Sprintf("%s: %s", failure.LogicalResourceId, failure.Message))
+		}
+		return fmt.Errorf("PluginError: %s failed to delete the resources, %s", o.plugin.Path, strings.Join(messages, ", "))
+	}
+	return nil
+}
+
+// awsEnv passes the resolved credentials to the plugin, since a profile given by the -p option is not
+// visible to the plugin otherwise.
+func (o *PluginOperator) awsEnv(ctx context.Context) ([]string, error) {
+	env := []string{}
+	if o.config.Region != "" {
+		env = append(env, "AWS_REGION="+o.config.Region, "AWS_DEFAULT_REGION="+o.config.Region)
+	}
+	if o.config.Credentials == nil {
+		return env, nil
+	}
+
+	credentials, err := o.config.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PluginError: failed to retrieve the credentials for %s: %w", o.plugin.Path, err)
+	}
+	env = append(env,
+		"AWS_ACCESS_KEY_ID="+credentials.AccessKeyID,
+		"AWS_SECRET_ACCESS_KEY="+credentials.SecretAccessKey,
+		"AWS_SESSION_TOKEN="+credentials.SessionToken,
+	)
+	return env, nil
+}
@@ -0,0 +1,396 @@
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+// buildFakePlugin builds testdata/delstack-plugin-fake into a new directory and returns the directory.
+func buildFakePlugin(t *testing.T) string {
+	t.Helper()
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command is not found")
+	}
+
+	dir := t.TempDir()
+	name := PluginPrefix + "fake"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	cmd := exec.Command(goBin, "build", "-o", filepath.Join(dir, name), "./testdata/delstack-plugin-fake")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to build the fake plugin: %v\n%s", err, out)
+	}
+	return dir
+}
+
+/*
+	Test Cases
+*/
+
+func TestDiscoverPlugins(t *testing.T) {
+	io.NewLogger(false)
+
+	pluginDir := buildFakePlugin(t)
+
+	// A file with the prefix but without the executable bit is not a plugin.
+	nonExecutableDir := t.TempDir()
+	if runtime.GOOS != "windows" {
+		if err := os.WriteFile(filepath.Join(nonExecutableDir, PluginPrefix+"other"), []byte("#!/bin/sh\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		name     string
+		pathList string
+		env      map[string]string
+		want     []*Plugin
+	}{
+		{
+			name:     "discover a plugin with its resource types",
+			pathList: pluginDir,
+			want: []*Plugin{
+				{
+					Name:          "fake",
+					ResourceTypes: []string{"Custom::Fake*", "MongoDB::Atlas::Cluster"},
+					Description:   "Fake plugin for tests.",
+				},
+			},
+		},
+		{
+			name:     "first plugin wins when the same name is in several directories",
+			pathList: pluginDir + string(os.PathListSeparator) + buildFakePlugin(t),
+			want: []*Plugin{
+				{
+					Name:          "fake",
+					ResourceTypes: []string{"Custom::Fake*", "MongoDB::Atlas::Cluster"},
+					Description:   "Fake plugin for tests.",
+				},
+			},
+		},
+		{
+			name:     "skip non-executable files and missing directories",
+			pathList: nonExecutableDir + string(os.PathListSeparator) + filepath.Join(nonExecutableDir, "missing"),
+			want:     []*Plugin{},
+		},
+		{
+			name:     "declared resource types are given by the plugin",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_RESOURCE_TYPES": "Custom::TeamX*",
+			},
+			want: []*Plugin{
+				{
+					Name:          "fake",
+					ResourceTypes: []string{"Custom::TeamX*"},
+					Description:   "Fake plugin for tests.",
+				},
+			},
+		},
+		{
+			name:     "custom resource types may be taken over from the built-in handling",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_RESOURCE_TYPES": "Custom::*",
+			},
+			want: []*Plugin{
+				{
+					Name:          "fake",
+					ResourceTypes: []string{"Custom::*"},
+					Description:   "Fake plugin for tests.",
+				},
+			},
+		},
+		{
+			name:     "skip a plugin with an invalid resource type pattern",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_RESOURCE_TYPES": "Custom::[",
+			},
+			want: []*Plugin{},
+		},
+		{
+			name:     "skip a plugin with a resource type supported by delstack",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_RESOURCE_TYPES": "Custom::TeamX*,AWS::S3::Bucket",
+			},
+			want: []*Plugin{},
+		},
+		{
+			name:     "skip a plugin with a resource type pattern matching types supported by delstack",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_RESOURCE_TYPES": "AWS::*",
+			},
+			want: []*Plugin{},
+		},
+		{
+			name:     "skip a plugin that exits with an error",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_EXIT_CODE": "2",
+			},
+			want: []*Plugin{},
+		},
+		{
+			name:     "skip a plugin that returns an invalid response",
+			pathList: pluginDir,
+			env: map[string]string{
+				"FAKE_PLUGIN_INVALID_RESPONSE": "true",
+			},
+			want: []*Plugin{},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got := DiscoverPlugins(context.Background(), tt.pathList)
+
+			// The path depends on the temporary directory, so only the directory is checked.
+			for _, plugin := range got {
+				if filepath.Dir(plugin.Path) != pluginDir {
+					t.Errorf("path = %#v, want in %#v", plugin.Path, pluginDir)
+				}
+				plugin.Path = ""
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPluginLoader_Load(t *testing.T) {
+	io.NewLogger(false)
+
+	pluginDir := buildFakePlugin(t)
+
+	var nilLoader *PluginLoader
+	if got := nilLoader.Load(context.Background()); got != nil {
+		t.Errorf("got = %#v, want nil", got)
+	}
+
+	pluginLoader := NewPluginLoader(pluginDir)
+	first := pluginLoader.Load(context.Background())
+	if len(first) != 1 {
+		t.Fatalf("expected 1 plugin, got %d", len(first))
+	}
+
+	// The plugins are not discovered again, so a plugin broken afterwards does not matter.
+	t.Setenv("FAKE_PLUGIN_EXIT_CODE", "2")
+	second := pluginLoader.Load(context.Background())
+	if !reflect.DeepEqual(second, first) {
+		t.Errorf("got = %#v, want %#v", second, first)
+	}
+}
+
+func TestPlugin_Matches(t *testing.T) {
+	plugin := &Plugin{
+		Name:          "fake",
+		ResourceTypes: []string{"Custom::TeamX*", "MongoDB::Atlas::Cluster"},
+	}
+
+	cases := []struct {
+		name         string
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "match a wildcard pattern",
+			resourceType: "Custom::TeamXBucketCleaner",
+			want:         true,
+		},
+		{
+			name:         "match an exact resource type",
+			resourceType: "MongoDB::Atlas::Cluster",
+			want:         true,
+		},
+		{
+			name:         "not match other custom resources",
+			resourceType: "Custom::TeamY",
+			want:         false,
+		},
+		{
+			name:         "not match other registry types",
+			resourceType: "MongoDB::Atlas::Project",
+			want:         false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := plugin.Matches(tt.resourceType)
+			if got != tt.want {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPluginOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	pluginDir := buildFakePlugin(t)
+	plugins := DiscoverPlugins(context.Background(), pluginDir)
+	if len(plugins) != 1 {
+		t.Fatalf("failed to discover the fake plugin")
+	}
+
+	config := aws.Config{
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", "TOKEN"),
+	}
+
+	cases := []struct {
+		name       string
+		resources  []types.StackResourceSummary
+		env        map[string]string
+		forceMode  bool
+		want       error
+		wantErr    bool
+		wantRecord *PluginRequest
+	}{
+		{
+			name: "delete resources successfully",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Cluster"),
+					PhysicalResourceId: aws.String("cluster-1"),
+					ResourceType:       aws.String("MongoDB::Atlas::Cluster"),
+					ResourceStatus:     "DELETE_FAILED",
+				},
+				{
+					LogicalResourceId:    aws.String("Cleaner"),
+					PhysicalResourceId:   aws.String("cleaner-1"),
+					ResourceType:         aws.String("Custom::FakeCleaner"),
+					ResourceStatus:       "DELETE_FAILED",
+					ResourceStatusReason: aws.String("Failed to delete"),
+				},
+			},
+			env: map[string]string{
+				"FAKE_PLUGIN_WANT_ACCESS_KEY_ID": "AKID",
+			},
+			forceMode: true,
+			want:      nil,
+			wantErr:   false,
+			wantRecord: &PluginRequest{
+				ProtocolVersion: PluginProtocolVersion,
+				Command:         "delete",
+				Region:          "us-east-1",
+				ForceMode:       true,
+				Resources: []PluginResource{
+					{
+						LogicalResourceId:  "Cluster",
+						PhysicalResourceId: "cluster-1",
+						ResourceType:       "MongoDB::Atlas::Cluster",
+						ResourceStatus:     "DELETE_FAILED",
+					},
+					{
+						LogicalResourceId:    "Cleaner",
+						PhysicalResourceId:   "cleaner-1",
+						ResourceType:         "Custom::FakeCleaner",
+						ResourceStatus:       "DELETE_FAILED",
+						ResourceStatusReason: "Failed to delete",
+					},
+				},
+			},
+		},
+		{
+			name: "plugin reports failures",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("FailCluster"),
+					PhysicalResourceId: aws.String("cluster-1"),
+					ResourceType:       aws.String("MongoDB::Atlas::Cluster"),
+					ResourceStatus:     "DELETE_FAILED",
+				},
+			},
+			want:    fmt.Errorf("PluginError: %s failed to delete the resources, FailCluster: fake failure", plugins[0].Path),
+			wantErr: true,
+		},
+		{
+			name: "plugin exits with an error",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Cluster"),
+					PhysicalResourceId: aws.String("cluster-1"),
+					ResourceType:       aws.String("MongoDB::Atlas::Cluster"),
+					ResourceStatus:     "DELETE_FAILED",
+				},
+			},
+			env: map[string]string{
+				"FAKE_PLUGIN_EXIT_CODE": "3",
+			},
+			want:    fmt.Errorf("PluginError: %s failed on the delete command: exit status 3", plugins[0].Path),
+			wantErr: true,
+		},
+		{
+			name:      "do nothing without resources",
+			resources: []types.StackResourceSummary{},
+			env: map[string]string{
+				"FAKE_PLUGIN_EXIT_CODE": "3",
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			record := filepath.Join(t.TempDir(), "request.json")
+			t.Setenv("FAKE_PLUGIN_RECORD", record)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			pluginOperator := NewPluginOperator(config, plugins[0])
+			pluginOperator.forceMode = tt.forceMode
+			for _, resource := range tt.resources {
+				pluginOperator.AddResource(&resource)
+			}
+
+			err := pluginOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+
+			if tt.wantRecord != nil {
+				data, err := os.ReadFile(record)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := &PluginRequest{}
+				if err := json.Unmarshal(data, got); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.wantRecord) {
+					t.Errorf("got = %#v, want %#v", got, tt.wantRecord)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	rdsDBClusterRetryInterval = 30 * time.Second
+
+	// rdsDBClusterMaxRetryCount bounds each wait for a global cluster removal, a replica promotion
+	// or the deletion (about 1 hour with the default interval).
+	rdsDBClusterMaxRetryCount = 120
+
+	rdsDBClusterStatusAvailable = "available"
+	rdsDBClusterStatusDeleting  = "deleting"
+)
+
+var _ IOperator = (*RDSDBClusterOperator)(nil)
+
+// RDSDBClusterOperator deletes RDS DB clusters that cannot be deleted by CloudFormation because they
+// are members of a global cluster, have cross-region read replica clusters, or still have DB instances
+// added outside the stack (e.g. Aurora Auto Scaling replicas).
+//
+// A cluster in a global cluster leaves it before the deletion. Only when it is the primary (writer)
+// cluster, the other members are detached first (and thereby promoted to standalone clusters); a
+// secondary cluster detaches only itself. Read replica clusters are promoted, the remaining DB instances
+// are deleted, and then the cluster is deleted. In force mode, the final snapshot is skipped unless it
+// is requested.
+type RDSDBClusterOperator struct {
+	client client.IRDS
+	// regionalClientFn returns a client for a replica region, built from the same credentials.
+	regionalClientFn func(region string) client.IRDS
+	region           string
+	resources        []*types.StackResourceSummary
+	forceMode        bool
+	finalSnapshot    bool
+	// retryInterval is stored as a field (rather than using the constant directly)
+	// so that tests can override it to avoid long waits.
+	retryInterval time.Duration
+
+	regionalClients map[string]client.IRDS
+	mu              sync.Mutex
+}
+
+func NewRDSDBClusterOperator(
+	rdsClient client.IRDS,
+	regionalClientFn func(region string) client.IRDS,
+	region string,
+) *RDSDBClusterOperator {
+	return &RDSDBClusterOperator{
+		client:           rdsClient,
+		regionalClientFn: regionalClientFn,
+		region:           region,
+		resources:        []*types.StackResourceSummary{},
+		retryInterval:    rdsDBClusterRetryInterval,
+		regionalClients:  map[string]client.IRDS{},
+	}
+}
+
+func (o *RDSDBClusterOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *RDSDBClusterOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *RDSDBClusterOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, cluster := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteDBCluster(ctx, cluster.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *RDSDBClusterOperator) DeleteDBCluster(ctx context.Context, dbClusterId *string) error {
+	exists, err := o.client.CheckDBClusterExists(ctx, dbClusterId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	cluster, err := o.client.DescribeDBCluster(ctx, dbClusterId)
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(cluster.Status) != rdsDBClusterStatusDeleting {
+		if aws.ToString(cluster.GlobalClusterIdentifier) != "" {
+			if err := o.leaveGlobalCluster(ctx, dbClusterId, cluster.DBClusterArn, cluster.GlobalClusterIdentifier); err != nil {
+				return err
+			}
+			// Leaving the global cluster changes the replicas of the cluster.
+			cluster, err = o.client.DescribeDBCluster(ctx, dbClusterId)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := o.promoteReadReplicas(ctx, dbClusterId, cluster.ReadReplicaIdentifiers); err != nil {
+			return err
+		}
+
+		if err := o.deleteDBInstances(ctx, dbClusterId, cluster.DBClusterMembers); err != nil {
+			return err
+		}
+
+		var finalSnapshotIdentifier *string
+		if !o.forceMode || o.finalSnapshot {
+			finalSnapshotIdentifier = aws.String(fmt.Sprintf("%s-final-%s", aws.ToString(dbClusterId), time.Now().Format("20060102150405")))
+		}
+		if err := o.client.DeleteDBCluster(ctx, dbClusterId, finalSnapshotIdentifier); err != nil {
+			return err
+		}
+	}
+
+	return waitUntil(ctx, dbClusterId, o.retryInterval, rdsDBClusterMaxRetryCount, func() (bool, error) {
+		exists, err := o.client.CheckDBClusterExists(ctx, dbClusterId)
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	}, "DBClusterDeletionTimeoutError: the DB cluster is still being deleted")
+}
+
+// leaveGlobalCluster removes the cluster from the global cluster. A primary cluster cannot leave while
+// secondary clusters remain, so when the cluster is the primary, the other members are detached first,
+// which promotes them to standalone clusters in their regions. A secondary cluster detaches only itself
+// and leaves the rest of the global cluster as it is.
+func (o *RDSDBClusterOperator) leaveGlobalCluster(ctx context.Context, dbClusterId *string, dbClusterArn *string, globalClusterId *string) error {
+	globalCluster, err := o.client.DescribeGlobalCluster(ctx, globalClusterId)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range globalCluster.GlobalClusterMembers {
+		if aws.ToString(member.DBClusterArn) != aws.ToString(dbClusterArn) || !aws.ToBool(member.IsWriter) {
+			continue
+		}
+		if err := o.detachOtherMembers(ctx, globalClusterId, dbClusterArn, globalCluster.GlobalClusterMembers); err != nil {
+			return err
+		}
+	}
+
+	if err := o.client.RemoveFromGlobalCluster(ctx, globalClusterId, dbClusterArn); err != nil {
+		return err
+	}
+
+	if err := waitUntil(ctx, dbClusterId, o.retryInterval, rdsDBClusterMaxRetryCount, func() (bool, error) {
+		cluster, err := o.client.DescribeDBCluster(ctx, dbClusterId)
+		if err != nil {
+			return false, err
+		}
+		return aws.ToString(cluster.GlobalClusterIdentifier) == "" &&
+			aws.ToString(cluster.Status) == rdsDBClusterStatusAvailable, nil
+	}, "GlobalClusterRemovalTimeoutError: the DB cluster is still a member of the global cluster"); err != nil {
+		return err
+	}
+
+	io.Logger.Debug().Msgf("Removed the DB cluster %s from the global cluster %s", aws.ToString(dbClusterId), aws.ToString(globalClusterId))
+	return nil
+}
+
+// detachOtherMembers detaches the members of the global cluster other than the primary cluster, which
+// may be in other regions.
+func (o *RDSDBClusterOperator) detachOtherMembers(
+	ctx context.Context,
+	globalClusterId *string,
+	primaryClusterArn *string,
+	members []rdstypes.GlobalClusterMember,
+) error {
+	for _, member := range members {
+		if aws.ToString(member.DBClusterArn) == aws.ToString(primaryClusterArn) {
+			continue
+		}
+		regionalClient := o.getRegionalClient(o.getRegionFromArn(aws.ToString(member.DBClusterArn)))
+		if err := regionalClient.RemoveFromGlobalCluster(ctx, globalClusterId, member.DBClusterArn); err != nil {
+			return err
+		}
+		io.Logger.Info().Msgf("Detached the DB cluster %s from the global cluster %s, and it is promoted to a standalone cluster.", aws.ToString(member.DBClusterArn), aws.ToString(globalClusterId))
+	}
+	return nil
+}
+
+// promoteReadReplicas promotes the read replica clusters, which may be in other regions, to standalone
+// clusters so that the source cluster can be deleted.
+func (o *RDSDBClusterOperator) promoteReadReplicas(ctx context.Context, dbClusterId *string, replicaIdentifiers []string) error {
+	if len(replicaIdentifiers) == 0 {
+		return nil
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, replicaIdentifier := range replicaIdentifiers {
+		eg.Go(func() error {
+			// The identifier is an ARN for a replica in another region.
+			region := o.region
+			replicaId := replicaIdentifier
+			if strings.HasPrefix(replicaIdentifier, "arn:") {
+				region = o.getRegionFromArn(replicaIdentifier)
+				replicaId = replicaIdentifier[strings.LastIndex(replicaIdentifier, ":")+1:]
+			}
+
+			if err := o.getRegionalClient(region).PromoteReadReplicaDBCluster(egCtx, aws.String(replicaId)); err != nil {
+				return err
+			}
+			io.Logger.Info().Msgf("Promoted the read replica cluster %s of the DB cluster %s to a standalone cluster.", replicaIdentifier, aws.ToString(dbClusterId))
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return waitUntil(ctx, dbClusterId, o.retryInterval, rdsDBClusterMaxRetryCount, func() (bool, error) {
+		cluster, err := o.client.DescribeDBCluster(ctx, dbClusterId)
+		if err != nil {
+			return false, err
+		}
+		return len(cluster.ReadReplicaIdentifiers) == 0, nil
+	}, "ReadReplicaPromotionTimeoutError: the read replica clusters are still being promoted")
+}
+
+// deleteDBInstances deletes the DB instances remaining in the cluster. The instances in the stack
+// have already been deleted by CloudFormation, so these are the ones added outside the stack.
+func (o *RDSDBClusterOperator) deleteDBInstances(ctx context.Context, dbClusterId *string, members []rdstypes.DBClusterMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, member := range members {
+		if err := sem.Acquire(egCtx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			err := o.client.DeleteDBInstance(egCtx, member.DBInstanceIdentifier)
+			if err != nil && !strings.Contains(err.Error(), "is already being deleted") {
+				return err
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return waitUntil(ctx, dbClusterId, o.retryInterval, rdsDBClusterMaxRetryCount, func() (bool, error) {
+		cluster, err := o.client.DescribeDBCluster(ctx, dbClusterId)
+		if err != nil {
+			return false, err
+		}
+		return len(cluster.DBClusterMembers) == 0, nil
+	}, "DBInstanceDeletionTimeoutError: the DB instances in the DB cluster are still being deleted")
+}
+
+// getRegionFromArn returns the region in an ARN (`arn:<partition>:rds:<region>:<account>:cluster:<id>`).
+func (o *RDSDBClusterOperator) getRegionFromArn(arn string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 4 || parts[3] == "" {
+		return o.region
+	}
+	return parts[3]
+}
+
+func (o *RDSDBClusterOperator) getRegionalClient(region string) client.IRDS {
+	if region == o.region {
+		return o.client
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if regionalClient, ok := o.regionalClients[region]; ok {
+		return regionalClient
+	}
+	regionalClient := o.regionalClientFn(region)
+	o.regionalClients[region] = regionalClient
+	return regionalClient
+}
@@ -0,0 +1,415 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testRDSHomeRegion    = "ap-northeast-1"
+	testRDSReplicaRegion = "us-west-2"
+	testRDSClusterArn    = "arn:aws:rds:ap-northeast-1:123456789012:cluster:test"
+	testRDSSecondaryArn  = "arn:aws:rds:us-west-2:123456789012:cluster:secondary"
+	testRDSPrimaryArn    = "arn:aws:rds:us-west-2:123456789012:cluster:primary"
+)
+
+/*
+	Test Cases
+*/
+
+func TestRDSDBClusterOperator_DeleteDBCluster(t *testing.T) {
+	io.NewLogger(false)
+
+	availableCluster := &types.DBCluster{
+		DBClusterArn: aws.String(testRDSClusterArn),
+		Status:       aws.String("available"),
+	}
+	globalCluster := &types.DBCluster{
+		DBClusterArn:            aws.String(testRDSClusterArn),
+		GlobalClusterIdentifier: aws.String("global"),
+		Status:                  aws.String("available"),
+	}
+
+	cases := []struct {
+		name          string
+		forceMode     bool
+		finalSnapshot bool
+		prepareMockFn func(m *client.MockIRDS, r *client.MockIRDS)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name:      "delete db cluster successfully without final snapshot in force mode",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Nil()).Return(nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:          "delete db cluster successfully with final snapshot in force mode with final snapshot option",
+			forceMode:     true,
+			finalSnapshot: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Not(gomock.Nil())).Return(nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete db cluster successfully with final snapshot without force mode",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Not(gomock.Nil())).Return(nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete db cluster successfully for db cluster not exists",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete db cluster successfully for db cluster already being deleted",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+						Status: aws.String("deleting"),
+					}, nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete db cluster successfully after leaving global cluster with secondary cluster",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(globalCluster, nil),
+					m.EXPECT().DescribeGlobalCluster(gomock.Any(), aws.String("global")).Return(&types.GlobalCluster{
+						GlobalClusterMembers: []types.GlobalClusterMember{
+							{
+								DBClusterArn: aws.String(testRDSClusterArn),
+								IsWriter:     aws.Bool(true),
+							},
+							{
+								DBClusterArn: aws.String(testRDSSecondaryArn),
+							},
+						},
+					}, nil),
+					r.EXPECT().RemoveFromGlobalCluster(gomock.Any(), aws.String("global"), aws.String(testRDSSecondaryArn)).Return(nil),
+					m.EXPECT().RemoveFromGlobalCluster(gomock.Any(), aws.String("global"), aws.String(testRDSClusterArn)).Return(nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+						DBClusterArn:            aws.String(testRDSClusterArn),
+						GlobalClusterIdentifier: aws.String("global"),
+						Status:                  aws.String("modifying"),
+					}, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Nil()).Return(nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete db cluster successfully after leaving global cluster as secondary cluster",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(globalCluster, nil),
+					m.EXPECT().DescribeGlobalCluster(gomock.Any(), aws.String("global")).Return(&types.GlobalCluster{
+						GlobalClusterMembers: []types.GlobalClusterMember{
+							{
+								DBClusterArn: aws.String(testRDSPrimaryArn),
+								IsWriter:     aws.Bool(true),
+							},
+							{
+								DBClusterArn: aws.String(testRDSClusterArn),
+								IsWriter:     aws.Bool(false),
+							},
+							{
+								DBClusterArn: aws.String(testRDSSecondaryArn),
+								IsWriter:     aws.Bool(false),
+							},
+						},
+					}, nil),
+					// The primary and the other secondary cluster are not detached.
+					m.EXPECT().RemoveFromGlobalCluster(gomock.Any(), aws.String("global"), aws.String(testRDSClusterArn)).Return(nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Nil()).Return(nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete db cluster successfully after promoting read replica clusters",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				gomock.InOrder(
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+						DBClusterArn: aws.String(testRDSClusterArn),
+						Status:       aws.String("available"),
+						ReadReplicaIdentifiers: []string{
+							"arn:aws:rds:us-west-2:123456789012:cluster:replica",
+						},
+					}, nil),
+					r.EXPECT().PromoteReadReplicaDBCluster(gomock.Any(), aws.String("replica")).Return(nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+					m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Nil()).Return(nil),
+					m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil),
+				)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:      "delete db cluster successfully after deleting db instances",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+						DBClusterArn: aws.String(testRDSClusterArn),
+						Status:       aws.String("available"),
+						DBClusterMembers: []types.DBClusterMember{
+							{
+								DBInstanceIdentifier: aws.String("instance1"),
+							},
+							{
+								DBInstanceIdentifier: aws.String("instance2"),
+							},
+						},
+					}, nil),
+					m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil),
+				)
+				m.EXPECT().DeleteDBInstance(gomock.Any(), aws.String("instance1")).Return(nil)
+				m.EXPECT().DeleteDBInstance(gomock.Any(), aws.String("instance2")).Return(fmt.Errorf("InvalidDBInstanceState: Instance instance2 is already being deleted."))
+				m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Nil()).Return(nil)
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete db cluster failure for check db cluster exists errors",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeDBClustersError"))
+			},
+			want:    fmt.Errorf("DescribeDBClustersError"),
+			wantErr: true,
+		},
+		{
+			name: "delete db cluster failure for describe db cluster errors",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("DescribeDBClustersError"))
+			},
+			want:    fmt.Errorf("DescribeDBClustersError"),
+			wantErr: true,
+		},
+		{
+			name: "delete db cluster failure for describe global cluster errors",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(globalCluster, nil)
+				m.EXPECT().DescribeGlobalCluster(gomock.Any(), aws.String("global")).Return(nil, fmt.Errorf("DescribeGlobalClustersError"))
+			},
+			want:    fmt.Errorf("DescribeGlobalClustersError"),
+			wantErr: true,
+		},
+		{
+			name: "delete db cluster failure for remove from global cluster errors",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(globalCluster, nil)
+				m.EXPECT().DescribeGlobalCluster(gomock.Any(), aws.String("global")).Return(&types.GlobalCluster{
+					GlobalClusterMembers: []types.GlobalClusterMember{
+						{
+							DBClusterArn: aws.String(testRDSClusterArn),
+						},
+					},
+				}, nil)
+				m.EXPECT().RemoveFromGlobalCluster(gomock.Any(), aws.String("global"), aws.String(testRDSClusterArn)).Return(fmt.Errorf("RemoveFromGlobalClusterError"))
+			},
+			want:    fmt.Errorf("RemoveFromGlobalClusterError"),
+			wantErr: true,
+		},
+		{
+			name: "delete db cluster failure for promote read replica db cluster errors",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+					Status:                 aws.String("available"),
+					ReadReplicaIdentifiers: []string{"arn:aws:rds:us-west-2:123456789012:cluster:replica"},
+				}, nil)
+				r.EXPECT().PromoteReadReplicaDBCluster(gomock.Any(), aws.String("replica")).Return(fmt.Errorf("PromoteReadReplicaDBClusterError"))
+			},
+			want:    fmt.Errorf("PromoteReadReplicaDBClusterError"),
+			wantErr: true,
+		},
+		{
+			name: "delete db cluster failure for delete db instance errors",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+					Status: aws.String("available"),
+					DBClusterMembers: []types.DBClusterMember{
+						{
+							DBInstanceIdentifier: aws.String("instance1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().DeleteDBInstance(gomock.Any(), aws.String("instance1")).Return(fmt.Errorf("DeleteDBInstanceError"))
+			},
+			want:    fmt.Errorf("DeleteDBInstanceError"),
+			wantErr: true,
+		},
+		{
+			name:      "delete db cluster failure for delete db cluster errors",
+			forceMode: true,
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(availableCluster, nil)
+				m.EXPECT().DeleteDBCluster(gomock.Any(), aws.String("test"), gomock.Nil()).Return(fmt.Errorf("DeleteDBClusterError"))
+			},
+			want:    fmt.Errorf("DeleteDBClusterError"),
+			wantErr: true,
+		},
+		{
+			name: "delete db cluster failure for deletion timeout",
+			prepareMockFn: func(m *client.MockIRDS, r *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(true, nil).Times(rdsDBClusterMaxRetryCount + 2)
+				m.EXPECT().DescribeDBCluster(gomock.Any(), aws.String("test")).Return(&types.DBCluster{
+					Status: aws.String("deleting"),
+				}, nil)
+			},
+			want: &client.ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("DBClusterDeletionTimeoutError: the DB cluster is still being deleted"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			rdsMock := client.NewMockIRDS(ctrl)
+			regionalRDSMock := client.NewMockIRDS(ctrl)
+			tt.prepareMockFn(rdsMock, regionalRDSMock)
+
+			regionalClientFn := func(region string) client.IRDS {
+				if region != testRDSReplicaRegion {
+					t.Fatalf("unexpected region: %s", region)
+				}
+				return regionalRDSMock
+			}
+
+			rdsDBClusterOperator := NewRDSDBClusterOperator(rdsMock, regionalClientFn, testRDSHomeRegion)
+			rdsDBClusterOperator.forceMode = tt.forceMode
+			rdsDBClusterOperator.finalSnapshot = tt.finalSnapshot
+			rdsDBClusterOperator.retryInterval = 0
+
+			err := rdsDBClusterOperator.DeleteDBCluster(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestRDSDBClusterOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockIRDS)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockIRDS) {
+				m.EXPECT().CheckDBClusterExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeDBClustersError"))
+			},
+			want:    fmt.Errorf("DescribeDBClustersError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			rdsMock := client.NewMockIRDS(ctrl)
+			tt.prepareMockFn(rdsMock)
+
+			rdsDBClusterOperator := NewRDSDBClusterOperator(rdsMock, nil, testRDSHomeRegion)
+			rdsDBClusterOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::RDS::DBCluster"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := rdsDBClusterOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/preprocessor"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ preprocessor.IPreprocessor = (*ResourceEmptier)(nil)
+
+// ResourceEmptier empties the S3 buckets and the ECR repositories in the stack, including nested
+// stacks, in the background while CloudFormation deletes the stack. Without it, they are emptied only
+// after the first deletion of the stack has failed on them, which serializes the emptying of huge
+// buckets behind the deletion.
+//
+// The preprocessing only collects the resources to be emptied. The stack operator starts the
+// emptying once the stack has passed its checks (e.g. termination protection) and is being deleted,
+// and cancels it if the deletion fails, so that no data is lost for a stack which is not deleted.
+//
+// The emptying shares the logic of S3BucketOperator and EcrRepositoryOperator, which delete the
+// buckets and the repositories left in DELETE_FAILED afterwards. The stack operator waits for the
+// emptying before that, so that they are not emptied twice.
+//
+// The resources retained by their DeletionPolicy are not emptied, as their data must survive. Nor are
+// the ones in nested stacks retained by it, which the recursive preprocessing does not enter.
+type ResourceEmptier struct {
+	cfnClient             client.ICloudFormation
+	s3BucketOperator      *S3BucketOperator
+	ecrRepositoryOperator *EcrRepositoryOperator
+
+	mu      sync.Mutex
+	targets []emptyingTarget
+
+	sem *semaphore.Weighted
+	wg  sync.WaitGroup
+
+	// ctx is the context of the emptying, which outlives the preprocessing and is canceled by Wait
+	// when the deletion is canceled, or by Cancel when the deletion fails.
+	startOnce sync.Once
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+type emptyingTarget struct {
+	stackName *string
+	name      *string
+	empty     func(ctx context.Context, name *string) error
+}
+
+func NewResourceEmptier(cfnClient client.ICloudFormation, s3BucketOperator *S3BucketOperator, ecrRepositoryOperator *EcrRepositoryOperator) *ResourceEmptier {
+	return &ResourceEmptier{
+		cfnClient:             cfnClient,
+		s3BucketOperator:      s3BucketOperator,
+		ecrRepositoryOperator: ecrRepositoryOperator,
+		targets:               []emptyingTarget{},
+		sem:                   semaphore.NewWeighted(int64(runtime.NumCPU())),
+	}
+}
+
+// Preprocess collects the resources to be emptied. Use Start to start emptying them.
+func (e *ResourceEmptier) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	buckets := preprocessor.FilterResourcesByType(resources, resourcetype.S3Bucket)
+	repositories := preprocessor.FilterResourcesByType(resources, resourcetype.EcrRepository)
+
+	if len(buckets) == 0 && len(repositories) == 0 {
+		return nil
+	}
+
+	template, err := e.cfnClient.GetTemplate(ctx, stackName)
+	if err != nil {
+		return fmt.Errorf("failed to get the template to find retained resources: %w", err)
+	}
+	retained, err := preprocessor.RetainedLogicalResourceIds(template)
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets {
+		e.add(stackName, bucket, retained, e.emptyS3Bucket)
+	}
+	for _, repository := range repositories {
+		e.add(stackName, repository, retained, e.ecrRepositoryOperator.EmptyEcrRepository)
+	}
+
+	return nil
+}
+
+// Start starts emptying the resources collected by Preprocess and returns without waiting for it.
+// The emptying is started only once. A nil emptier has nothing to start.
+func (e *ResourceEmptier) Start(ctx context.Context) {
+	if e == nil {
+		return
+	}
+
+	e.startOnce.Do(func() {
+		e.ctx, e.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		for _, target := range e.targets {
+			e.start(e.ctx, target)
+		}
+	})
+}
+
+// Wait waits for the emptying started by Start, or cancels it when ctx is canceled. A nil emptier
+// has nothing to wait for.
+func (e *ResourceEmptier) Wait(ctx context.Context) {
+	if e == nil {
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		if e.cancel != nil {
+			e.cancel()
+		}
+		<-done
+	}
+
+	if e.cancel != nil {
+		e.cancel()
+	}
+}
+
+// Cancel stops the emptying started by Start and waits for it to stop. The emptying is never
+// started after it. A nil emptier has nothing to cancel.
+func (e *ResourceEmptier) Cancel() {
+	if e == nil {
+		return
+	}
+
+	// Prevent the emptying from being started afterwards.
+	e.startOnce.Do(func() {})
+
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+}
+
+func (e *ResourceEmptier) add(
+	stackName *string,
+	resource types.StackResourceSummary,
+	retained map[string]struct{},
+	empty func(ctx context.Context, name *string) error,
+) {
+	name := resource.PhysicalResourceId
+	if aws.ToString(name) == "" {
+		return
+	}
+	if _, ok := retained[aws.ToString(resource.LogicalResourceId)]; ok {
+		io.Logger.Debug().Msgf("[%v]: Skip emptying %s retained by its DeletionPolicy", aws.ToString(stackName), aws.ToString(name))
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.targets = append(e.targets, emptyingTarget{
+		stackName: stackName,
+		name:      name,
+		empty:     empty,
+	})
+}
+
+func (e *ResourceEmptier) start(ctx context.Context, target emptyingTarget) {
+	stackName := aws.ToString(target.stackName)
+	name := aws.ToString(target.name)
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		if err := e.sem.Acquire(ctx, 1); err != nil {
+			return
+		}
+		defer e.sem.Release(1)
+
+		io.Logger.Debug().Msgf("[%v]: Emptying %s in parallel with the stack deletion", stackName, name)
+		if err := target.empty(ctx, target.name); err != nil {
+			io.Logger.Warn().Msgf("[%v]: Failed to empty %s in advance (continuing): %v", stackName, name, err)
+			return
+		}
+		io.Logger.Debug().Msgf("[%v]: Emptied %s", stackName, name)
+	}()
+}
+
+func (e *ResourceEmptier) emptyS3Bucket(ctx context.Context, bucketName *string) error {
+	exists, err := e.s3BucketOperator.client.CheckBucketExists(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	return e.s3BucketOperator.EmptyS3Bucket(ctx, bucketName)
+}
@@ -0,0 +1,262 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestResourceEmptier_Preprocess(t *testing.T) {
+	io.NewLogger(false)
+
+	template := `Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  RetainedBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+  Repository:
+    Type: AWS::ECR::Repository
+`
+
+	cases := []struct {
+		name          string
+		resources     []types.StackResourceSummary
+		prepareMockFn func(cfnMock *client.MockICloudFormation, s3Mock *client.MockIS3, ecrMock *client.MockIEcr)
+		wantErr       bool
+	}{
+		{
+			name: "no buckets nor repositories",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Topic"),
+					PhysicalResourceId: aws.String("test-topic"),
+					ResourceType:       aws.String("AWS::SNS::Topic"),
+				},
+			},
+			prepareMockFn: func(cfnMock *client.MockICloudFormation, s3Mock *client.MockIS3, ecrMock *client.MockIEcr) {},
+			wantErr:       false,
+		},
+		{
+			name: "empty buckets and repositories except retained ones",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Bucket"),
+					PhysicalResourceId: aws.String("test-bucket"),
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+				},
+				{
+					LogicalResourceId:  aws.String("RetainedBucket"),
+					PhysicalResourceId: aws.String("test-retained-bucket"),
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+				},
+				{
+					LogicalResourceId:  aws.String("Repository"),
+					PhysicalResourceId: aws.String("test-repository"),
+					ResourceType:       aws.String("AWS::ECR::Repository"),
+				},
+			},
+			prepareMockFn: func(cfnMock *client.MockICloudFormation, s3Mock *client.MockIS3, ecrMock *client.MockIEcr) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String(template), nil)
+				s3Mock.EXPECT().CheckBucketExists(gomock.Any(), aws.String("test-bucket")).Return(true, nil)
+				s3Mock.EXPECT().ListObjectsOrVersionsByPage(gomock.Any(), aws.String("test-bucket"), nil, nil).Return(
+					&client.ListObjectsOrVersionsByPageOutput{
+						ObjectIdentifiers: []s3types.ObjectIdentifier{
+							{
+								Key:       aws.String("Key"),
+								VersionId: aws.String("VersionId"),
+							},
+						},
+					}, nil,
+				)
+				s3Mock.EXPECT().DeleteObjects(gomock.Any(), aws.String("test-bucket"), gomock.Any()).Return([]s3types.Error{}, nil)
+				ecrMock.EXPECT().CheckEcrExists(gomock.Any(), aws.String("test-repository")).Return(true, nil)
+				ecrMock.EXPECT().ListImageIds(gomock.Any(), aws.String("test-repository")).Return(
+					[]ecrtypes.ImageIdentifier{{ImageDigest: aws.String("sha256:1")}}, nil,
+				)
+				ecrMock.EXPECT().BatchDeleteImages(gomock.Any(), aws.String("test-repository"), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "emptying errors do not fail preprocessing",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Bucket"),
+					PhysicalResourceId: aws.String("test-bucket"),
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+				},
+			},
+			prepareMockFn: func(cfnMock *client.MockICloudFormation, s3Mock *client.MockIS3, ecrMock *client.MockIEcr) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String(template), nil)
+				s3Mock.EXPECT().CheckBucketExists(gomock.Any(), aws.String("test-bucket")).Return(false, fmt.Errorf("HeadBucketError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip buckets already deleted",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Bucket"),
+					PhysicalResourceId: aws.String("test-bucket"),
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+					ResourceStatus:     types.ResourceStatusDeleteComplete,
+				},
+			},
+			prepareMockFn: func(cfnMock *client.MockICloudFormation, s3Mock *client.MockIS3, ecrMock *client.MockIEcr) {},
+			wantErr:       false,
+		},
+		{
+			name: "get template failure",
+			resources: []types.StackResourceSummary{
+				{
+					LogicalResourceId:  aws.String("Bucket"),
+					PhysicalResourceId: aws.String("test-bucket"),
+					ResourceType:       aws.String("AWS::S3::Bucket"),
+				},
+			},
+			prepareMockFn: func(cfnMock *client.MockICloudFormation, s3Mock *client.MockIS3, ecrMock *client.MockIEcr) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(nil, fmt.Errorf("GetTemplateError"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cfnMock := client.NewMockICloudFormation(ctrl)
+			s3Mock := client.NewMockIS3(ctrl)
+			ecrMock := client.NewMockIEcr(ctrl)
+			tt.prepareMockFn(cfnMock, s3Mock, ecrMock)
+
+			emptier := NewResourceEmptier(cfnMock, NewS3BucketOperator(s3Mock), NewEcrRepositoryOperator(ecrMock))
+
+			err := emptier.Preprocess(context.Background(), aws.String("test-stack"), tt.resources)
+			emptier.Start(context.Background())
+			emptier.Wait(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourceEmptier_Wait_Canceled(t *testing.T) {
+	io.NewLogger(false)
+
+	ctrl := gomock.NewController(t)
+	cfnMock := client.NewMockICloudFormation(ctrl)
+	s3Mock := client.NewMockIS3(ctrl)
+	ecrMock := client.NewMockIEcr(ctrl)
+
+	cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+	// The emptying blocks until it is canceled.
+	started := make(chan struct{})
+	s3Mock.EXPECT().CheckBucketExists(gomock.Any(), aws.String("test-bucket")).DoAndReturn(
+		func(ctx context.Context, bucketName *string) (bool, error) {
+			close(started)
+			<-ctx.Done()
+			return false, ctx.Err()
+		},
+	)
+
+	emptier := NewResourceEmptier(cfnMock, NewS3BucketOperator(s3Mock), NewEcrRepositoryOperator(ecrMock))
+
+	// The context of the preprocessing is canceled after it, but the emptying continues.
+	preprocessCtx, cancelPreprocess := context.WithCancel(context.Background())
+	err := emptier.Preprocess(preprocessCtx, aws.String("test-stack"), []types.StackResourceSummary{
+		{
+			LogicalResourceId:  aws.String("Bucket"),
+			PhysicalResourceId: aws.String("test-bucket"),
+			ResourceType:       aws.String("AWS::S3::Bucket"),
+		},
+	})
+	cancelPreprocess()
+	if err != nil {
+		t.Fatal(err)
+	}
+	emptier.Start(preprocessCtx)
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	emptier.Wait(ctx)
+}
+
+func TestResourceEmptier_Cancel(t *testing.T) {
+	io.NewLogger(false)
+
+	resources := []types.StackResourceSummary{
+		{
+			LogicalResourceId:  aws.String("Bucket"),
+			PhysicalResourceId: aws.String("test-bucket"),
+			ResourceType:       aws.String("AWS::S3::Bucket"),
+		},
+	}
+
+	t.Run("cancel the emptying in progress", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cfnMock := client.NewMockICloudFormation(ctrl)
+		s3Mock := client.NewMockIS3(ctrl)
+		ecrMock := client.NewMockIEcr(ctrl)
+
+		cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+		// The emptying blocks until it is canceled.
+		started := make(chan struct{})
+		s3Mock.EXPECT().CheckBucketExists(gomock.Any(), aws.String("test-bucket")).DoAndReturn(
+			func(ctx context.Context, bucketName *string) (bool, error) {
+				close(started)
+				<-ctx.Done()
+				return false, ctx.Err()
+			},
+		)
+
+		emptier := NewResourceEmptier(cfnMock, NewS3BucketOperator(s3Mock), NewEcrRepositoryOperator(ecrMock))
+		if err := emptier.Preprocess(context.Background(), aws.String("test-stack"), resources); err != nil {
+			t.Fatal(err)
+		}
+		emptier.Start(context.Background())
+		<-started
+
+		emptier.Cancel()
+	})
+
+	t.Run("never start the emptying after cancel", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cfnMock := client.NewMockICloudFormation(ctrl)
+		s3Mock := client.NewMockIS3(ctrl)
+		ecrMock := client.NewMockIEcr(ctrl)
+
+		// No bucket is checked nor emptied.
+		cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+
+		emptier := NewResourceEmptier(cfnMock, NewS3BucketOperator(s3Mock), NewEcrRepositoryOperator(ecrMock))
+		if err := emptier.Preprocess(context.Background(), aws.String("test-stack"), resources); err != nil {
+			t.Fatal(err)
+		}
+
+		emptier.Cancel()
+		emptier.Start(context.Background())
+		emptier.Wait(context.Background())
+	})
+}
+
+func TestResourceEmptier_Nil(t *testing.T) {
+	var emptier *ResourceEmptier
+	emptier.Start(context.Background())
+	emptier.Wait(context.Background())
+	emptier.Cancel()
+}
@@ -0,0 +1,49 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+var _ IOperator = (*RetainOperator)(nil)
+
+// RetainOperator deletes nothing, like CustomOperator, so that the resources of unsupported types
+// allowed by the retain policy are retained from the stack. They are recorded in the leftover report
+// of the policy instead, once the stack has been deleted.
+type RetainOperator struct {
+	stackName string
+	policy    *RetainPolicy
+	resources []*types.StackResourceSummary
+}
+
+func NewRetainOperator(stackName string, policy *RetainPolicy) *RetainOperator {
+	return &RetainOperator{
+		stackName: stackName,
+		policy:    policy,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *RetainOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *RetainOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *RetainOperator) DeleteResources(ctx context.Context) error {
+	for _, resource := range o.resources {
+		o.policy.record(Leftover{
+			StackName:          o.stackName,
+			LogicalResourceId:  aws.ToString(resource.LogicalResourceId),
+			PhysicalResourceId: aws.ToString(resource.PhysicalResourceId),
+			ResourceType:       aws.ToString(resource.ResourceType),
+		})
+		io.Logger.Info().Msgf("[%v]: Retaining %s (%s) from the stack as a leftover.", o.stackName, aws.ToString(resource.LogicalResourceId), aws.ToString(resource.ResourceType))
+	}
+	return nil
+}
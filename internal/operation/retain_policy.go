@@ -0,0 +1,144 @@
+package operation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+// RetainPolicy retains the DELETE_FAILED resources of unsupported types matching its patterns from the
+// stacks, so that the stacks can still be deleted, and records them as leftovers to be handed to the
+// teams owning them. It is shared by every stack in a run, including nested stacks.
+//
+// The resources are recorded as pending when they are retained, and become leftovers only when their
+// stack is deleted, since they are not retained if the deletion of the stack fails.
+type RetainPolicy struct {
+	// patterns are the resource type patterns in the syntax of path.Match (e.g. `Datadog::*`).
+	patterns []string
+
+	mu        sync.Mutex
+	pending   map[string][]Leftover
+	leftovers []Leftover
+}
+
+// Leftover is a resource retained from a stack, which remains in the account after the deletion.
+type Leftover struct {
+	StackName          string `json:"stackName"`
+	LogicalResourceId  string `json:"logicalResourceId"`
+	PhysicalResourceId string `json:"physicalResourceId"`
+	ResourceType       string `json:"resourceType"`
+}
+
+func NewRetainPolicy(patterns []string) (*RetainPolicy, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("InvalidOptionError: invalid resource type pattern %q for --retainUnsupported: %w", pattern, err)
+		}
+	}
+	return &RetainPolicy{
+		patterns:  patterns,
+		pending:   map[string][]Leftover{},
+		leftovers: []Leftover{},
+	}, nil
+}
+
+// Matches reports whether the resources of the type are retained. A nil policy retains nothing.
+func (p *RetainPolicy) Matches(resourceType string) bool {
+	if p == nil {
+		return false
+	}
+	for _, pattern := range p.patterns {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// record records the resource being retained from its stack as pending until the stack is deleted.
+func (p *RetainPolicy) record(leftover Leftover) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pending[leftover.StackName] = append(p.pending[leftover.StackName], leftover)
+}
+
+// confirm makes the pending resources of the stack leftovers once the stack has been deleted. A nil
+// policy has nothing to confirm.
+func (p *RetainPolicy) confirm(stackName string) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, leftover := range p.pending[stackName] {
+		if !slices.Contains(p.leftovers, leftover) {
+			p.leftovers = append(p.leftovers, leftover)
+		}
+	}
+	delete(p.pending, stackName)
+}
+
+// Leftovers returns the retained resources sorted by the stack name and the logical ID.
+func (p *RetainPolicy) Leftovers() []Leftover {
+	if p == nil {
+		return []Leftover{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	leftovers := make([]Leftover, len(p.leftovers))
+	copy(leftovers, p.leftovers)
+	sort.Slice(leftovers, func(i, j int) bool {
+		if leftovers[i].StackName != leftovers[j].StackName {
+			return leftovers[i].StackName < leftovers[j].StackName
+		}
+		return leftovers[i].LogicalResourceId < leftovers[j].LogicalResourceId
+	})
+	return leftovers
+}
+
+// Report outputs the leftovers as a table, and writes them to reportPath as JSON if it is given.
+// Nothing is output when no resource has been retained.
+func (p *RetainPolicy) Report(reportPath string) error {
+	leftovers := p.Leftovers()
+
+	if reportPath != "" {
+		data, err := json.MarshalIndent(leftovers, "", "  ")
+		if err != nil {
+			return fmt.Errorf("LeftoverReportError: failed to encode the leftover report, %w", err)
+		}
+		if err := os.WriteFile(reportPath, append(data, '\n'), 0o644); err != nil {
+			return fmt.Errorf("LeftoverReportError: failed to write the leftover report, %w", err)
+		}
+	}
+
+	if len(leftovers) == 0 {
+		return nil
+	}
+
+	header := []string{"StackName", "ResourceType", "LogicalResourceId", "PhysicalResourceId"}
+	data := [][]string{}
+	for _, leftover := range leftovers {
+		data = append(data, []string{leftover.StackName, leftover.ResourceType, leftover.LogicalResourceId, leftover.PhysicalResourceId})
+	}
+	table, err := io.ToStringAsTableFormat(header, data)
+	if err != nil {
+		return fmt.Errorf("LeftoverReportError: failed to create the leftover table, %w", err)
+	}
+
+	io.Logger.Warn().Msgf("The following resources were retained from the stacks and remain in the account:\n%s", *table)
+	if reportPath != "" {
+		io.Logger.Info().Msgf("The leftover report is written to %s.", reportPath)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+)
+
+func TestNewRetainPolicy(t *testing.T) {
+	cases := []struct {
+		name     string
+		patterns []string
+		want     error
+		wantErr  bool
+	}{
+		{
+			name:     "valid patterns",
+			patterns: []string{"Datadog::*", "MongoDB::Atlas::Cluster"},
+			want:     nil,
+			wantErr:  false,
+		},
+		{
+			name:     "invalid pattern",
+			patterns: []string{"Datadog::["},
+			want:     fmt.Errorf("InvalidOptionError: invalid resource type pattern %q for --retainUnsupported: syntax error in pattern", "Datadog::["),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRetainPolicy(tt.patterns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestRetainPolicy_Matches(t *testing.T) {
+	retainPolicy, err := NewRetainPolicy([]string{"Datadog::*", "MongoDB::Atlas::Cluster"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name         string
+		policy       *RetainPolicy
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "wildcard pattern",
+			policy:       retainPolicy,
+			resourceType: "Datadog::Monitors::Monitor",
+			want:         true,
+		},
+		{
+			name:         "exact pattern",
+			policy:       retainPolicy,
+			resourceType: "MongoDB::Atlas::Cluster",
+			want:         true,
+		},
+		{
+			name:         "unmatched type",
+			policy:       retainPolicy,
+			resourceType: "MongoDB::Atlas::Project",
+			want:         false,
+		},
+		{
+			name:         "nil policy",
+			policy:       nil,
+			resourceType: "Datadog::Monitors::Monitor",
+			want:         false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Matches(tt.resourceType); got != tt.want {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetainPolicy_Report(t *testing.T) {
+	io.NewLogger(false)
+
+	retainPolicy, err := NewRetainPolicy([]string{"Datadog::*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same resources are retained twice, as the deletion of a stack is retried. StackC is not
+	// deleted, so its resources are not retained.
+	for _, stackName := range []string{"StackB", "StackA", "StackB", "StackC"} {
+		operator := NewRetainOperator(stackName, retainPolicy)
+		operator.AddResource(&types.StackResourceSummary{
+			LogicalResourceId:  aws.String("Monitor2"),
+			PhysicalResourceId: aws.String("PhysicalResourceId2"),
+			ResourceType:       aws.String("Datadog::Monitors::Monitor"),
+		})
+		operator.AddResource(&types.StackResourceSummary{
+			LogicalResourceId:  aws.String("Monitor1"),
+			PhysicalResourceId: aws.String("PhysicalResourceId1"),
+			ResourceType:       aws.String("Datadog::Monitors::Monitor"),
+		})
+		if err := operator.DeleteResources(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	retainPolicy.confirm("StackA")
+	retainPolicy.confirm("StackB")
+
+	reportPath := filepath.Join(t.TempDir(), "leftovers.json")
+	if err := retainPolicy.Report(reportPath); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []Leftover{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Leftover{
+		{StackName: "StackA", LogicalResourceId: "Monitor1", PhysicalResourceId: "PhysicalResourceId1", ResourceType: "Datadog::Monitors::Monitor"},
+		{StackName: "StackA", LogicalResourceId: "Monitor2", PhysicalResourceId: "PhysicalResourceId2", ResourceType: "Datadog::Monitors::Monitor"},
+		{StackName: "StackB", LogicalResourceId: "Monitor1", PhysicalResourceId: "PhysicalResourceId1", ResourceType: "Datadog::Monitors::Monitor"},
+		{StackName: "StackB", LogicalResourceId: "Monitor2", PhysicalResourceId: "PhysicalResourceId2", ResourceType: "Datadog::Monitors::Monitor"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got = %#v, want %#v", got, want)
+	}
+}
+
+func TestRetainPolicy_Report_Empty(t *testing.T) {
+	io.NewLogger(false)
+
+	retainPolicy, err := NewRetainPolicy([]string{"Datadog::*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reportPath := filepath.Join(t.TempDir(), "leftovers.json")
+	if err := retainPolicy.Report(reportPath); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "[]\n" {
+		t.Errorf("got = %q, want %q", string(data), "[]\n")
+	}
+}
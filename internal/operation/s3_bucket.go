@@ -62,6 +62,19 @@ func (o *S3BucketOperator) DeleteS3Bucket(ctx context.Context, bucketName *strin
 		return nil
 	}
 
+	if err := o.EmptyS3Bucket(ctx, bucketName); err != nil {
+		return err
+	}
+
+	if err := o.client.DeleteBucket(ctx, bucketName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EmptyS3Bucket deletes all the objects in the bucket, including all the versions.
+func (o *S3BucketOperator) EmptyS3Bucket(ctx context.Context, bucketName *string) error {
 	eg := errgroup.Group{}
 	errorStr := ""
 	errorsCount := 0
@@ -135,10 +148,6 @@ func (o *S3BucketOperator) DeleteS3Bucket(ctx context.Context, bucketName *strin
 		}
 	}
 
-	if err := o.client.DeleteBucket(ctx, bucketName); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -0,0 +1,90 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*StepFunctionsStateMachineOperator)(nil)
+
+// StepFunctionsStateMachineOperator deletes state machines after stopping their running executions.
+// A state machine with running executions stays in the DELETING status until all of them finish,
+// which can take up to a year for standard workflows.
+type StepFunctionsStateMachineOperator struct {
+	client    client.ISFN
+	resources []*types.StackResourceSummary
+}
+
+func NewStepFunctionsStateMachineOperator(sfnClient client.ISFN) *StepFunctionsStateMachineOperator {
+	return &StepFunctionsStateMachineOperator{
+		client:    sfnClient,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *StepFunctionsStateMachineOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *StepFunctionsStateMachineOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *StepFunctionsStateMachineOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteStateMachine(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *StepFunctionsStateMachineOperator) DeleteStateMachine(ctx context.Context, stateMachineArn *string) error {
+	exists, err := o.client.CheckStateMachineExists(ctx, stateMachineArn)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	executions, err := o.client.ListRunningExecutions(ctx, stateMachineArn)
+	if err != nil {
+		return err
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, execution := range executions {
+		if err := sem.Acquire(egCtx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.client.StopExecution(egCtx, execution.ExecutionArn)
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return o.client.DeleteStateMachine(ctx, stateMachineArn)
+}
@@ -0,0 +1,180 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestStepFunctionsStateMachineOperator_DeleteStateMachine(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockISFN)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete state machine successfully",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListRunningExecutions(gomock.Any(), aws.String("test")).Return([]types.ExecutionListItem{}, nil)
+				m.EXPECT().DeleteStateMachine(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete state machine successfully for state machine not exists",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete state machine successfully after stopping running executions",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListRunningExecutions(gomock.Any(), aws.String("test")).Return([]types.ExecutionListItem{
+					{
+						ExecutionArn: aws.String("execution1"),
+					},
+					{
+						ExecutionArn: aws.String("execution2"),
+					},
+				}, nil)
+				m.EXPECT().StopExecution(gomock.Any(), aws.String("execution1")).Return(nil)
+				m.EXPECT().StopExecution(gomock.Any(), aws.String("execution2")).Return(nil)
+				m.EXPECT().DeleteStateMachine(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete state machine failure for check state machine exists errors",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeStateMachineError"))
+			},
+			want:    fmt.Errorf("DescribeStateMachineError"),
+			wantErr: true,
+		},
+		{
+			name: "delete state machine failure for list running executions errors",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListRunningExecutions(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListExecutionsError"))
+			},
+			want:    fmt.Errorf("ListExecutionsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete state machine failure for stop execution errors",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListRunningExecutions(gomock.Any(), aws.String("test")).Return([]types.ExecutionListItem{
+					{
+						ExecutionArn: aws.String("execution1"),
+					},
+				}, nil)
+				m.EXPECT().StopExecution(gomock.Any(), aws.String("execution1")).Return(fmt.Errorf("StopExecutionError"))
+			},
+			want:    fmt.Errorf("StopExecutionError"),
+			wantErr: true,
+		},
+		{
+			name: "delete state machine failure for delete state machine errors",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListRunningExecutions(gomock.Any(), aws.String("test")).Return([]types.ExecutionListItem{}, nil)
+				m.EXPECT().DeleteStateMachine(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteStateMachineError"))
+			},
+			want:    fmt.Errorf("DeleteStateMachineError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sfnMock := client.NewMockISFN(ctrl)
+			tt.prepareMockFn(sfnMock)
+
+			stepFunctionsStateMachineOperator := NewStepFunctionsStateMachineOperator(sfnMock)
+
+			err := stepFunctionsStateMachineOperator.DeleteStateMachine(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestStepFunctionsStateMachineOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockISFN)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockISFN) {
+				m.EXPECT().CheckStateMachineExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("DescribeStateMachineError"))
+			},
+			want:    fmt.Errorf("DescribeStateMachineError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sfnMock := client.NewMockISFN(ctrl)
+			tt.prepareMockFn(sfnMock)
+
+			stepFunctionsStateMachineOperator := NewStepFunctionsStateMachineOperator(sfnMock)
+			stepFunctionsStateMachineOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := stepFunctionsStateMachineOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var _ IOperator = (*SNSTopicOperator)(nil)
+
+// SNSTopicOperator deletes SNS topics after removing the subscriptions added from outside the stack.
+type SNSTopicOperator struct {
+	client    client.ISNS
+	resources []*types.StackResourceSummary
+}
+
+func NewSNSTopicOperator(snsClient client.ISNS) *SNSTopicOperator {
+	return &SNSTopicOperator{
+		client:    snsClient,
+		resources: []*types.StackResourceSummary{},
+	}
+}
+
+func (o *SNSTopicOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *SNSTopicOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *SNSTopicOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteTopic(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+func (o *SNSTopicOperator) DeleteTopic(ctx context.Context, topicArn *string) error {
+	exists, err := o.client.CheckTopicExists(ctx, topicArn)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	subscriptions, err := o.client.ListSubscriptionsByTopic(ctx, topicArn)
+	if err != nil {
+		return err
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, subscription := range subscriptions {
+		// Subscriptions pending confirmation have a placeholder instead of an ARN and
+		// cannot be unsubscribed, but they do not remain after the topic is deleted.
+		if !strings.HasPrefix(aws.ToString(subscription.SubscriptionArn), "arn:") {
+			continue
+		}
+		if err := sem.Acquire(egCtx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return o.client.Unsubscribe(egCtx, subscription.SubscriptionArn)
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return o.client.DeleteTopic(ctx, topicArn)
+}
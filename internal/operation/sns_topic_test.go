@@ -0,0 +1,183 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestSNSTopicOperator_DeleteTopic(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockISNS)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete topic successfully",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListSubscriptionsByTopic(gomock.Any(), aws.String("test")).Return([]types.Subscription{}, nil)
+				m.EXPECT().DeleteTopic(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete topic successfully for topic not exists",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete topic successfully after unsubscribing foreign subscriptions",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListSubscriptionsByTopic(gomock.Any(), aws.String("test")).Return([]types.Subscription{
+					{
+						SubscriptionArn: aws.String("arn:aws:sns:us-east-1:123456789012:test:subscription1"),
+					},
+					{
+						SubscriptionArn: aws.String("arn:aws:sns:us-east-1:123456789012:test:subscription2"),
+					},
+					{
+						SubscriptionArn: aws.String("PendingConfirmation"),
+					},
+				}, nil)
+				m.EXPECT().Unsubscribe(gomock.Any(), aws.String("arn:aws:sns:us-east-1:123456789012:test:subscription1")).Return(nil)
+				m.EXPECT().Unsubscribe(gomock.Any(), aws.String("arn:aws:sns:us-east-1:123456789012:test:subscription2")).Return(nil)
+				m.EXPECT().DeleteTopic(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete topic failure for check topic exists errors",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("GetTopicAttributesError"))
+			},
+			want:    fmt.Errorf("GetTopicAttributesError"),
+			wantErr: true,
+		},
+		{
+			name: "delete topic failure for list subscriptions errors",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListSubscriptionsByTopic(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("ListSubscriptionsByTopicError"))
+			},
+			want:    fmt.Errorf("ListSubscriptionsByTopicError"),
+			wantErr: true,
+		},
+		{
+			name: "delete topic failure for unsubscribe errors",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListSubscriptionsByTopic(gomock.Any(), aws.String("test")).Return([]types.Subscription{
+					{
+						SubscriptionArn: aws.String("arn:aws:sns:us-east-1:123456789012:test:subscription1"),
+					},
+				}, nil)
+				m.EXPECT().Unsubscribe(gomock.Any(), aws.String("arn:aws:sns:us-east-1:123456789012:test:subscription1")).Return(fmt.Errorf("UnsubscribeError"))
+			},
+			want:    fmt.Errorf("UnsubscribeError"),
+			wantErr: true,
+		},
+		{
+			name: "delete topic failure for delete topic errors",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().ListSubscriptionsByTopic(gomock.Any(), aws.String("test")).Return([]types.Subscription{}, nil)
+				m.EXPECT().DeleteTopic(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteTopicError"))
+			},
+			want:    fmt.Errorf("DeleteTopicError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			snsMock := client.NewMockISNS(ctrl)
+			tt.prepareMockFn(snsMock)
+
+			sNSTopicOperator := NewSNSTopicOperator(snsMock)
+
+			err := sNSTopicOperator.DeleteTopic(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestSNSTopicOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockISNS)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockISNS) {
+				m.EXPECT().CheckTopicExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("GetTopicAttributesError"))
+			},
+			want:    fmt.Errorf("GetTopicAttributesError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			snsMock := client.NewMockISNS(ctrl)
+			tt.prepareMockFn(snsMock)
+
+			sNSTopicOperator := NewSNSTopicOperator(snsMock)
+			sNSTopicOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::SNS::Topic"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := sNSTopicOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
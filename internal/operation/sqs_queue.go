@@ -0,0 +1,112 @@
+package operation
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/pkg/client"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+// lambdaEventSourceMappingStateDeleting is the state of an event source mapping that is being deleted.
+const lambdaEventSourceMappingStateDeleting = "Deleting"
+
+var _ IOperator = (*SQSQueueOperator)(nil)
+
+// SQSQueueOperator deletes SQS queues after removing the Lambda event source mappings that
+// reference them from outside the stack.
+type SQSQueueOperator struct {
+	client       client.ISQS
+	lambdaClient client.ILambda
+	resources    []*types.StackResourceSummary
+}
+
+func NewSQSQueueOperator(sqsClient client.ISQS, lambdaClient client.ILambda) *SQSQueueOperator {
+	return &SQSQueueOperator{
+		client:       sqsClient,
+		lambdaClient: lambdaClient,
+		resources:    []*types.StackResourceSummary{},
+	}
+}
+
+func (o *SQSQueueOperator) AddResource(resource *types.StackResourceSummary) {
+	o.resources = append(o.resources, resource)
+}
+
+func (o *SQSQueueOperator) GetResourcesLength() int {
+	return len(o.resources)
+}
+
+func (o *SQSQueueOperator) DeleteResources(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, resource := range o.resources {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() (err error) {
+			defer sem.Release(1)
+
+			return o.DeleteQueue(ctx, resource.PhysicalResourceId)
+		})
+	}
+
+	err := eg.Wait()
+	return err
+}
+
+// DeleteQueue deletes the queue identified by its URL, which is the physical ID of AWS::SQS::Queue.
+func (o *SQSQueueOperator) DeleteQueue(ctx context.Context, queueUrl *string) error {
+	exists, err := o.client.CheckQueueExists(ctx, queueUrl)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	queueArn, err := o.client.GetQueueArn(ctx, queueUrl)
+	if err != nil {
+		return err
+	}
+
+	if queueArn != nil {
+		if err := deleteLambdaEventSourceMappings(ctx, o.lambdaClient, nil, queueArn); err != nil {
+			return err
+		}
+	}
+
+	return o.client.DeleteQueue(ctx, queueUrl)
+}
+
+// deleteLambdaEventSourceMappings deletes the event source mappings filtered by the function name
+// and/or the event source ARN in parallel, skipping the ones already being deleted.
+func deleteLambdaEventSourceMappings(ctx context.Context, lambdaClient client.ILambda, functionName *string, eventSourceArn *string) error {
+	mappings, err := lambdaClient.ListEventSourceMappings(ctx, functionName, eventSourceArn)
+	if err != nil {
+		return err
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := semaphore.NewWeighted(int64(runtime.NumCPU()))
+
+	for _, mapping := range mappings {
+		if aws.ToString(mapping.State) == lambdaEventSourceMappingStateDeleting {
+			continue
+		}
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return err
+		}
+		eg.Go(func() error {
+			defer sem.Release(1)
+
+			return lambdaClient.DeleteEventSourceMapping(ctx, mapping.UUID)
+		})
+	}
+
+	return eg.Wait()
+}
@@ -0,0 +1,198 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfnTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+	Test Cases
+*/
+
+func TestSQSQueueOperator_DeleteQueue(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockISQS, lambdaMock *client.MockILambda)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete queue successfully",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().GetQueueArn(gomock.Any(), aws.String("test")).Return(aws.String("arn:aws:sqs:us-east-1:123456789012:test"), nil)
+				lambdaMock.EXPECT().ListEventSourceMappings(gomock.Any(), nil, aws.String("arn:aws:sqs:us-east-1:123456789012:test")).Return([]lambdatypes.EventSourceMappingConfiguration{}, nil)
+				m.EXPECT().DeleteQueue(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete queue successfully for queue not exists",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete queue successfully after deleting event source mappings",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().GetQueueArn(gomock.Any(), aws.String("test")).Return(aws.String("arn:aws:sqs:us-east-1:123456789012:test"), nil)
+				lambdaMock.EXPECT().ListEventSourceMappings(gomock.Any(), nil, aws.String("arn:aws:sqs:us-east-1:123456789012:test")).Return([]lambdatypes.EventSourceMappingConfiguration{
+					{
+						UUID:  aws.String("uuid1"),
+						State: aws.String("Enabled"),
+					},
+					{
+						UUID:  aws.String("uuid2"),
+						State: aws.String("Deleting"),
+					},
+				}, nil)
+				lambdaMock.EXPECT().DeleteEventSourceMapping(gomock.Any(), aws.String("uuid1")).Return(nil)
+				m.EXPECT().DeleteQueue(gomock.Any(), aws.String("test")).Return(nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete queue failure for check queue exists errors",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("GetQueueAttributesError"))
+			},
+			want:    fmt.Errorf("GetQueueAttributesError"),
+			wantErr: true,
+		},
+		{
+			name: "delete queue failure for get queue arn errors",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().GetQueueArn(gomock.Any(), aws.String("test")).Return(nil, fmt.Errorf("GetQueueAttributesError"))
+			},
+			want:    fmt.Errorf("GetQueueAttributesError"),
+			wantErr: true,
+		},
+		{
+			name: "delete queue failure for list event source mappings errors",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().GetQueueArn(gomock.Any(), aws.String("test")).Return(aws.String("arn:aws:sqs:us-east-1:123456789012:test"), nil)
+				lambdaMock.EXPECT().ListEventSourceMappings(gomock.Any(), nil, aws.String("arn:aws:sqs:us-east-1:123456789012:test")).Return(nil, fmt.Errorf("ListEventSourceMappingsError"))
+			},
+			want:    fmt.Errorf("ListEventSourceMappingsError"),
+			wantErr: true,
+		},
+		{
+			name: "delete queue failure for delete event source mapping errors",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().GetQueueArn(gomock.Any(), aws.String("test")).Return(aws.String("arn:aws:sqs:us-east-1:123456789012:test"), nil)
+				lambdaMock.EXPECT().ListEventSourceMappings(gomock.Any(), nil, aws.String("arn:aws:sqs:us-east-1:123456789012:test")).Return([]lambdatypes.EventSourceMappingConfiguration{
+					{
+						UUID:  aws.String("uuid1"),
+						State: aws.String("Enabled"),
+					},
+				}, nil)
+				lambdaMock.EXPECT().DeleteEventSourceMapping(gomock.Any(), aws.String("uuid1")).Return(fmt.Errorf("DeleteEventSourceMappingError"))
+			},
+			want:    fmt.Errorf("DeleteEventSourceMappingError"),
+			wantErr: true,
+		},
+		{
+			name: "delete queue failure for delete queue errors",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(true, nil)
+				m.EXPECT().GetQueueArn(gomock.Any(), aws.String("test")).Return(aws.String("arn:aws:sqs:us-east-1:123456789012:test"), nil)
+				lambdaMock.EXPECT().ListEventSourceMappings(gomock.Any(), nil, aws.String("arn:aws:sqs:us-east-1:123456789012:test")).Return([]lambdatypes.EventSourceMappingConfiguration{}, nil)
+				m.EXPECT().DeleteQueue(gomock.Any(), aws.String("test")).Return(fmt.Errorf("DeleteQueueError"))
+			},
+			want:    fmt.Errorf("DeleteQueueError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sqsMock := client.NewMockISQS(ctrl)
+			lambdaMock := client.NewMockILambda(ctrl)
+			tt.prepareMockFn(sqsMock, lambdaMock)
+
+			sQSQueueOperator := NewSQSQueueOperator(sqsMock, lambdaMock)
+
+			err := sQSQueueOperator.DeleteQueue(context.Background(), aws.String("test"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestSQSQueueOperator_DeleteResources(t *testing.T) {
+	io.NewLogger(false)
+
+	cases := []struct {
+		name          string
+		prepareMockFn func(m *client.MockISQS, lambdaMock *client.MockILambda)
+		want          error
+		wantErr       bool
+	}{
+		{
+			name: "delete resources successfully",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete resources failure",
+			prepareMockFn: func(m *client.MockISQS, lambdaMock *client.MockILambda) {
+				m.EXPECT().CheckQueueExists(gomock.Any(), aws.String("test")).Return(false, fmt.Errorf("GetQueueAttributesError"))
+			},
+			want:    fmt.Errorf("GetQueueAttributesError"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sqsMock := client.NewMockISQS(ctrl)
+			lambdaMock := client.NewMockILambda(ctrl)
+			tt.prepareMockFn(sqsMock, lambdaMock)
+
+			sQSQueueOperator := NewSQSQueueOperator(sqsMock, lambdaMock)
+			sQSQueueOperator.AddResource(&cfnTypes.StackResourceSummary{
+				LogicalResourceId:  aws.String("LogicalResourceId1"),
+				ResourceStatus:     "DELETE_FAILED",
+				ResourceType:       aws.String("AWS::SQS::Queue"),
+				PhysicalResourceId: aws.String("test"),
+			})
+
+			err := sQSQueueOperator.DeleteResources(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// delstack-plugin-fake is a plugin for tests. It deletes nothing, and its behavior is controlled by
+// environment variables:
+//
+//   - FAKE_PLUGIN_RESOURCE_TYPES: comma-separated resource type patterns to declare
+//     (default: `Custom::Fake*,MongoDB::Atlas::Cluster`)
+//   - FAKE_PLUGIN_RECORD: file to write the received delete request to
+//   - FAKE_PLUGIN_EXIT_CODE: exit code to fail with
+//   - FAKE_PLUGIN_INVALID_RESPONSE: return a response that is not JSON if set
+//   - FAKE_PLUGIN_WANT_ACCESS_KEY_ID: fail the delete command unless AWS_ACCESS_KEY_ID has this value
+//
+// The resources whose logical ID contains `Fail` are reported as failures.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type request struct {
+	ProtocolVersion int    `json:"protocolVersion"`
+	Command         string `json:"command"`
+	Resources       []struct {
+		LogicalResourceId string `json:"logicalResourceId"`
+	} `json:"resources"`
+}
+
+type failure struct {
+	LogicalResourceId string `json:"logicalResourceId"`
+	Message           string `json:"message"`
+}
+
+func main() {
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		fail(err)
+	}
+	req := request{}
+	if err := json.Unmarshal(input, &req); err != nil {
+		fail(err)
+	}
+
+	if code := os.Getenv("FAKE_PLUGIN_EXIT_CODE"); code != "" {
+		exitCode, _ := strconv.Atoi(code)
+		fmt.Fprintln(os.Stderr, "fake plugin failed")
+		os.Exit(exitCode)
+	}
+	if os.Getenv("FAKE_PLUGIN_INVALID_RESPONSE") != "" {
+		fmt.Print("not json")
+		return
+	}
+
+	switch req.Command {
+	case "describe":
+		resourceTypes := "Custom::Fake*,MongoDB::Atlas::Cluster"
+		if v := os.Getenv("FAKE_PLUGIN_RESOURCE_TYPES"); v != "" {
+			resourceTypes = v
+		}
+		respond(map[string]any{
+			"resourceTypes": strings.Split(resourceTypes, ","),
+			"description":   "Fake plugin for tests.",
+		})
+	case "delete":
+		if want := os.Getenv("FAKE_PLUGIN_WANT_ACCESS_KEY_ID"); want != "" && os.Getenv("AWS_ACCESS_KEY_ID") != want {
+			fail(fmt.Errorf("unexpected AWS_ACCESS_KEY_ID %q", os.Getenv("AWS_ACCESS_KEY_ID")))
+		}
+		if record := os.Getenv("FAKE_PLUGIN_RECORD"); record != "" {
+			if err := os.WriteFile(record, input, 0o600); err != nil {
+				fail(err)
+			}
+		}
+		failures := []failure{}
+		for _, resource := range req.Resources {
+			if strings.Contains(resource.LogicalResourceId, "Fail") {
+				failures = append(failures, failure{
+					LogicalResourceId: resource.LogicalResourceId,
+					Message:           "fake failure",
+				})
+			}
+		}
+		respond(map[string]any{"failures": failures})
+	default:
+		fail(fmt.Errorf("unknown command %q", req.Command))
+	}
+}
+
+func respond(response any) {
+	if err := json.NewEncoder(os.Stdout).Encode(response); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,61 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+// waitUntil calls isDone every interval until it reports done. When it is not done after
+// maxRetryCount retries, the timeout message is returned as the error of the resource.
+func waitUntil(
+	ctx context.Context,
+	resourceName *string,
+	interval time.Duration,
+	maxRetryCount int,
+	isDone func() (bool, error),
+	timeoutMessage string,
+) error {
+	return waitUntilOrTimeout(ctx, resourceName, interval, maxRetryCount, isDone, func() error {
+		return &client.ClientError{
+			ResourceName: resourceName,
+			Err:          fmt.Errorf("%s", timeoutMessage),
+		}
+	})
+}
+
+// waitUntilOrTimeout calls isDone every interval until it reports done. When it is not done after
+// maxRetryCount retries, the error built by timeoutErr is returned, so that callers can report the
+// state seen by the last call of isDone.
+func waitUntilOrTimeout(
+	ctx context.Context,
+	resourceName *string,
+	interval time.Duration,
+	maxRetryCount int,
+	isDone func() (bool, error),
+	timeoutErr func() error,
+) error {
+	for retryCount := 0; ; retryCount++ {
+		done, err := isDone()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if retryCount >= maxRetryCount {
+			return timeoutErr()
+		}
+
+		select {
+		case <-ctx.Done():
+			return &client.ClientError{
+				ResourceName: resourceName,
+				Err:          ctx.Err(),
+			}
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func Test_waitUntilOrTimeout(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		name          string
+		ctx           context.Context
+		interval      time.Duration
+		doneAt        int
+		isDoneErr     error
+		maxRetryCount int
+		wantCalls     int
+		want          error
+		wantErr       bool
+	}{
+		{
+			name:          "done at the first call",
+			ctx:           context.Background(),
+			doneAt:        1,
+			maxRetryCount: 3,
+			wantCalls:     1,
+			want:          nil,
+			wantErr:       false,
+		},
+		{
+			name:          "done after retries",
+			ctx:           context.Background(),
+			doneAt:        3,
+			maxRetryCount: 3,
+			wantCalls:     3,
+			want:          nil,
+			wantErr:       false,
+		},
+		{
+			name:          "timeout after the max retry count",
+			ctx:           context.Background(),
+			doneAt:        10,
+			maxRetryCount: 3,
+			wantCalls:     4,
+			want:          fmt.Errorf("TimeoutError: 4 call(s)"),
+			wantErr:       true,
+		},
+		{
+			name:          "error from isDone",
+			ctx:           context.Background(),
+			doneAt:        10,
+			isDoneErr:     fmt.Errorf("DescribeError"),
+			maxRetryCount: 3,
+			wantCalls:     1,
+			want:          fmt.Errorf("DescribeError"),
+			wantErr:       true,
+		},
+		{
+			name:          "canceled context",
+			ctx:           canceledCtx,
+			interval:      time.Hour,
+			doneAt:        10,
+			maxRetryCount: 3,
+			wantCalls:     1,
+			want:          fmt.Errorf("[resource test] context canceled"),
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := waitUntilOrTimeout(tt.ctx, aws.String("test"), tt.interval, tt.maxRetryCount, func() (bool, error) {
+				calls++
+				if tt.isDoneErr != nil {
+					return false, tt.isDoneErr
+				}
+				return calls >= tt.doneAt, nil
+			}, func() error {
+				return fmt.Errorf("TimeoutError: %d call(s)", calls)
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_waitUntil(t *testing.T) {
+	cases := []struct {
+		name    string
+		doneAt  int
+		want    error
+		wantErr bool
+	}{
+		{
+			name:    "done after retries",
+			doneAt:  2,
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "timeout with the message as the error of the resource",
+			doneAt:  10,
+			want:    fmt.Errorf("[resource test] TimeoutError: the resource is still being processed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := waitUntil(context.Background(), aws.String("test"), 0, 3, func() (bool, error) {
+				calls++
+				return calls >= tt.doneAt, nil
+			}, "TimeoutError: the resource is still being processed")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.Error())
+			}
+		})
+	}
+}
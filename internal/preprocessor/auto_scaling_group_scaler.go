@@ -0,0 +1,101 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+var _ IPreprocessor = (*AutoScalingGroupScaler)(nil)
+
+// suspendedScalingProcesses are the processes suspended before scaling in, so that no instance is
+// launched again by scaling policies, scheduled actions or health checks. Terminate is left running
+// for the scale-in itself.
+var suspendedScalingProcesses = []string{
+	"Launch",
+	"HealthCheck",
+	"ReplaceUnhealthy",
+	"AZRebalance",
+	"AlarmNotification",
+	"ScheduledActions",
+	"InstanceRefresh",
+}
+
+// AutoScalingGroupScaler scales the Auto Scaling groups in the stack to 0 before the stack deletion
+// starts. CloudFormation only deletes a group after the resources depending on it, and then waits for
+// all the instances to be drained and terminated. Scaling in up front lets the instances terminate
+// while other resources are being deleted.
+//
+// It does not wait for the instances to terminate, so the preprocessing itself stays fast. The groups
+// retained by their DeletionPolicy are left as they are, since they keep serving after the deletion.
+type AutoScalingGroupScaler struct {
+	autoScalingClient client.IAutoScaling
+	cfnClient         client.ICloudFormation
+}
+
+func NewAutoScalingGroupScaler(autoScalingClient client.IAutoScaling, cfnClient client.ICloudFormation) *AutoScalingGroupScaler {
+	return &AutoScalingGroupScaler{
+		autoScalingClient: autoScalingClient,
+		cfnClient:         cfnClient,
+	}
+}
+
+func (s *AutoScalingGroupScaler) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	groups := FilterResourcesByType(resources, resourcetype.AutoScalingGroup)
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	groups, err := ExcludeRetainedResources(ctx, s.cfnClient, stackName, groups)
+	if err != nil {
+		io.Logger.Warn().Msgf("[%v]: Failed to scale in Auto Scaling groups: %v", aws.ToString(stackName), err)
+		return nil
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d Auto Scaling group(s), scaling in", aws.ToString(stackName), len(groups))
+
+	var wg sync.WaitGroup
+	for _, resource := range groups {
+		groupName := resource.PhysicalResourceId
+		if aws.ToString(groupName) == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(name *string) {
+			defer wg.Done()
+			if err := s.scaleToZero(ctx, stackName, name); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to scale in Auto Scaling group %s: %v",
+					aws.ToString(stackName), aws.ToString(name), err)
+			}
+		}(groupName)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (s *AutoScalingGroupScaler) scaleToZero(ctx context.Context, stackName *string, groupName *string) error {
+	if err := s.autoScalingClient.SuspendProcesses(ctx, groupName, suspendedScalingProcesses); err != nil {
+		return fmt.Errorf("failed to suspend scaling processes: %w", err)
+	}
+
+	if err := s.autoScalingClient.ScaleToZero(ctx, groupName); err != nil {
+		return fmt.Errorf("failed to set the desired capacity to 0: %w", err)
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Auto Scaling group %s scaled to 0, instance termination started",
+		aws.ToString(stackName), aws.ToString(groupName))
+
+	return nil
+}
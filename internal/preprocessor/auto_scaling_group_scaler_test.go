@@ -0,0 +1,197 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAutoScalingGroupScaler_Preprocess(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	io.Logger = &logger
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type args struct {
+		ctx       context.Context
+		stackName *string
+		resources []types.StackResourceSummary
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		setup   func(*client.MockIAutoScaling, *client.MockICloudFormation)
+		wantErr bool
+	}{
+		{
+			name: "no auto scaling groups",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::S3::Bucket"),
+						PhysicalResourceId: aws.String("test-bucket"),
+					},
+				},
+			},
+			setup:   func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "scale auto scaling groups to zero",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-1"),
+					},
+					{
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-2"),
+					},
+				},
+			},
+			setup: func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().SuspendProcesses(gomock.Any(), aws.String("test-asg-1"), suspendedScalingProcesses).Return(nil)
+				m.EXPECT().ScaleToZero(gomock.Any(), aws.String("test-asg-1")).Return(nil)
+				m.EXPECT().SuspendProcesses(gomock.Any(), aws.String("test-asg-2"), suspendedScalingProcesses).Return(nil)
+				m.EXPECT().ScaleToZero(gomock.Any(), aws.String("test-asg-2")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip auto scaling groups already deleted or not created",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-1"),
+						ResourceStatus:     types.ResourceStatusDeleteComplete,
+					},
+					{
+						ResourceType: aws.String("AWS::AutoScaling::AutoScalingGroup"),
+					},
+				},
+			},
+			setup: func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip auto scaling groups retained by DeletionPolicy",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("RetainedGroup"),
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-1"),
+					},
+					{
+						LogicalResourceId:  aws.String("Group"),
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-2"),
+					},
+				},
+			},
+			setup: func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String(`Resources:
+  RetainedGroup:
+    Type: AWS::AutoScaling::AutoScalingGroup
+    DeletionPolicy: Retain
+  Group:
+    Type: AWS::AutoScaling::AutoScalingGroup
+`), nil)
+				m.EXPECT().SuspendProcesses(gomock.Any(), aws.String("test-asg-2"), suspendedScalingProcesses).Return(nil)
+				m.EXPECT().ScaleToZero(gomock.Any(), aws.String("test-asg-2")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "get template error does not scale in nor fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-1"),
+					},
+				},
+			},
+			setup: func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(nil, fmt.Errorf("GetTemplateError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "suspend processes error does not scale in nor fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-1"),
+					},
+				},
+			},
+			setup: func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().SuspendProcesses(gomock.Any(), aws.String("test-asg-1"), suspendedScalingProcesses).Return(fmt.Errorf("SuspendProcessesError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "scale to zero error does not fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						PhysicalResourceId: aws.String("test-asg-1"),
+					},
+				},
+			},
+			setup: func(m *client.MockIAutoScaling, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().SuspendProcesses(gomock.Any(), aws.String("test-asg-1"), suspendedScalingProcesses).Return(nil)
+				m.EXPECT().ScaleToZero(gomock.Any(), aws.String("test-asg-1")).Return(fmt.Errorf("UpdateAutoScalingGroupError"))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAutoScaling := client.NewMockIAutoScaling(ctrl)
+			mockCfn := client.NewMockICloudFormation(ctrl)
+			tt.setup(mockAutoScaling, mockCfn)
+
+			scaler := NewAutoScalingGroupScaler(mockAutoScaling, mockCfn)
+			err := scaler.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Preprocess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+var _ IPreprocessor = (*CloudFrontDistributionDisabler)(nil)
+
+// CloudFrontDistributionDisabler disables the enabled CloudFront distributions in the stack
+// before the stack deletion starts. CloudFormation has to disable a distribution and wait for
+// the change to propagate to all edge locations before deleting it, which takes a long time.
+// Starting the propagation up front lets it run while other resources are being deleted, and
+// also lets the Lambda@Edge replicas be cleaned up earlier.
+//
+// It does not wait for the propagation to finish, so the preprocessing itself stays fast. The
+// distributions retained by their DeletionPolicy are left enabled, since they keep serving after the
+// deletion.
+type CloudFrontDistributionDisabler struct {
+	cloudFrontClient client.ICloudFront
+	cfnClient        client.ICloudFormation
+}
+
+func NewCloudFrontDistributionDisabler(cloudFrontClient client.ICloudFront, cfnClient client.ICloudFormation) *CloudFrontDistributionDisabler {
+	return &CloudFrontDistributionDisabler{
+		cloudFrontClient: cloudFrontClient,
+		cfnClient:        cfnClient,
+	}
+}
+
+func (d *CloudFrontDistributionDisabler) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	distributions := FilterResourcesByType(resources, resourcetype.CloudFrontDistribution)
+
+	if len(distributions) == 0 {
+		return nil
+	}
+
+	distributions, err := ExcludeRetainedResources(ctx, d.cfnClient, stackName, distributions)
+	if err != nil {
+		io.Logger.Warn().Msgf("[%v]: Failed to disable CloudFront distributions: %v", aws.ToString(stackName), err)
+		return nil
+	}
+	if len(distributions) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d CloudFront distribution(s), checking enabled state", aws.ToString(stackName), len(distributions))
+
+	var wg sync.WaitGroup
+	for _, resource := range distributions {
+		distributionId := resource.PhysicalResourceId
+		wg.Add(1)
+		go func(id *string) {
+			defer wg.Done()
+			if err := d.disableDistribution(ctx, stackName, id); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to disable CloudFront distribution %s: %v",
+					aws.ToString(stackName), aws.ToString(id), err)
+			}
+		}(distributionId)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (d *CloudFrontDistributionDisabler) disableDistribution(ctx context.Context, stackName *string, distributionId *string) error {
+	distributionConfig, eTag, err := d.cloudFrontClient.GetDistributionConfig(ctx, distributionId)
+	if err != nil {
+		return fmt.Errorf("failed to get distribution config: %w", err)
+	}
+
+	if !aws.ToBool(distributionConfig.Enabled) {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Disabling CloudFront distribution %s",
+		aws.ToString(stackName), aws.ToString(distributionId))
+
+	distributionConfig.Enabled = aws.Bool(false)
+	if err := d.cloudFrontClient.UpdateDistributionConfig(ctx, distributionId, distributionConfig, eTag); err != nil {
+		return fmt.Errorf("failed to disable distribution: %w", err)
+	}
+
+	io.Logger.Debug().Msgf("[%v]: CloudFront distribution %s disabled, propagation started",
+		aws.ToString(stackName), aws.ToString(distributionId))
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	cloudfronttypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCloudFrontDistributionDisabler_Preprocess(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	io.Logger = &logger
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type args struct {
+		ctx       context.Context
+		stackName *string
+		resources []types.StackResourceSummary
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		setup   func(*client.MockICloudFront, *client.MockICloudFormation)
+		wantErr bool
+	}{
+		{
+			name: "no distributions",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::S3::Bucket"),
+						PhysicalResourceId: aws.String("test-bucket"),
+					},
+				},
+			},
+			setup:   func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "disable enabled distribution",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+					},
+				},
+			},
+			setup: func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().GetDistributionConfig(gomock.Any(), aws.String("E1234567890")).Return(
+					&cloudfronttypes.DistributionConfig{
+						Enabled: aws.Bool(true),
+					},
+					aws.String("ETag1"),
+					nil,
+				)
+				m.EXPECT().UpdateDistributionConfig(
+					gomock.Any(),
+					aws.String("E1234567890"),
+					&cloudfronttypes.DistributionConfig{
+						Enabled: aws.Bool(false),
+					},
+					aws.String("ETag1"),
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip already disabled distribution",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+					},
+				},
+			},
+			setup: func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().GetDistributionConfig(gomock.Any(), aws.String("E1234567890")).Return(
+					&cloudfronttypes.DistributionConfig{
+						Enabled: aws.Bool(false),
+					},
+					aws.String("ETag1"),
+					nil,
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip distribution already deleted",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+						ResourceStatus:     types.ResourceStatusDeleteComplete,
+					},
+				},
+			},
+			setup:   func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "skip distributions retained by DeletionPolicy",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("RetainedDistribution"),
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+					},
+				},
+			},
+			setup: func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String(`Resources:
+  RetainedDistribution:
+    Type: AWS::CloudFront::Distribution
+    DeletionPolicy: Retain
+`), nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "get template error does not disable distributions nor fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+					},
+				},
+			},
+			setup: func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(nil, fmt.Errorf("GetTemplateError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "get distribution config error continues processing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+					},
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E0987654321"),
+					},
+				},
+			},
+			setup: func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().GetDistributionConfig(gomock.Any(), aws.String("E1234567890")).Return(
+					nil, nil, fmt.Errorf("GetDistributionConfigError"),
+				)
+				m.EXPECT().GetDistributionConfig(gomock.Any(), aws.String("E0987654321")).Return(
+					&cloudfronttypes.DistributionConfig{
+						Enabled: aws.Bool(true),
+					},
+					aws.String("ETag2"),
+					nil,
+				)
+				m.EXPECT().UpdateDistributionConfig(gomock.Any(), aws.String("E0987654321"), gomock.Any(), aws.String("ETag2")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "update distribution error does not fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::CloudFront::Distribution"),
+						PhysicalResourceId: aws.String("E1234567890"),
+					},
+				},
+			},
+			setup: func(m *client.MockICloudFront, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().GetDistributionConfig(gomock.Any(), aws.String("E1234567890")).Return(
+					&cloudfronttypes.DistributionConfig{
+						Enabled: aws.Bool(true),
+					},
+					aws.String("ETag1"),
+					nil,
+				)
+				m.EXPECT().UpdateDistributionConfig(gomock.Any(), aws.String("E1234567890"), gomock.Any(), aws.String("ETag1")).Return(fmt.Errorf("UpdateDistributionError"))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCloudFront := client.NewMockICloudFront(ctrl)
+			mockCfn := client.NewMockICloudFormation(ctrl)
+			tt.setup(mockCloudFront, mockCfn)
+
+			disabler := NewCloudFrontDistributionDisabler(mockCloudFront, mockCfn)
+			err := disabler.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Preprocess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -9,8 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/go-to-k/delstack/internal/io"
-	"github.com/go-to-k/delstack/internal/resourcetype"
-	"github.com/go-to-k/delstack/pkg/client"
 )
 
 var _ IPreprocessor = (*DeletionProtectionRemover)(nil)
@@ -21,30 +19,25 @@ type protectedResource struct {
 	physicalResourceId string
 }
 
+// ProtectionHandler checks and disables the deletion protection (or termination protection) of
+// resources of one resource type. Both functions take the physical resource ID of the resource.
+type ProtectionHandler struct {
+	Check   func(ctx context.Context, physicalId *string) (bool, error)
+	Disable func(ctx context.Context, physicalId *string) error
+}
+
+// DeletionProtectionRemover detects the resources with deletion protection in a stack, and disables
+// the protection in force mode. The supported resource types are the keys of the handler registry,
+// so a new protected type only needs a new entry there.
 type DeletionProtectionRemover struct {
-	forceMode     bool
-	ec2Client     client.IEC2
-	rdsClient     client.IRDS
-	cognitoClient client.ICognito
-	logsClient    client.ICloudWatchLogs
-	elbv2Client   client.IELBV2
+	forceMode bool
+	handlers  map[string]ProtectionHandler
 }
 
-func NewDeletionProtectionRemover(
-	forceMode bool,
-	ec2Client client.IEC2,
-	rdsClient client.IRDS,
-	cognitoClient client.ICognito,
-	logsClient client.ICloudWatchLogs,
-	elbv2Client client.IELBV2,
-) *DeletionProtectionRemover {
+func NewDeletionProtectionRemover(forceMode bool, handlers map[string]ProtectionHandler) *DeletionProtectionRemover {
 	return &DeletionProtectionRemover{
-		forceMode:     forceMode,
-		ec2Client:     ec2Client,
-		rdsClient:     rdsClient,
-		cognitoClient: cognitoClient,
-		logsClient:    logsClient,
-		elbv2Client:   elbv2Client,
+		forceMode: forceMode,
+		handlers:  handlers,
 	}
 }
 
@@ -103,23 +96,11 @@ func (r *DeletionProtectionRemover) findProtectedResources(ctx context.Context,
 }
 
 func (r *DeletionProtectionRemover) checkProtection(ctx context.Context, resource types.StackResourceSummary) (bool, error) {
-	physicalId := resource.PhysicalResourceId
-	switch aws.ToString(resource.ResourceType) {
-	case resourcetype.Ec2Instance:
-		return r.ec2Client.CheckTerminationProtection(ctx, physicalId)
-	case resourcetype.RdsDBInstance:
-		return r.rdsClient.CheckDBInstanceDeletionProtection(ctx, physicalId)
-	case resourcetype.RdsDBCluster:
-		return r.rdsClient.CheckDBClusterDeletionProtection(ctx, physicalId)
-	case resourcetype.CognitoUserPool:
-		return r.cognitoClient.CheckUserPoolDeletionProtection(ctx, physicalId)
-	case resourcetype.LogsLogGroup:
-		return r.logsClient.CheckLogGroupDeletionProtection(ctx, physicalId)
-	case resourcetype.Elbv2LoadBalancer:
-		return r.elbv2Client.CheckLoadBalancerDeletionProtection(ctx, physicalId)
-	default:
+	handler, ok := r.handlers[aws.ToString(resource.ResourceType)]
+	if !ok {
 		return false, nil
 	}
+	return handler.Check(ctx, resource.PhysicalResourceId)
 }
 
 func (r *DeletionProtectionRemover) disableProtections(ctx context.Context, stackName *string, resources []protectedResource) error {
@@ -155,36 +136,16 @@ func (r *DeletionProtectionRemover) disableProtections(ctx context.Context, stac
 }
 
 func (r *DeletionProtectionRemover) disableProtection(ctx context.Context, pr protectedResource) error {
-	switch pr.resourceType {
-	case resourcetype.Ec2Instance:
-		return r.ec2Client.DisableTerminationProtection(ctx, aws.String(pr.physicalResourceId))
-	case resourcetype.RdsDBInstance:
-		return r.rdsClient.DisableDBInstanceDeletionProtection(ctx, aws.String(pr.physicalResourceId))
-	case resourcetype.RdsDBCluster:
-		return r.rdsClient.DisableDBClusterDeletionProtection(ctx, aws.String(pr.physicalResourceId))
-	case resourcetype.CognitoUserPool:
-		return r.cognitoClient.DisableUserPoolDeletionProtection(ctx, aws.String(pr.physicalResourceId))
-	case resourcetype.LogsLogGroup:
-		return r.logsClient.DisableLogGroupDeletionProtection(ctx, aws.String(pr.physicalResourceId))
-	case resourcetype.Elbv2LoadBalancer:
-		return r.elbv2Client.DisableLoadBalancerDeletionProtection(ctx, aws.String(pr.physicalResourceId))
-	default:
+	handler, ok := r.handlers[pr.resourceType]
+	if !ok {
 		return nil
 	}
+	return handler.Disable(ctx, aws.String(pr.physicalResourceId))
 }
 
 func (r *DeletionProtectionRemover) isTargetResourceType(resourceType string) bool {
-	switch resourceType {
-	case resourcetype.Ec2Instance,
-		resourcetype.RdsDBInstance,
-		resourcetype.RdsDBCluster,
-		resourcetype.CognitoUserPool,
-		resourcetype.LogsLogGroup,
-		resourcetype.Elbv2LoadBalancer:
-		return true
-	default:
-		return false
-	}
+	_, ok := r.handlers[resourceType]
+	return ok
 }
 
 func (r *DeletionProtectionRemover) buildProtectionError(resources []protectedResource) error {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
 	"github.com/go-to-k/delstack/pkg/client"
 	"github.com/rs/zerolog"
 	"go.uber.org/mock/gomock"
@@ -29,11 +30,36 @@ func TestDeletionProtectionRemover_Preprocess(t *testing.T) {
 	}
 
 	type mocks struct {
-		ec2     *client.MockIEC2
-		rds     *client.MockIRDS
-		cognito *client.MockICognito
-		logs    *client.MockICloudWatchLogs
-		elbv2   *client.MockIELBV2
+		ec2      *client.MockIEC2
+		rds      *client.MockIRDS
+		cognito  *client.MockICognito
+		logs     *client.MockICloudWatchLogs
+		elbv2    *client.MockIELBV2
+		dynamodb *client.MockIDynamoDB
+		neptune  *client.MockINeptune
+		docdb    *client.MockIDocDB
+		firewall *client.MockINetworkFirewall
+		qldb     *client.MockIQLDB
+		trail    *client.MockICloudTrail
+	}
+
+	// newHandlers builds the same registry as newDeletionProtectionRemoverFromConfig with the mocks.
+	newHandlers := func(m mocks) map[string]ProtectionHandler {
+		return map[string]ProtectionHandler{
+			resourcetype.Ec2Instance:              {Check: m.ec2.CheckTerminationProtection, Disable: m.ec2.DisableTerminationProtection},
+			resourcetype.RdsDBInstance:            {Check: m.rds.CheckDBInstanceDeletionProtection, Disable: m.rds.DisableDBInstanceDeletionProtection},
+			resourcetype.RdsDBCluster:             {Check: m.rds.CheckDBClusterDeletionProtection, Disable: m.rds.DisableDBClusterDeletionProtection},
+			resourcetype.CognitoUserPool:          {Check: m.cognito.CheckUserPoolDeletionProtection, Disable: m.cognito.DisableUserPoolDeletionProtection},
+			resourcetype.LogsLogGroup:             {Check: m.logs.CheckLogGroupDeletionProtection, Disable: m.logs.DisableLogGroupDeletionProtection},
+			resourcetype.Elbv2LoadBalancer:        {Check: m.elbv2.CheckLoadBalancerDeletionProtection, Disable: m.elbv2.DisableLoadBalancerDeletionProtection},
+			resourcetype.DynamoDBTable:            {Check: m.dynamodb.CheckTableDeletionProtection, Disable: m.dynamodb.DisableTableDeletionProtection},
+			resourcetype.DynamoDBGlobalTable:      {Check: m.dynamodb.CheckTableDeletionProtection, Disable: m.dynamodb.DisableTableDeletionProtection},
+			resourcetype.NeptuneDBCluster:         {Check: m.neptune.CheckDBClusterDeletionProtection, Disable: m.neptune.DisableDBClusterDeletionProtection},
+			resourcetype.DocDBDBCluster:           {Check: m.docdb.CheckDBClusterDeletionProtection, Disable: m.docdb.DisableDBClusterDeletionProtection},
+			resourcetype.NetworkFirewallFirewall:  {Check: m.firewall.CheckFirewallProtection, Disable: m.firewall.DisableFirewallProtection},
+			resourcetype.QldbLedger:               {Check: m.qldb.CheckLedgerDeletionProtection, Disable: m.qldb.DisableLedgerDeletionProtection},
+			resourcetype.CloudTrailEventDataStore: {Check: m.trail.CheckEventDataStoreTerminationProtection, Disable: m.trail.DisableEventDataStoreTerminationProtection},
+		}
 	}
 
 	cases := []struct {
@@ -404,6 +430,160 @@ func TestDeletionProtectionRemover_Preprocess(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:      "dynamodb table and global table deletion protection",
+			forceMode: true,
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						LogicalResourceId:  aws.String("MyTable"),
+						PhysicalResourceId: aws.String("table"),
+					},
+					{
+						ResourceType:       aws.String("AWS::DynamoDB::GlobalTable"),
+						LogicalResourceId:  aws.String("MyGlobalTable"),
+						PhysicalResourceId: aws.String("global-table"),
+					},
+				},
+			},
+			setup: func(m mocks) {
+				m.dynamodb.EXPECT().CheckTableDeletionProtection(gomock.Any(), aws.String("table")).Return(true, nil)
+				m.dynamodb.EXPECT().DisableTableDeletionProtection(gomock.Any(), aws.String("table")).Return(nil)
+				m.dynamodb.EXPECT().CheckTableDeletionProtection(gomock.Any(), aws.String("global-table")).Return(true, nil)
+				m.dynamodb.EXPECT().DisableTableDeletionProtection(gomock.Any(), aws.String("global-table")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "dynamodb table deletion protection without force mode",
+			forceMode: false,
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::DynamoDB::Table"),
+						LogicalResourceId:  aws.String("MyTable"),
+						PhysicalResourceId: aws.String("table"),
+					},
+				},
+			},
+			setup: func(m mocks) {
+				m.dynamodb.EXPECT().CheckTableDeletionProtection(gomock.Any(), aws.String("table")).Return(true, nil)
+			},
+			wantErr: true,
+			errMsg:  "- AWS::DynamoDB::Table: MyTable (physical: table)",
+		},
+		{
+			name:      "neptune and docdb db cluster deletion protection",
+			forceMode: true,
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Neptune::DBCluster"),
+						LogicalResourceId:  aws.String("MyNeptuneCluster"),
+						PhysicalResourceId: aws.String("neptune-cluster"),
+					},
+					{
+						ResourceType:       aws.String("AWS::DocDB::DBCluster"),
+						LogicalResourceId:  aws.String("MyDocDBCluster"),
+						PhysicalResourceId: aws.String("docdb-cluster"),
+					},
+				},
+			},
+			setup: func(m mocks) {
+				m.neptune.EXPECT().CheckDBClusterDeletionProtection(gomock.Any(), aws.String("neptune-cluster")).Return(true, nil)
+				m.neptune.EXPECT().DisableDBClusterDeletionProtection(gomock.Any(), aws.String("neptune-cluster")).Return(nil)
+				m.docdb.EXPECT().CheckDBClusterDeletionProtection(gomock.Any(), aws.String("docdb-cluster")).Return(true, nil)
+				m.docdb.EXPECT().DisableDBClusterDeletionProtection(gomock.Any(), aws.String("docdb-cluster")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "neptune and docdb db cluster deletion protection without force mode",
+			forceMode: false,
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Neptune::DBCluster"),
+						LogicalResourceId:  aws.String("MyNeptuneCluster"),
+						PhysicalResourceId: aws.String("neptune-cluster"),
+					},
+					{
+						ResourceType:       aws.String("AWS::DocDB::DBCluster"),
+						LogicalResourceId:  aws.String("MyDocDBCluster"),
+						PhysicalResourceId: aws.String("docdb-cluster"),
+					},
+				},
+			},
+			setup: func(m mocks) {
+				m.neptune.EXPECT().CheckDBClusterDeletionProtection(gomock.Any(), aws.String("neptune-cluster")).Return(false, nil)
+				m.docdb.EXPECT().CheckDBClusterDeletionProtection(gomock.Any(), aws.String("docdb-cluster")).Return(true, nil)
+			},
+			wantErr: true,
+			errMsg:  "- AWS::DocDB::DBCluster: MyDocDBCluster (physical: docdb-cluster)",
+		},
+		{
+			name:      "network firewall, qldb ledger and cloudtrail event data store protection",
+			forceMode: true,
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::NetworkFirewall::Firewall"),
+						LogicalResourceId:  aws.String("MyFirewall"),
+						PhysicalResourceId: aws.String("firewall-arn"),
+					},
+					{
+						ResourceType:       aws.String("AWS::QLDB::Ledger"),
+						LogicalResourceId:  aws.String("MyLedger"),
+						PhysicalResourceId: aws.String("ledger"),
+					},
+					{
+						ResourceType:       aws.String("AWS::CloudTrail::EventDataStore"),
+						LogicalResourceId:  aws.String("MyEventDataStore"),
+						PhysicalResourceId: aws.String("event-data-store-arn"),
+					},
+				},
+			},
+			setup: func(m mocks) {
+				m.firewall.EXPECT().CheckFirewallProtection(gomock.Any(), aws.String("firewall-arn")).Return(true, nil)
+				m.firewall.EXPECT().DisableFirewallProtection(gomock.Any(), aws.String("firewall-arn")).Return(nil)
+				m.qldb.EXPECT().CheckLedgerDeletionProtection(gomock.Any(), aws.String("ledger")).Return(true, nil)
+				m.qldb.EXPECT().DisableLedgerDeletionProtection(gomock.Any(), aws.String("ledger")).Return(nil)
+				m.trail.EXPECT().CheckEventDataStoreTerminationProtection(gomock.Any(), aws.String("event-data-store-arn")).Return(true, nil)
+				m.trail.EXPECT().DisableEventDataStoreTerminationProtection(gomock.Any(), aws.String("event-data-store-arn")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "network firewall protection without force mode",
+			forceMode: false,
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::NetworkFirewall::Firewall"),
+						LogicalResourceId:  aws.String("MyFirewall"),
+						PhysicalResourceId: aws.String("firewall-arn"),
+					},
+				},
+			},
+			setup: func(m mocks) {
+				m.firewall.EXPECT().CheckFirewallProtection(gomock.Any(), aws.String("firewall-arn")).Return(true, nil)
+			},
+			wantErr: true,
+			errMsg:  "- AWS::NetworkFirewall::Firewall: MyFirewall (physical: firewall-arn)",
+		},
 	}
 
 	for _, tt := range cases {
@@ -413,16 +593,29 @@ func TestDeletionProtectionRemover_Preprocess(t *testing.T) {
 			mockCognito := client.NewMockICognito(ctrl)
 			mockLogs := client.NewMockICloudWatchLogs(ctrl)
 			mockELBV2 := client.NewMockIELBV2(ctrl)
+			mockDynamoDB := client.NewMockIDynamoDB(ctrl)
+			mockNeptune := client.NewMockINeptune(ctrl)
+			mockDocDB := client.NewMockIDocDB(ctrl)
+			mockNetworkFirewall := client.NewMockINetworkFirewall(ctrl)
+			mockQLDB := client.NewMockIQLDB(ctrl)
+			mockCloudTrail := client.NewMockICloudTrail(ctrl)
 
-			tt.setup(mocks{
-				ec2:     mockEC2,
-				rds:     mockRDS,
-				cognito: mockCognito,
-				logs:    mockLogs,
-				elbv2:   mockELBV2,
-			})
+			m := mocks{
+				ec2:      mockEC2,
+				rds:      mockRDS,
+				cognito:  mockCognito,
+				logs:     mockLogs,
+				elbv2:    mockELBV2,
+				dynamodb: mockDynamoDB,
+				neptune:  mockNeptune,
+				docdb:    mockDocDB,
+				firewall: mockNetworkFirewall,
+				qldb:     mockQLDB,
+				trail:    mockCloudTrail,
+			}
+			tt.setup(m)
 
-			remover := NewDeletionProtectionRemover(tt.forceMode, mockEC2, mockRDS, mockCognito, mockLogs, mockELBV2)
+			remover := NewDeletionProtectionRemover(tt.forceMode, newHandlers(m))
 			err := remover.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
 
 			if (err != nil) != tt.wantErr {
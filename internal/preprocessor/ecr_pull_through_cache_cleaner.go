@@ -0,0 +1,95 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+// ecrPullThroughCacheRootPrefix is the special prefix of a pull through cache rule that applies
+// to every repository in the registry. Repositories under it cannot be told apart from ones that
+// are not related to the rule, so they are never cleaned up.
+const ecrPullThroughCacheRootPrefix = "ROOT"
+
+var _ IPreprocessor = (*EcrPullThroughCacheCleaner)(nil)
+
+// EcrPullThroughCacheCleaner deletes the repositories that ECR creates implicitly under the
+// prefix of a pull through cache rule (`<prefix>/<upstream-repository>`) on the first pull.
+// These repositories are created outside CloudFormation, so they are left behind after the
+// rule itself is deleted with the stack.
+type EcrPullThroughCacheCleaner struct {
+	ecrClient client.IEcr
+}
+
+func NewEcrPullThroughCacheCleaner(ecrClient client.IEcr) *EcrPullThroughCacheCleaner {
+	return &EcrPullThroughCacheCleaner{
+		ecrClient: ecrClient,
+	}
+}
+
+func (c *EcrPullThroughCacheCleaner) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	rules := FilterResourcesByType(resources, resourcetype.EcrPullThroughCacheRule)
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d ECR pull through cache rule(s), checking cached repositories", aws.ToString(stackName), len(rules))
+
+	var wg sync.WaitGroup
+	for _, rule := range rules {
+		prefix := rule.PhysicalResourceId
+		wg.Add(1)
+		go func(p *string) {
+			defer wg.Done()
+			if err := c.cleanupCachedRepositories(ctx, stackName, p); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to clean up repositories of pull through cache rule %s: %v",
+					aws.ToString(stackName), aws.ToString(p), err)
+			}
+		}(prefix)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (c *EcrPullThroughCacheCleaner) cleanupCachedRepositories(ctx context.Context, stackName *string, prefix *string) error {
+	if aws.ToString(prefix) == "" || aws.ToString(prefix) == ecrPullThroughCacheRootPrefix {
+		io.Logger.Debug().Msgf("[%v]: Skipping pull through cache rule with prefix %q", aws.ToString(stackName), aws.ToString(prefix))
+		return nil
+	}
+
+	repositoryNames, err := c.ecrClient.ListRepositoryNamesByPrefix(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	if len(repositoryNames) == 0 {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, repositoryName := range repositoryNames {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			if err := c.ecrClient.DeleteRepository(ctx, aws.String(name)); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to delete pull through cache repository %s: %v",
+					aws.ToString(stackName), name, err)
+				return
+			}
+			io.Logger.Info().Msgf("[%v]: Deleted pull through cache repository %s", aws.ToString(stackName), name)
+		}(repositoryName)
+	}
+
+	wg.Wait()
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEcrPullThroughCacheCleaner_Preprocess(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	io.Logger = &logger
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type args struct {
+		ctx       context.Context
+		stackName *string
+		resources []types.StackResourceSummary
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		setup   func(*client.MockIEcr)
+		wantErr bool
+	}{
+		{
+			name: "no pull through cache rules",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::Repository"),
+						PhysicalResourceId: aws.String("test-repository"),
+					},
+				},
+			},
+			setup:   func(m *client.MockIEcr) {},
+			wantErr: false,
+		},
+		{
+			name: "delete cached repositories under the rule prefix",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::PullThroughCacheRule"),
+						PhysicalResourceId: aws.String("ecr-public"),
+					},
+				},
+			},
+			setup: func(m *client.MockIEcr) {
+				m.EXPECT().ListRepositoryNamesByPrefix(gomock.Any(), aws.String("ecr-public")).Return(
+					[]string{"ecr-public/nginx/nginx", "ecr-public/docker/library/redis"}, nil,
+				)
+				m.EXPECT().DeleteRepository(gomock.Any(), aws.String("ecr-public/nginx/nginx")).Return(nil)
+				m.EXPECT().DeleteRepository(gomock.Any(), aws.String("ecr-public/docker/library/redis")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "no cached repositories",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::PullThroughCacheRule"),
+						PhysicalResourceId: aws.String("ecr-public"),
+					},
+				},
+			},
+			setup: func(m *client.MockIEcr) {
+				m.EXPECT().ListRepositoryNamesByPrefix(gomock.Any(), aws.String("ecr-public")).Return([]string{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip rule with ROOT prefix",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::PullThroughCacheRule"),
+						PhysicalResourceId: aws.String("ROOT"),
+					},
+				},
+			},
+			setup:   func(m *client.MockIEcr) {},
+			wantErr: false,
+		},
+		{
+			name: "skip rule already deleted",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::PullThroughCacheRule"),
+						PhysicalResourceId: aws.String("ecr-public"),
+						ResourceStatus:     types.ResourceStatusDeleteComplete,
+					},
+				},
+			},
+			setup:   func(m *client.MockIEcr) {},
+			wantErr: false,
+		},
+		{
+			name: "list repositories error continues processing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::PullThroughCacheRule"),
+						PhysicalResourceId: aws.String("ecr-public"),
+					},
+				},
+			},
+			setup: func(m *client.MockIEcr) {
+				m.EXPECT().ListRepositoryNamesByPrefix(gomock.Any(), aws.String("ecr-public")).Return(nil, fmt.Errorf("DescribeRepositoriesError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete repository error continues processing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECR::PullThroughCacheRule"),
+						PhysicalResourceId: aws.String("ecr-public"),
+					},
+				},
+			},
+			setup: func(m *client.MockIEcr) {
+				m.EXPECT().ListRepositoryNamesByPrefix(gomock.Any(), aws.String("ecr-public")).Return(
+					[]string{"ecr-public/nginx/nginx", "ecr-public/docker/library/redis"}, nil,
+				)
+				m.EXPECT().DeleteRepository(gomock.Any(), aws.String("ecr-public/nginx/nginx")).Return(fmt.Errorf("DeleteRepositoryError"))
+				m.EXPECT().DeleteRepository(gomock.Any(), aws.String("ecr-public/docker/library/redis")).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEcr := client.NewMockIEcr(ctrl)
+			tt.setup(mockEcr)
+
+			cleaner := NewEcrPullThroughCacheCleaner(mockEcr)
+			err := cleaner.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Preprocess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
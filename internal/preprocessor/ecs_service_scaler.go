@@ -0,0 +1,97 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+var _ IPreprocessor = (*EcsServiceScaler)(nil)
+
+// EcsServiceScaler sets the desired count of the ECS services in the stack to 0 before the stack
+// deletion starts. CloudFormation drains the tasks of a service when it reaches the service, which
+// takes as long as the deregistration delay of the load balancer. Scaling in up front lets the tasks
+// stop while other resources are being deleted.
+//
+// It does not wait for the tasks to stop, so the preprocessing itself stays fast. The services
+// retained by their DeletionPolicy are left as they are, since they keep serving after the deletion.
+type EcsServiceScaler struct {
+	ecsClient client.IECS
+	cfnClient client.ICloudFormation
+}
+
+func NewEcsServiceScaler(ecsClient client.IECS, cfnClient client.ICloudFormation) *EcsServiceScaler {
+	return &EcsServiceScaler{
+		ecsClient: ecsClient,
+		cfnClient: cfnClient,
+	}
+}
+
+func (s *EcsServiceScaler) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	services := FilterResourcesByType(resources, resourcetype.EcsService)
+
+	if len(services) == 0 {
+		return nil
+	}
+
+	services, err := ExcludeRetainedResources(ctx, s.cfnClient, stackName, services)
+	if err != nil {
+		io.Logger.Warn().Msgf("[%v]: Failed to scale in ECS services: %v", aws.ToString(stackName), err)
+		return nil
+	}
+	if len(services) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d ECS service(s), scaling in", aws.ToString(stackName), len(services))
+
+	var wg sync.WaitGroup
+	for _, resource := range services {
+		serviceArn := resource.PhysicalResourceId
+		if aws.ToString(serviceArn) == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(arn *string) {
+			defer wg.Done()
+			if err := s.scaleToZero(ctx, stackName, arn); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to scale in ECS service %s: %v",
+					aws.ToString(stackName), aws.ToString(arn), err)
+			}
+		}(serviceArn)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (s *EcsServiceScaler) scaleToZero(ctx context.Context, stackName *string, serviceArn *string) error {
+	if err := s.ecsClient.ScaleServiceToZero(ctx, clusterNameFromServiceArn(serviceArn), serviceArn); err != nil {
+		return fmt.Errorf("failed to set the desired count to 0: %w", err)
+	}
+
+	io.Logger.Debug().Msgf("[%v]: ECS service %s scaled to 0, task shutdown started",
+		aws.ToString(stackName), aws.ToString(serviceArn))
+
+	return nil
+}
+
+// clusterNameFromServiceArn returns the cluster name in a service ARN of the new format
+// (arn:aws:ecs:<region>:<account>:service/<cluster>/<service>). A service ARN of the old format
+// (arn:aws:ecs:<region>:<account>:service/<service>) has no cluster name, and nil is returned for
+// the default cluster.
+func clusterNameFromServiceArn(serviceArn *string) *string {
+	parts := strings.Split(aws.ToString(serviceArn), "/")
+	if len(parts) != 3 {
+		return nil
+	}
+	return aws.String(parts[1])
+}
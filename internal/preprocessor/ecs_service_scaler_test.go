@@ -0,0 +1,176 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEcsServiceScaler_Preprocess(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	io.Logger = &logger
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	type args struct {
+		ctx       context.Context
+		stackName *string
+		resources []types.StackResourceSummary
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		setup   func(*client.MockIECS, *client.MockICloudFormation)
+		wantErr bool
+	}{
+		{
+			name: "no services",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::S3::Bucket"),
+						PhysicalResourceId: aws.String("test-bucket"),
+					},
+				},
+			},
+			setup:   func(m *client.MockIECS, cfnMock *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "scale services to zero",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+					},
+					{
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-legacy-service"),
+					},
+				},
+			},
+			setup: func(m *client.MockIECS, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().ScaleServiceToZero(
+					gomock.Any(),
+					aws.String("test-cluster"),
+					aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+				).Return(nil)
+				m.EXPECT().ScaleServiceToZero(
+					gomock.Any(),
+					nil,
+					aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-legacy-service"),
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip services already deleted",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+						ResourceStatus:     types.ResourceStatusDeleteComplete,
+					},
+				},
+			},
+			setup:   func(m *client.MockIECS, cfnMock *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "skip services retained by DeletionPolicy",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("RetainedService"),
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-retained-service"),
+					},
+					{
+						LogicalResourceId:  aws.String("Service"),
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+					},
+				},
+			},
+			setup: func(m *client.MockIECS, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String(`{"Resources":{"RetainedService":{"Type":"AWS::ECS::Service","DeletionPolicy":"RetainExceptOnCreate"},"Service":{"Type":"AWS::ECS::Service"}}}`), nil)
+				m.EXPECT().ScaleServiceToZero(
+					gomock.Any(),
+					aws.String("test-cluster"),
+					aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+				).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "get template error does not scale in nor fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+					},
+				},
+			},
+			setup: func(m *client.MockIECS, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(nil, fmt.Errorf("GetTemplateError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "update service error does not fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ECS::Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-east-1:123456789012:service/test-cluster/test-service"),
+					},
+				},
+			},
+			setup: func(m *client.MockIECS, cfnMock *client.MockICloudFormation) {
+				cfnMock.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
+				m.EXPECT().ScaleServiceToZero(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("UpdateServiceError"))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockECS := client.NewMockIECS(ctrl)
+			mockCfn := client.NewMockICloudFormation(ctrl)
+			tt.setup(mockECS, mockCfn)
+
+			scaler := NewEcsServiceScaler(mockECS, mockCfn)
+			err := scaler.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Preprocess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,50 +2,51 @@ package preprocessor
 
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
-	"github.com/go-to-k/delstack/internal/operation"
+	"github.com/go-to-k/delstack/internal/resourcetype"
 	"github.com/go-to-k/delstack/pkg/client"
 )
 
-func NewRecursivePreprocessorFromConfig(config aws.Config, forceMode bool) *RecursivePreprocessor {
-	sdkCfnClient := cloudformation.NewFromConfig(config, func(o *cloudformation.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
-		o.RetryMode = aws.RetryModeStandard
-	})
-	sdkCfnDeleteWaiter := cloudformation.NewStackDeleteCompleteWaiter(sdkCfnClient)
-	sdkCfnUpdateWaiter := cloudformation.NewStackUpdateCompleteWaiter(sdkCfnClient)
-	cfnClient := client.NewCloudFormation(
-		sdkCfnClient,
-		sdkCfnDeleteWaiter,
-		sdkCfnUpdateWaiter,
-	)
+// NewRecursivePreprocessorFromConfig composes the deletion protection check with the given modifiers,
+// which are created from the resource handler registry of the operation package.
+func NewRecursivePreprocessorFromConfig(config aws.Config, forceMode bool, modifiers []IPreprocessor) *RecursivePreprocessor {
+	cfnClient := newCloudFormationFromConfig(config)
 
-	lambdaVPCDetacher := newLambdaVPCDetacherFromConfig(config)
 	protectionRemover := newDeletionProtectionRemoverFromConfig(config, forceMode)
 
 	composite := NewCompositePreprocessor(
 		[]IPreprocessor{protectionRemover},
-		[]IPreprocessor{lambdaVPCDetacher},
+		modifiers,
 	)
 
 	return NewRecursivePreprocessor(cfnClient, composite)
 }
 
-func newLambdaVPCDetacherFromConfig(config aws.Config) *LambdaVPCDetacher {
+func NewLambdaVPCDetacherFromConfig(config aws.Config) *LambdaVPCDetacher {
 	sdkLambdaClient := lambda.NewFromConfig(config, func(o *lambda.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 	sdkLambdaWaiter := lambda.NewFunctionUpdatedV2Waiter(sdkLambdaClient)
 
 	sdkEC2Client := ec2.NewFromConfig(config, func(o *ec2.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 
@@ -58,38 +59,218 @@ func newLambdaVPCDetacherFromConfig(config aws.Config) *LambdaVPCDetacher {
 	)
 }
 
+func NewCloudFrontDistributionDisablerFromConfig(config aws.Config) *CloudFrontDistributionDisabler {
+	sdkCloudFrontClient := cloudfront.NewFromConfig(config, func(o *cloudfront.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewCloudFrontDistributionDisabler(
+		client.NewCloudFront(sdkCloudFrontClient),
+		newCloudFormationFromConfig(config),
+	)
+}
+
+func NewAutoScalingGroupScalerFromConfig(config aws.Config) *AutoScalingGroupScaler {
+	sdkAutoScalingClient := autoscaling.NewFromConfig(config, func(o *autoscaling.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewAutoScalingGroupScaler(
+		client.NewAutoScaling(sdkAutoScalingClient),
+		newCloudFormationFromConfig(config),
+	)
+}
+
+func NewEcsServiceScalerFromConfig(config aws.Config) *EcsServiceScaler {
+	sdkEcsClient := ecs.NewFromConfig(config, func(o *ecs.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewEcsServiceScaler(
+		client.NewECS(sdkEcsClient),
+		newCloudFormationFromConfig(config),
+	)
+}
+
+func NewTargetGroupDetacherFromConfig(config aws.Config) *TargetGroupDetacher {
+	sdkELBV2Client := elasticloadbalancingv2.NewFromConfig(config, func(o *elasticloadbalancingv2.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewTargetGroupDetacher(
+		client.NewELBV2(sdkELBV2Client),
+	)
+}
+
+func NewEcrPullThroughCacheCleanerFromConfig(config aws.Config) *EcrPullThroughCacheCleaner {
+	sdkEcrClient := ecr.NewFromConfig(config, func(o *ecr.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	return NewEcrPullThroughCacheCleaner(
+		client.NewEcr(sdkEcrClient),
+	)
+}
+
+func NewLambdaLayerVersionCleanerFromConfig(config aws.Config) *LambdaLayerVersionCleaner {
+	sdkLambdaClient := lambda.NewFromConfig(config, func(o *lambda.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	sdkLambdaWaiter := lambda.NewFunctionUpdatedV2Waiter(sdkLambdaClient)
+
+	return NewLambdaLayerVersionCleaner(
+		client.NewLambdaClient(
+			sdkLambdaClient,
+			sdkLambdaWaiter,
+		),
+		newCloudFormationFromConfig(config),
+	)
+}
+
+func newCloudFormationFromConfig(config aws.Config) *client.CloudFormation {
+	sdkCfnClient := cloudformation.NewFromConfig(config, func(o *cloudformation.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+	sdkCfnDeleteWaiter := cloudformation.NewStackDeleteCompleteWaiter(sdkCfnClient)
+	sdkCfnUpdateWaiter := cloudformation.NewStackUpdateCompleteWaiter(sdkCfnClient)
+
+	return client.NewCloudFormation(
+		sdkCfnClient,
+		sdkCfnDeleteWaiter,
+		sdkCfnUpdateWaiter,
+	)
+}
+
 func newDeletionProtectionRemoverFromConfig(config aws.Config, forceMode bool) *DeletionProtectionRemover {
 	sdkEC2Client := ec2.NewFromConfig(config, func(o *ec2.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 
 	sdkRDSClient := rds.NewFromConfig(config, func(o *rds.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 
 	sdkCognitoClient := cognitoidentityprovider.NewFromConfig(config, func(o *cognitoidentityprovider.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 
 	sdkLogsClient := cloudwatchlogs.NewFromConfig(config, func(o *cloudwatchlogs.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 
 	sdkELBV2Client := elasticloadbalancingv2.NewFromConfig(config, func(o *elasticloadbalancingv2.Options) {
-		o.RetryMaxAttempts = operation.SDKRetryMaxAttempts
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
 		o.RetryMode = aws.RetryModeStandard
 	})
 
-	return NewDeletionProtectionRemover(
-		forceMode,
-		client.NewEC2Client(sdkEC2Client),
-		client.NewRDS(sdkRDSClient),
-		client.NewCognito(sdkCognitoClient),
-		client.NewCloudWatchLogs(sdkLogsClient),
-		client.NewELBV2(sdkELBV2Client),
-	)
+	sdkDynamoDBClient := dynamodb.NewFromConfig(config, func(o *dynamodb.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	sdkNeptuneClient := neptune.NewFromConfig(config, func(o *neptune.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	sdkDocDBClient := docdb.NewFromConfig(config, func(o *docdb.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	sdkNetworkFirewallClient := networkfirewall.NewFromConfig(config, func(o *networkfirewall.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	sdkQLDBClient := qldb.NewFromConfig(config, func(o *qldb.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	sdkCloudTrailClient := cloudtrail.NewFromConfig(config, func(o *cloudtrail.Options) {
+		o.RetryMaxAttempts = client.SDKRetryMaxAttempts
+		o.RetryMode = aws.RetryModeStandard
+	})
+
+	ec2Client := client.NewEC2Client(sdkEC2Client)
+	rdsClient := client.NewRDS(sdkRDSClient)
+	cognitoClient := client.NewCognito(sdkCognitoClient)
+	logsClient := client.NewCloudWatchLogs(sdkLogsClient)
+	elbv2Client := client.NewELBV2(sdkELBV2Client)
+	dynamoDBClient := client.NewDynamoDB(sdkDynamoDBClient)
+	neptuneClient := client.NewNeptune(sdkNeptuneClient)
+	docDBClient := client.NewDocDB(sdkDocDBClient)
+	networkFirewallClient := client.NewNetworkFirewall(sdkNetworkFirewallClient)
+	qldbClient := client.NewQLDB(sdkQLDBClient)
+	cloudTrailClient := client.NewCloudTrail(sdkCloudTrailClient)
+
+	// The registry of the resource types with deletion protection, keyed by the CloudFormation type.
+	handlers := map[string]ProtectionHandler{
+		resourcetype.Ec2Instance: {
+			Check:   ec2Client.CheckTerminationProtection,
+			Disable: ec2Client.DisableTerminationProtection,
+		},
+		resourcetype.RdsDBInstance: {
+			Check:   rdsClient.CheckDBInstanceDeletionProtection,
+			Disable: rdsClient.DisableDBInstanceDeletionProtection,
+		},
+		resourcetype.RdsDBCluster: {
+			Check:   rdsClient.CheckDBClusterDeletionProtection,
+			Disable: rdsClient.DisableDBClusterDeletionProtection,
+		},
+		resourcetype.CognitoUserPool: {
+			Check:   cognitoClient.CheckUserPoolDeletionProtection,
+			Disable: cognitoClient.DisableUserPoolDeletionProtection,
+		},
+		resourcetype.LogsLogGroup: {
+			Check:   logsClient.CheckLogGroupDeletionProtection,
+			Disable: logsClient.DisableLogGroupDeletionProtection,
+		},
+		resourcetype.Elbv2LoadBalancer: {
+			Check:   elbv2Client.CheckLoadBalancerDeletionProtection,
+			Disable: elbv2Client.DisableLoadBalancerDeletionProtection,
+		},
+		resourcetype.DynamoDBTable: {
+			Check:   dynamoDBClient.CheckTableDeletionProtection,
+			Disable: dynamoDBClient.DisableTableDeletionProtection,
+		},
+		resourcetype.DynamoDBGlobalTable: {
+			Check:   dynamoDBClient.CheckTableDeletionProtection,
+			Disable: dynamoDBClient.DisableTableDeletionProtection,
+		},
+		resourcetype.NeptuneDBCluster: {
+			Check:   neptuneClient.CheckDBClusterDeletionProtection,
+			Disable: neptuneClient.DisableDBClusterDeletionProtection,
+		},
+		resourcetype.DocDBDBCluster: {
+			Check:   docDBClient.CheckDBClusterDeletionProtection,
+			Disable: docDBClient.DisableDBClusterDeletionProtection,
+		},
+		resourcetype.NetworkFirewallFirewall: {
+			Check:   networkFirewallClient.CheckFirewallProtection,
+			Disable: networkFirewallClient.DisableFirewallProtection,
+		},
+		resourcetype.QldbLedger: {
+			Check:   qldbClient.CheckLedgerDeletionProtection,
+			Disable: qldbClient.DisableLedgerDeletionProtection,
+		},
+		resourcetype.CloudTrailEventDataStore: {
+			Check:   cloudTrailClient.CheckEventDataStoreTerminationProtection,
+			Disable: cloudTrailClient.DisableEventDataStoreTerminationProtection,
+		},
+	}
+
+	return NewDeletionProtectionRemover(forceMode, handlers)
 }
@@ -0,0 +1,173 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+var _ IPreprocessor = (*LambdaLayerVersionCleaner)(nil)
+
+// LambdaLayerVersionCleaner deletes the previous versions of the layers that the stack published.
+// Every update of a layer publishes a new version, and the previous one is retained outside the
+// stack when its DeletionPolicy is Retain (the default for AWS::Lambda::LayerVersion in CDK), so
+// they accumulate forever.
+//
+// Only the versions that the stack events show were created by the stack are deleted, so the
+// versions of the same layer published by hand, by CI or by other stacks are kept, and so are the
+// ones whose events CloudFormation no longer keeps. The current ones are deleted with the stack.
+type LambdaLayerVersionCleaner struct {
+	lambdaClient client.ILambda
+	cfnClient    client.ICloudFormation
+}
+
+func NewLambdaLayerVersionCleaner(lambdaClient client.ILambda, cfnClient client.ICloudFormation) *LambdaLayerVersionCleaner {
+	return &LambdaLayerVersionCleaner{
+		lambdaClient: lambdaClient,
+		cfnClient:    cfnClient,
+	}
+}
+
+func (c *LambdaLayerVersionCleaner) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	layerVersions := FilterResourcesByType(resources, resourcetype.LambdaLayerVersion)
+
+	if len(layerVersions) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d Lambda layer version(s), checking previous versions", aws.ToString(stackName), len(layerVersions))
+
+	// The current versions in the stack by the layer ARN.
+	currentVersions := map[string]map[int64]struct{}{}
+	for _, layerVersion := range layerVersions {
+		layerArn, versionNumber, err := ParseLayerVersionArn(aws.ToString(layerVersion.PhysicalResourceId))
+		if err != nil {
+			io.Logger.Warn().Msgf("[%v]: Failed to parse the layer version ARN %s: %v",
+				aws.ToString(stackName), aws.ToString(layerVersion.PhysicalResourceId), err)
+			continue
+		}
+		if _, ok := currentVersions[layerArn]; !ok {
+			currentVersions[layerArn] = map[int64]struct{}{}
+		}
+		currentVersions[layerArn][versionNumber] = struct{}{}
+	}
+
+	if len(currentVersions) == 0 {
+		return nil
+	}
+
+	previousVersions, err := c.listPreviousVersions(ctx, stackName, currentVersions)
+	if err != nil {
+		io.Logger.Warn().Msgf("[%v]: Failed to find the previous layer versions published by the stack: %v", aws.ToString(stackName), err)
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for layerArn, versionNumbers := range previousVersions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.deletePreviousVersions(ctx, stackName, layerArn, versionNumbers); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to delete previous versions of layer %s: %v",
+					aws.ToString(stackName), layerArn, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// listPreviousVersions returns the versions of the layers in the stack that the stack published, except
+// the current ones, by the layer ARN. Each update of a layer creates a new version as a new physical
+// resource, so its ARN is recorded in the CREATE_COMPLETE event of the layer.
+func (c *LambdaLayerVersionCleaner) listPreviousVersions(
+	ctx context.Context,
+	stackName *string,
+	currentVersions map[string]map[int64]struct{},
+) (map[string]map[int64]struct{}, error) {
+	stackEvents, err := c.cfnClient.DescribeStackEvents(ctx, stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	previousVersions := map[string]map[int64]struct{}{}
+	for _, stackEvent := range stackEvents {
+		if aws.ToString(stackEvent.ResourceType) != resourcetype.LambdaLayerVersion ||
+			stackEvent.ResourceStatus != types.ResourceStatusCreateComplete {
+			continue
+		}
+		layerArn, versionNumber, err := ParseLayerVersionArn(aws.ToString(stackEvent.PhysicalResourceId))
+		if err != nil {
+			continue
+		}
+		current, ok := currentVersions[layerArn]
+		if !ok {
+			continue
+		}
+		if _, ok := current[versionNumber]; ok {
+			continue
+		}
+		if _, ok := previousVersions[layerArn]; !ok {
+			previousVersions[layerArn] = map[int64]struct{}{}
+		}
+		previousVersions[layerArn][versionNumber] = struct{}{}
+	}
+
+	return previousVersions, nil
+}
+
+// deletePreviousVersions deletes the given versions of the layer that still exist.
+func (c *LambdaLayerVersionCleaner) deletePreviousVersions(ctx context.Context, stackName *string, layerArn string, versionNumbers map[int64]struct{}) error {
+	layerVersions, err := c.lambdaClient.ListLayerVersions(ctx, aws.String(layerArn))
+	if err != nil {
+		return fmt.Errorf("failed to list layer versions: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, layerVersion := range layerVersions {
+		if _, ok := versionNumbers[layerVersion.Version]; !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(versionNumber int64) {
+			defer wg.Done()
+			if err := c.lambdaClient.DeleteLayerVersion(ctx, aws.String(layerArn), versionNumber); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to delete previous version %d of layer %s: %v",
+					aws.ToString(stackName), versionNumber, layerArn, err)
+				return
+			}
+			io.Logger.Info().Msgf("[%v]: Deleted previous version %d of layer %s", aws.ToString(stackName), versionNumber, layerArn)
+		}(layerVersion.Version)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// ParseLayerVersionArn splits the layer version ARN (`arn:aws:lambda:<region>:<account>:layer:<name>:<version>`)
+// into the layer ARN and the version number.
+func ParseLayerVersionArn(layerVersionArn string) (string, int64, error) {
+	index := strings.LastIndex(layerVersionArn, ":")
+	if index == -1 {
+		return "", 0, fmt.Errorf("InvalidLayerVersionArnError: the version number is not found")
+	}
+
+	versionNumber, err := strconv.ParseInt(layerVersionArn[index+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("InvalidLayerVersionArnError: the version number is not found")
+	}
+
+	return layerVersionArn[:index], versionNumber, nil
+}
@@ -0,0 +1,244 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLambdaLayerVersionCleaner_Preprocess(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	io.Logger = &logger
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	layerArn := "arn:aws:lambda:us-east-1:123456789012:layer:test-layer"
+
+	type args struct {
+		ctx       context.Context
+		stackName *string
+		resources []types.StackResourceSummary
+	}
+
+	layerVersionEvent := func(layerVersionArn string) types.StackEvent {
+		return types.StackEvent{
+			LogicalResourceId:  aws.String("Layer"),
+			PhysicalResourceId: aws.String(layerVersionArn),
+			ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+			ResourceStatus:     types.ResourceStatusCreateComplete,
+		}
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		setup   func(*client.MockILambda, *client.MockICloudFormation)
+		wantErr bool
+	}{
+		{
+			name: "no layer versions",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::Function"),
+						PhysicalResourceId: aws.String("test-function"),
+					},
+				},
+			},
+			setup:   func(m *client.MockILambda, cfn *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "delete only previous versions published by the stack",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":4"),
+					},
+				},
+			},
+			setup: func(m *client.MockILambda, cfn *client.MockICloudFormation) {
+				cfn.EXPECT().DescribeStackEvents(gomock.Any(), aws.String("test-stack")).Return([]types.StackEvent{
+					layerVersionEvent(layerArn + ":4"),
+					{
+						LogicalResourceId:  aws.String("Layer"),
+						PhysicalResourceId: aws.String(layerArn + ":3"),
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						ResourceStatus:     types.ResourceStatusCreateInProgress,
+					},
+					layerVersionEvent(layerArn + ":3"),
+					layerVersionEvent(layerArn + ":1"),
+					layerVersionEvent("arn:aws:lambda:us-east-1:123456789012:layer:other-layer:1"),
+				}, nil)
+				// The version 2 was published outside the stack, and the version 5 after the current one.
+				m.EXPECT().ListLayerVersions(gomock.Any(), aws.String(layerArn)).Return([]lambdatypes.LayerVersionsListItem{
+					{Version: 5},
+					{Version: 4},
+					{Version: 3},
+					{Version: 2},
+					{Version: 1},
+				}, nil)
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String(layerArn), int64(3)).Return(nil)
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String(layerArn), int64(1)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "keep every current version of the same layer in the stack",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":1"),
+					},
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":3"),
+					},
+				},
+			},
+			setup: func(m *client.MockILambda, cfn *client.MockICloudFormation) {
+				cfn.EXPECT().DescribeStackEvents(gomock.Any(), aws.String("test-stack")).Return([]types.StackEvent{
+					layerVersionEvent(layerArn + ":3"),
+					layerVersionEvent(layerArn + ":2"),
+					layerVersionEvent(layerArn + ":1"),
+				}, nil)
+				m.EXPECT().ListLayerVersions(gomock.Any(), aws.String(layerArn)).Return([]lambdatypes.LayerVersionsListItem{
+					{Version: 3},
+					{Version: 2},
+					{Version: 1},
+				}, nil)
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String(layerArn), int64(2)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete nothing when the stack published only the current version",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":3"),
+					},
+				},
+			},
+			setup: func(m *client.MockILambda, cfn *client.MockICloudFormation) {
+				cfn.EXPECT().DescribeStackEvents(gomock.Any(), aws.String("test-stack")).Return([]types.StackEvent{
+					layerVersionEvent(layerArn + ":3"),
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip layer versions already deleted",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":3"),
+						ResourceStatus:     types.ResourceStatusDeleteComplete,
+					},
+				},
+			},
+			setup:   func(m *client.MockILambda, cfn *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "skip invalid layer version ARNs",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn),
+					},
+				},
+			},
+			setup:   func(m *client.MockILambda, cfn *client.MockICloudFormation) {},
+			wantErr: false,
+		},
+		{
+			name: "describe stack events errors do not fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":3"),
+					},
+				},
+			},
+			setup: func(m *client.MockILambda, cfn *client.MockICloudFormation) {
+				cfn.EXPECT().DescribeStackEvents(gomock.Any(), aws.String("test-stack")).Return(nil, fmt.Errorf("DescribeStackEventsError"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "list and delete errors do not fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + ":3"),
+					},
+					{
+						ResourceType:       aws.String("AWS::Lambda::LayerVersion"),
+						PhysicalResourceId: aws.String(layerArn + "-other:2"),
+					},
+				},
+			},
+			setup: func(m *client.MockILambda, cfn *client.MockICloudFormation) {
+				cfn.EXPECT().DescribeStackEvents(gomock.Any(), aws.String("test-stack")).Return([]types.StackEvent{
+					layerVersionEvent(layerArn + ":2"),
+					layerVersionEvent(layerArn + "-other:1"),
+				}, nil)
+				m.EXPECT().ListLayerVersions(gomock.Any(), aws.String(layerArn)).Return(nil, fmt.Errorf("ListLayerVersionsError"))
+				m.EXPECT().ListLayerVersions(gomock.Any(), aws.String(layerArn+"-other")).Return([]lambdatypes.LayerVersionsListItem{
+					{Version: 2},
+					{Version: 1},
+				}, nil)
+				m.EXPECT().DeleteLayerVersion(gomock.Any(), aws.String(layerArn+"-other"), int64(1)).Return(fmt.Errorf("DeleteLayerVersionError"))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLambda := client.NewMockILambda(ctrl)
+			mockCfn := client.NewMockICloudFormation(ctrl)
+			tt.setup(mockLambda, mockCfn)
+
+			cleaner := NewLambdaLayerVersionCleaner(mockLambda, mockCfn)
+			err := cleaner.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Preprocess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
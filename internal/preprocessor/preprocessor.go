@@ -2,9 +2,14 @@ package preprocessor
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"gopkg.in/yaml.v3"
 )
 
 type IPreprocessor interface {
@@ -21,3 +26,68 @@ func FilterResourcesByType(resources []types.StackResourceSummary, resourceType
 	}
 	return filtered
 }
+
+// RetainedLogicalResourceIds returns the logical IDs of the resources with DeletionPolicy Retain or
+// RetainExceptOnCreate at the resource level, which CloudFormation keeps when the stack is deleted.
+//
+// With the AWS::LanguageExtensions transform, DeletionPolicy can be an intrinsic function such as
+// Fn::If or Ref, which only CloudFormation resolves. So any DeletionPolicy other than a literal
+// Delete or Snapshot is regarded as retained. Note that the short form in YAML (e.g. `!Ref Policy`)
+// is decoded as a plain string of its argument.
+func RetainedLogicalResourceIds(template *string) (map[string]struct{}, error) {
+	retained := map[string]struct{}{}
+	if template == nil || *template == "" {
+		return retained, nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(*template), &data); err != nil {
+		if err := yaml.Unmarshal([]byte(*template), &data); err != nil {
+			return nil, fmt.Errorf("TemplateParseError: template is neither valid JSON nor valid YAML: %w", err)
+		}
+	}
+
+	resourcesMap, ok := data["Resources"].(map[string]interface{})
+	if !ok {
+		return retained, nil
+	}
+
+	for logicalResourceId, resource := range resourcesMap {
+		resourceMap, ok := resource.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		deletionPolicy, exists := resourceMap["DeletionPolicy"]
+		if !exists {
+			continue
+		}
+		if deletionPolicyStr, ok := deletionPolicy.(string); ok && (deletionPolicyStr == "Delete" || deletionPolicyStr == "Snapshot") {
+			continue
+		}
+		retained[logicalResourceId] = struct{}{}
+	}
+	return retained, nil
+}
+
+// ExcludeRetainedResources returns the resources except the ones retained by their DeletionPolicy in
+// the template of the stack, which must be left untouched as they outlive the stack.
+func ExcludeRetainedResources(ctx context.Context, cfnClient client.ICloudFormation, stackName *string, resources []types.StackResourceSummary) ([]types.StackResourceSummary, error) {
+	template, err := cfnClient.GetTemplate(ctx, stackName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the template to find retained resources: %w", err)
+	}
+	retained, err := RetainedLogicalResourceIds(template)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []types.StackResourceSummary
+	for _, resource := range resources {
+		if _, ok := retained[aws.ToString(resource.LogicalResourceId)]; ok {
+			io.Logger.Debug().Msgf("[%v]: Skip %s retained by its DeletionPolicy", aws.ToString(stackName), aws.ToString(resource.PhysicalResourceId))
+			continue
+		}
+		filtered = append(filtered, resource)
+	}
+	return filtered, nil
+}
@@ -107,3 +107,99 @@ func TestFilterResourcesByType(t *testing.T) {
 		})
 	}
 }
+
+func TestRetainedLogicalResourceIds(t *testing.T) {
+	cases := []struct {
+		name     string
+		template *string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name: "YAML",
+			template: aws.String(`Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+  Repository:
+    Type: AWS::ECR::Repository
+    DeletionPolicy: RetainExceptOnCreate
+  Table:
+    Type: AWS::DynamoDB::Table
+    DeletionPolicy: Snapshot
+  Topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      DeletionPolicy: Retain
+`),
+			want:    []string{"Bucket", "Repository"},
+			wantErr: false,
+		},
+		{
+			name:     "JSON",
+			template: aws.String(`{"Resources":{"Bucket":{"Type":"AWS::S3::Bucket","DeletionPolicy":"Retain"},"Topic":{"Type":"AWS::SNS::Topic","DeletionPolicy":"Delete"}}}`),
+			want:     []string{"Bucket"},
+			wantErr:  false,
+		},
+		{
+			name: "intrinsic functions with AWS::LanguageExtensions",
+			template: aws.String(`Transform: AWS::LanguageExtensions
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: !If [IsProd, Retain, Delete]
+  Repository:
+    Type: AWS::ECR::Repository
+    DeletionPolicy: !Ref DeletionPolicyParameter
+  Table:
+    Type: AWS::DynamoDB::Table
+    DeletionPolicy:
+      Fn::If: [IsProd, Retain, Delete]
+  Topic:
+    Type: AWS::SNS::Topic
+    DeletionPolicy: Delete
+`),
+			want:    []string{"Bucket", "Repository", "Table"},
+			wantErr: false,
+		},
+		{
+			name:     "intrinsic functions in JSON",
+			template: aws.String(`{"Resources":{"Bucket":{"Type":"AWS::S3::Bucket","DeletionPolicy":{"Ref":"DeletionPolicyParameter"}},"Topic":{"Type":"AWS::SNS::Topic"}}}`),
+			want:     []string{"Bucket"},
+			wantErr:  false,
+		},
+		{
+			name:     "empty template",
+			template: nil,
+			want:     []string{},
+			wantErr:  false,
+		},
+		{
+			name:     "invalid template",
+			template: aws.String("{invalid: [template"),
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RetainedLogicalResourceIds(tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+			for _, logicalResourceId := range tt.want {
+				if _, ok := got[logicalResourceId]; !ok {
+					t.Errorf("%s is not retained, got = %v", logicalResourceId, got)
+				}
+			}
+		})
+	}
+}
@@ -31,6 +31,15 @@ func (r *RecursivePreprocessor) PreprocessRecursively(ctx context.Context, stack
 
 	nestedStacks := FilterResourcesByType(resources, resourcetype.CloudformationStack)
 
+	// Nested stacks retained by their DeletionPolicy outlive the deletion with all their resources,
+	// so nothing in them is preprocessed.
+	if len(nestedStacks) > 0 {
+		nestedStacks, err = ExcludeRetainedResources(ctx, r.cfnClient, stackName, nestedStacks)
+		if err != nil {
+			return err
+		}
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	// Process current stack's resources with preprocessor
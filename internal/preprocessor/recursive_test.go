@@ -68,12 +68,56 @@ func TestRecursivePreprocessor_PreprocessRecursively(t *testing.T) {
 						},
 					}, nil,
 				)
+				cfn.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
 				cfn.EXPECT().ListStackResources(gomock.Any(), aws.String("nested-stack")).Return(
 					[]types.StackResourceSummary{}, nil,
 				)
 			},
 			wantErr: false,
 		},
+		{
+			name: "nested stack retained by its DeletionPolicy is skipped",
+			setup: func(cfn *client.MockICloudFormation, pp *mockPreprocessor) {
+				cfn.EXPECT().ListStackResources(gomock.Any(), aws.String("test-stack")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("RetainedNestedStack"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							PhysicalResourceId: aws.String("retained-nested-stack"),
+						},
+						{
+							LogicalResourceId:  aws.String("NestedStack"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							PhysicalResourceId: aws.String("nested-stack"),
+						},
+					}, nil,
+				)
+				cfn.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(
+					aws.String(`{"Resources": {"RetainedNestedStack": {"Type": "AWS::CloudFormation::Stack", "DeletionPolicy": "Retain"}, "NestedStack": {"Type": "AWS::CloudFormation::Stack"}}}`),
+					nil,
+				)
+				cfn.EXPECT().ListStackResources(gomock.Any(), aws.String("nested-stack")).Return(
+					[]types.StackResourceSummary{}, nil,
+				)
+			},
+			wantErr: false,
+		},
+		{
+			name: "get template error for nested stacks propagates",
+			setup: func(cfn *client.MockICloudFormation, pp *mockPreprocessor) {
+				cfn.EXPECT().ListStackResources(gomock.Any(), aws.String("test-stack")).Return(
+					[]types.StackResourceSummary{
+						{
+							LogicalResourceId:  aws.String("NestedStack"),
+							ResourceType:       aws.String("AWS::CloudFormation::Stack"),
+							PhysicalResourceId: aws.String("nested-stack"),
+						},
+					}, nil,
+				)
+				cfn.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(nil, fmt.Errorf("get template error"))
+			},
+			wantErr: true,
+		},
 		{
 			name: "nested stack with DELETE_COMPLETE is skipped",
 			setup: func(cfn *client.MockICloudFormation, pp *mockPreprocessor) {
@@ -116,6 +160,7 @@ func TestRecursivePreprocessor_PreprocessRecursively(t *testing.T) {
 						},
 					}, nil,
 				)
+				cfn.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
 				cfn.EXPECT().ListStackResources(gomock.Any(), aws.String("nested-stack")).Return(
 					[]types.StackResourceSummary{
 						{
@@ -138,6 +183,7 @@ func TestRecursivePreprocessor_PreprocessRecursively(t *testing.T) {
 						},
 					}, nil,
 				)
+				cfn.EXPECT().GetTemplate(gomock.Any(), aws.String("test-stack")).Return(aws.String("Resources: {}"), nil)
 				cfn.EXPECT().ListStackResources(gomock.Any(), aws.String("nested-stack")).Return(
 					nil, fmt.Errorf("nested list error"),
 				)
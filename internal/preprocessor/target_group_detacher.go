@@ -0,0 +1,173 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/internal/resourcetype"
+	"github.com/go-to-k/delstack/pkg/client"
+)
+
+var _ IPreprocessor = (*TargetGroupDetacher)(nil)
+
+// TargetGroupDetacher detaches the target groups in the stack from the load balancers shared with
+// other stacks or tools. A target group cannot be deleted while a listener rule forwards to it, so
+// the listener rules that are not in the stack and forward to the target groups are deleted, and the
+// targets registered with the target groups are deregistered.
+//
+// A listener outside the stack whose default action forwards to a target group cannot be fixed here
+// without changing the routing of that listener, so it is only reported as a warning.
+type TargetGroupDetacher struct {
+	elbv2Client client.IELBV2
+}
+
+func NewTargetGroupDetacher(elbv2Client client.IELBV2) *TargetGroupDetacher {
+	return &TargetGroupDetacher{
+		elbv2Client: elbv2Client,
+	}
+}
+
+func (d *TargetGroupDetacher) Preprocess(ctx context.Context, stackName *string, resources []types.StackResourceSummary) error {
+	targetGroups := FilterResourcesByType(resources, resourcetype.Elbv2TargetGroup)
+
+	if len(targetGroups) == 0 {
+		return nil
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Found %d target group(s), checking listener rules and targets", aws.ToString(stackName), len(targetGroups))
+
+	stackListeners := physicalResourceIdSet(FilterResourcesByType(resources, resourcetype.Elbv2Listener))
+	stackRules := physicalResourceIdSet(FilterResourcesByType(resources, resourcetype.Elbv2ListenerRule))
+
+	var wg sync.WaitGroup
+	for _, resource := range targetGroups {
+		targetGroupArn := resource.PhysicalResourceId
+		if aws.ToString(targetGroupArn) == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(arn *string) {
+			defer wg.Done()
+			if err := d.removeForeignListenerRules(ctx, stackName, arn, stackListeners, stackRules); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to remove listener rules forwarding to target group %s: %v",
+					aws.ToString(stackName), aws.ToString(arn), err)
+			}
+			if err := d.deregisterTargets(ctx, stackName, arn); err != nil {
+				io.Logger.Warn().Msgf("[%v]: Failed to deregister targets of target group %s: %v",
+					aws.ToString(stackName), aws.ToString(arn), err)
+			}
+		}(targetGroupArn)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (d *TargetGroupDetacher) removeForeignListenerRules(
+	ctx context.Context,
+	stackName *string,
+	targetGroupArn *string,
+	stackListeners map[string]struct{},
+	stackRules map[string]struct{},
+) error {
+	loadBalancerArns, err := d.elbv2Client.DescribeTargetGroupLoadBalancerArns(ctx, targetGroupArn)
+	if err != nil {
+		return fmt.Errorf("failed to describe load balancers: %w", err)
+	}
+
+	for _, loadBalancerArn := range loadBalancerArns {
+		listeners, err := d.elbv2Client.DescribeListeners(ctx, aws.String(loadBalancerArn))
+		if err != nil {
+			return fmt.Errorf("failed to describe listeners: %w", err)
+		}
+
+		for _, listener := range listeners {
+			if _, ok := stackListeners[aws.ToString(listener.ListenerArn)]; !ok && forwardsToTargetGroup(listener.DefaultActions, targetGroupArn) {
+				io.Logger.Warn().Msgf("[%v]: The default action of listener %s outside the stack forwards to target group %s, so the target group may fail to be deleted",
+					aws.ToString(stackName), aws.ToString(listener.ListenerArn), aws.ToString(targetGroupArn))
+			}
+
+			// Only the listeners of Application Load Balancers have rules other than the default one.
+			if listener.Protocol != elbv2types.ProtocolEnumHttp && listener.Protocol != elbv2types.ProtocolEnumHttps {
+				continue
+			}
+
+			rules, err := d.elbv2Client.DescribeRules(ctx, listener.ListenerArn)
+			if err != nil {
+				return fmt.Errorf("failed to describe rules: %w", err)
+			}
+
+			for _, rule := range rules {
+				if aws.ToBool(rule.IsDefault) || !forwardsToTargetGroup(rule.Actions, targetGroupArn) {
+					continue
+				}
+				if _, ok := stackRules[aws.ToString(rule.RuleArn)]; ok {
+					continue
+				}
+				if err := d.elbv2Client.DeleteRule(ctx, rule.RuleArn); err != nil {
+					return fmt.Errorf("failed to delete listener rule: %w", err)
+				}
+				io.Logger.Info().Msgf("[%v]: Deleted listener rule %s forwarding to target group %s",
+					aws.ToString(stackName), aws.ToString(rule.RuleArn), aws.ToString(targetGroupArn))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *TargetGroupDetacher) deregisterTargets(ctx context.Context, stackName *string, targetGroupArn *string) error {
+	targets, err := d.elbv2Client.DescribeTargets(ctx, targetGroupArn)
+	if err != nil {
+		return fmt.Errorf("failed to describe targets: %w", err)
+	}
+
+	if len(targets) == 0 {
+		return nil
+	}
+
+	if err := d.elbv2Client.DeregisterTargets(ctx, targetGroupArn, targets); err != nil {
+		return err
+	}
+
+	io.Logger.Debug().Msgf("[%v]: Deregistered %d target(s) from target group %s",
+		aws.ToString(stackName), len(targets), aws.ToString(targetGroupArn))
+
+	return nil
+}
+
+// forwardsToTargetGroup reports whether any of the actions forwards to the target group, either
+// directly or as one of the weighted target groups.
+func forwardsToTargetGroup(actions []elbv2types.Action, targetGroupArn *string) bool {
+	for _, action := range actions {
+		if action.Type != elbv2types.ActionTypeEnumForward {
+			continue
+		}
+		if aws.ToString(action.TargetGroupArn) == aws.ToString(targetGroupArn) {
+			return true
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, targetGroup := range action.ForwardConfig.TargetGroups {
+			if aws.ToString(targetGroup.TargetGroupArn) == aws.ToString(targetGroupArn) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func physicalResourceIdSet(resources []types.StackResourceSummary) map[string]struct{} {
+	ids := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		ids[aws.ToString(resource.PhysicalResourceId)] = struct{}{}
+	}
+	return ids
+}
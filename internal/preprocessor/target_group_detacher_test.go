@@ -0,0 +1,260 @@
+package preprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-to-k/delstack/internal/io"
+	"github.com/go-to-k/delstack/pkg/client"
+	"github.com/rs/zerolog"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTargetGroupDetacher_Preprocess(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	io.Logger = &logger
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	targetGroupArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/test-tg/1234567890abcdef"
+	otherTargetGroupArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/other-tg/abcdef1234567890"
+	loadBalancerArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/shared-alb/1234567890abcdef"
+	listenerArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/shared-alb/1234567890abcdef/1111111111111111"
+
+	forwardTo := func(arn string) []elbv2types.Action {
+		return []elbv2types.Action{
+			{
+				Type:           elbv2types.ActionTypeEnumForward,
+				TargetGroupArn: aws.String(arn),
+			},
+		}
+	}
+
+	type args struct {
+		ctx       context.Context
+		stackName *string
+		resources []types.StackResourceSummary
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		setup   func(*client.MockIELBV2)
+		wantErr bool
+	}{
+		{
+			name: "no target groups",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::S3::Bucket"),
+						PhysicalResourceId: aws.String("test-bucket"),
+					},
+				},
+			},
+			setup:   func(m *client.MockIELBV2) {},
+			wantErr: false,
+		},
+		{
+			name: "delete foreign listener rules and deregister targets",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						PhysicalResourceId: aws.String(targetGroupArn),
+					},
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::ListenerRule"),
+						PhysicalResourceId: aws.String("stack-rule"),
+					},
+				},
+			},
+			setup: func(m *client.MockIELBV2) {
+				m.EXPECT().DescribeTargetGroupLoadBalancerArns(gomock.Any(), aws.String(targetGroupArn)).Return([]string{loadBalancerArn}, nil)
+				m.EXPECT().DescribeListeners(gomock.Any(), aws.String(loadBalancerArn)).Return([]elbv2types.Listener{
+					{
+						ListenerArn:    aws.String(listenerArn),
+						Protocol:       elbv2types.ProtocolEnumHttps,
+						DefaultActions: forwardTo(otherTargetGroupArn),
+					},
+				}, nil)
+				m.EXPECT().DescribeRules(gomock.Any(), aws.String(listenerArn)).Return([]elbv2types.Rule{
+					{
+						RuleArn: aws.String("stack-rule"),
+						Actions: forwardTo(targetGroupArn),
+					},
+					{
+						RuleArn: aws.String("foreign-rule"),
+						Actions: forwardTo(targetGroupArn),
+					},
+					{
+						RuleArn: aws.String("foreign-weighted-rule"),
+						Actions: []elbv2types.Action{
+							{
+								Type: elbv2types.ActionTypeEnumForward,
+								ForwardConfig: &elbv2types.ForwardActionConfig{
+									TargetGroups: []elbv2types.TargetGroupTuple{
+										{TargetGroupArn: aws.String(otherTargetGroupArn)},
+										{TargetGroupArn: aws.String(targetGroupArn)},
+									},
+								},
+							},
+						},
+					},
+					{
+						RuleArn: aws.String("unrelated-rule"),
+						Actions: forwardTo(otherTargetGroupArn),
+					},
+					{
+						RuleArn:   aws.String("default-rule"),
+						IsDefault: aws.Bool(true),
+						Actions:   forwardTo(otherTargetGroupArn),
+					},
+				}, nil)
+				m.EXPECT().DeleteRule(gomock.Any(), aws.String("foreign-rule")).Return(nil)
+				m.EXPECT().DeleteRule(gomock.Any(), aws.String("foreign-weighted-rule")).Return(nil)
+				m.EXPECT().DescribeTargets(gomock.Any(), aws.String(targetGroupArn)).Return([]elbv2types.TargetDescription{
+					{Id: aws.String("i-1234567890")},
+				}, nil)
+				m.EXPECT().DeregisterTargets(gomock.Any(), aws.String(targetGroupArn), []elbv2types.TargetDescription{
+					{Id: aws.String("i-1234567890")},
+				}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip rules of network load balancer listeners and warn on foreign default actions",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						PhysicalResourceId: aws.String(targetGroupArn),
+					},
+				},
+			},
+			setup: func(m *client.MockIELBV2) {
+				m.EXPECT().DescribeTargetGroupLoadBalancerArns(gomock.Any(), aws.String(targetGroupArn)).Return([]string{loadBalancerArn}, nil)
+				m.EXPECT().DescribeListeners(gomock.Any(), aws.String(loadBalancerArn)).Return([]elbv2types.Listener{
+					{
+						ListenerArn:    aws.String(listenerArn),
+						Protocol:       elbv2types.ProtocolEnumTcp,
+						DefaultActions: forwardTo(targetGroupArn),
+					},
+				}, nil)
+				m.EXPECT().DescribeTargets(gomock.Any(), aws.String(targetGroupArn)).Return([]elbv2types.TargetDescription{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "target group not attached to load balancers",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						PhysicalResourceId: aws.String(targetGroupArn),
+					},
+				},
+			},
+			setup: func(m *client.MockIELBV2) {
+				m.EXPECT().DescribeTargetGroupLoadBalancerArns(gomock.Any(), aws.String(targetGroupArn)).Return([]string{}, nil)
+				m.EXPECT().DescribeTargets(gomock.Any(), aws.String(targetGroupArn)).Return([]elbv2types.TargetDescription{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "skip target groups already deleted",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						PhysicalResourceId: aws.String(targetGroupArn),
+						ResourceStatus:     types.ResourceStatusDeleteComplete,
+					},
+				},
+			},
+			setup:   func(m *client.MockIELBV2) {},
+			wantErr: false,
+		},
+		{
+			name: "delete rule error does not fail preprocessing and still deregisters targets",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						PhysicalResourceId: aws.String(targetGroupArn),
+					},
+				},
+			},
+			setup: func(m *client.MockIELBV2) {
+				m.EXPECT().DescribeTargetGroupLoadBalancerArns(gomock.Any(), aws.String(targetGroupArn)).Return([]string{loadBalancerArn}, nil)
+				m.EXPECT().DescribeListeners(gomock.Any(), aws.String(loadBalancerArn)).Return([]elbv2types.Listener{
+					{
+						ListenerArn: aws.String(listenerArn),
+						Protocol:    elbv2types.ProtocolEnumHttp,
+					},
+				}, nil)
+				m.EXPECT().DescribeRules(gomock.Any(), aws.String(listenerArn)).Return([]elbv2types.Rule{
+					{
+						RuleArn: aws.String("foreign-rule"),
+						Actions: forwardTo(targetGroupArn),
+					},
+				}, nil)
+				m.EXPECT().DeleteRule(gomock.Any(), aws.String("foreign-rule")).Return(fmt.Errorf("DeleteRuleError"))
+				m.EXPECT().DescribeTargets(gomock.Any(), aws.String(targetGroupArn)).Return([]elbv2types.TargetDescription{
+					{Id: aws.String("i-1234567890")},
+				}, nil)
+				m.EXPECT().DeregisterTargets(gomock.Any(), aws.String(targetGroupArn), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe errors do not fail preprocessing",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test-stack"),
+				resources: []types.StackResourceSummary{
+					{
+						ResourceType:       aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+						PhysicalResourceId: aws.String(targetGroupArn),
+					},
+				},
+			},
+			setup: func(m *client.MockIELBV2) {
+				m.EXPECT().DescribeTargetGroupLoadBalancerArns(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("DescribeTargetGroupsError"))
+				m.EXPECT().DescribeTargets(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("DescribeTargetHealthError"))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockELBV2 := client.NewMockIELBV2(ctrl)
+			tt.setup(mockELBV2)
+
+			detacher := NewTargetGroupDetacher(mockELBV2)
+			err := detacher.Preprocess(tt.args.ctx, tt.args.stackName, tt.args.resources)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Preprocess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -10,11 +10,29 @@ const (
 	IamGroup                                 = "AWS::IAM::Group"
 	IamUser                                  = "AWS::IAM::User"
 	EcrRepository                            = "AWS::ECR::Repository"
+	EcrPublicRepository                      = "AWS::ECR::PublicRepository"
 	BackupVault                              = "AWS::Backup::BackupVault"
 	AthenaWorkGroup                          = "AWS::Athena::WorkGroup"
 	EC2Subnet                                = "AWS::EC2::Subnet"
 	EC2SecurityGroup                         = "AWS::EC2::SecurityGroup"
 	CognitoUserPoolUICustomizationAttachment = "AWS::Cognito::UserPoolUICustomizationAttachment"
+	ApiGatewayDomainName                     = "AWS::ApiGateway::DomainName"
+	ApiGatewayV2DomainName                   = "AWS::ApiGatewayV2::DomainName"
+	AcmCertificate                           = "AWS::CertificateManager::Certificate"
+	KinesisStream                            = "AWS::Kinesis::Stream"
+	FirehoseDeliveryStream                   = "AWS::KinesisFirehose::DeliveryStream"
+	DynamoDBTable                            = "AWS::DynamoDB::Table"
+	DynamoDBGlobalTable                      = "AWS::DynamoDB::GlobalTable"
+	ElastiCacheReplicationGroup              = "AWS::ElastiCache::ReplicationGroup"
+	ElastiCacheServerlessCache               = "AWS::ElastiCache::ServerlessCache"
+	OpenSearchDomain                         = "AWS::OpenSearchService::Domain"
+	SNSTopic                                 = "AWS::SNS::Topic"
+	SQSQueue                                 = "AWS::SQS::Queue"
+	StepFunctionsStateMachine                = "AWS::StepFunctions::StateMachine"
+	CognitoUserPoolDomain                    = "AWS::Cognito::UserPoolDomain"
+	LambdaLayerVersion                       = "AWS::Lambda::LayerVersion"
+	EC2TransitGateway                        = "AWS::EC2::TransitGateway"
+	EC2VPCEndpointService                    = "AWS::EC2::VPCEndpointService"
 	CloudformationStack                      = "AWS::CloudFormation::Stack"
 	CloudformationCustomResource             = "AWS::CloudFormation::CustomResource"
 	CustomResource                           = "Custom::"
@@ -25,32 +43,32 @@ const (
 	LambdaFunction = "AWS::Lambda::Function"
 )
 
-// For Deletion Protection Check
+// For Force Deletion and Deletion Protection Check
+const (
+	LogsLogGroup    = "AWS::Logs::LogGroup"
+	CognitoUserPool = "AWS::Cognito::UserPool"
+	RdsDBCluster    = "AWS::RDS::DBCluster"
+)
+
+// For Preprocessors
 const (
-	Ec2Instance       = "AWS::EC2::Instance"
-	RdsDBInstance     = "AWS::RDS::DBInstance"
-	RdsDBCluster      = "AWS::RDS::DBCluster"
-	CognitoUserPool   = "AWS::Cognito::UserPool"
-	LogsLogGroup      = "AWS::Logs::LogGroup"
-	Elbv2LoadBalancer = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	EcrPullThroughCacheRule = "AWS::ECR::PullThroughCacheRule"
+	CloudFrontDistribution  = "AWS::CloudFront::Distribution"
+	AutoScalingGroup        = "AWS::AutoScaling::AutoScalingGroup"
+	EcsService              = "AWS::ECS::Service"
+	Elbv2TargetGroup        = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	Elbv2Listener           = "AWS::ElasticLoadBalancingV2::Listener"
+	Elbv2ListenerRule       = "AWS::ElasticLoadBalancingV2::ListenerRule"
 )
 
-var ResourceTypes = []string{
-	S3Bucket,
-	S3DirectoryBucket,
-	S3TableBucket,
-	S3TableNamespace,
-	S3VectorBucket,
-	IamGroup,
-	IamUser,
-	EcrRepository,
-	BackupVault,
-	AthenaWorkGroup,
-	EC2Subnet,
-	EC2SecurityGroup,
-	CognitoUserPoolUICustomizationAttachment,
-	LambdaFunction,
-	CloudformationStack,
-	CloudformationCustomResource,
-	CustomResource,
-}
+// For Deletion Protection Check
+const (
+	Ec2Instance              = "AWS::EC2::Instance"
+	RdsDBInstance            = "AWS::RDS::DBInstance"
+	Elbv2LoadBalancer        = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	NeptuneDBCluster         = "AWS::Neptune::DBCluster"
+	DocDBDBCluster           = "AWS::DocDB::DBCluster"
+	NetworkFirewallFirewall  = "AWS::NetworkFirewall::Firewall"
+	QldbLedger               = "AWS::QLDB::Ledger"
+	CloudTrailEventDataStore = "AWS::CloudTrail::EventDataStore"
+)
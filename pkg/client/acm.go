@@ -0,0 +1,82 @@
+//go:generate mockgen -source=$GOFILE -destination=acm_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+)
+
+type IAcm interface {
+	GetCertificateInUseBy(ctx context.Context, certificateArn *string) ([]string, error)
+	DeleteCertificate(ctx context.Context, certificateArn *string) error
+	CheckCertificateExists(ctx context.Context, certificateArn *string) (bool, error)
+}
+
+var _ IAcm = (*Acm)(nil)
+
+type Acm struct {
+	client *acm.Client
+}
+
+func NewAcm(client *acm.Client) *Acm {
+	return &Acm{
+		client,
+	}
+}
+
+// GetCertificateInUseBy returns the ARNs of the AWS resources (load balancers, CloudFront
+// distributions, etc.) that the certificate is associated with.
+func (a *Acm) GetCertificateInUseBy(ctx context.Context, certificateArn *string) ([]string, error) {
+	input := &acm.DescribeCertificateInput{
+		CertificateArn: certificateArn,
+	}
+
+	output, err := a.client.DescribeCertificate(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: certificateArn,
+			Err:          err,
+		}
+	}
+	if output.Certificate == nil {
+		return []string{}, nil
+	}
+
+	return output.Certificate.InUseBy, nil
+}
+
+func (a *Acm) DeleteCertificate(ctx context.Context, certificateArn *string) error {
+	input := &acm.DeleteCertificateInput{
+		CertificateArn: certificateArn,
+	}
+
+	_, err := a.client.DeleteCertificate(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: certificateArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (a *Acm) CheckCertificateExists(ctx context.Context, certificateArn *string) (bool, error) {
+	input := &acm.DescribeCertificateInput{
+		CertificateArn: certificateArn,
+	}
+
+	_, err := a.client.DescribeCertificate(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: certificateArn,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
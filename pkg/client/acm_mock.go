@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: acm.go
+//
+// Generated by this command:
+//
+//	mockgen -source=acm.go -destination=acm_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAcm is a mock of IAcm interface.
+type MockIAcm struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAcmMockRecorder
+	isgomock struct{}
+}
+
+// MockIAcmMockRecorder is the mock recorder for MockIAcm.
+type MockIAcmMockRecorder struct {
+	mock *MockIAcm
+}
+
+// NewMockIAcm creates a new mock instance.
+func NewMockIAcm(ctrl *gomock.Controller) *MockIAcm {
+	mock := &MockIAcm{ctrl: ctrl}
+	mock.recorder = &MockIAcmMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAcm) EXPECT() *MockIAcmMockRecorder {
+	return m.recorder
+}
+
+// CheckCertificateExists mocks base method.
+func (m *MockIAcm) CheckCertificateExists(ctx context.Context, certificateArn *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCertificateExists", ctx, certificateArn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCertificateExists indicates an expected call of CheckCertificateExists.
+func (mr *MockIAcmMockRecorder) CheckCertificateExists(ctx, certificateArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCertificateExists", reflect.TypeOf((*MockIAcm)(nil).CheckCertificateExists), ctx, certificateArn)
+}
+
+// DeleteCertificate mocks base method.
+func (m *MockIAcm) DeleteCertificate(ctx context.Context, certificateArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertificate", ctx, certificateArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCertificate indicates an expected call of DeleteCertificate.
+func (mr *MockIAcmMockRecorder) DeleteCertificate(ctx, certificateArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificate", reflect.TypeOf((*MockIAcm)(nil).DeleteCertificate), ctx, certificateArn)
+}
+
+// GetCertificateInUseBy mocks base method.
+func (m *MockIAcm) GetCertificateInUseBy(ctx context.Context, certificateArn *string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateInUseBy", ctx, certificateArn)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateInUseBy indicates an expected call of GetCertificateInUseBy.
+func (mr *MockIAcmMockRecorder) GetCertificateInUseBy(ctx, certificateArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateInUseBy", reflect.TypeOf((*MockIAcm)(nil).GetCertificateInUseBy), ctx, certificateArn)
+}
@@ -0,0 +1,299 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/smithy-go/middleware"
+)
+
+/*
+	Test Cases
+*/
+
+func TestAcm_CheckCertificateExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		certificateArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check certificate exists successfully",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeCertificateMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DescribeCertificateOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check certificate exists successfully for not found",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeCertificateNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DescribeCertificateOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check certificate exists failure",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeCertificateErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DescribeCertificateOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeCertificateError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := acm.NewFromConfig(cfg)
+			acmClient := NewAcm(client)
+
+			output, err := acmClient.CheckCertificateExists(tt.args.ctx, tt.args.certificateArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcm_DeleteCertificate(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		certificateArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete certificate successfully",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteCertificateMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DeleteCertificateOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete certificate failure",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteCertificateErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DeleteCertificateOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteCertificateError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				Err:          fmt.Errorf("operation error ACM: DeleteCertificate, DeleteCertificateError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := acm.NewFromConfig(cfg)
+			acmClient := NewAcm(client)
+
+			err = acmClient.DeleteCertificate(tt.args.ctx, tt.args.certificateArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcm_GetCertificateInUseBy(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		certificateArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "get certificate in use by successfully",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeCertificateMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DescribeCertificateOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "get certificate in use by failure",
+			args: args{
+				ctx:            context.Background(),
+				certificateArn: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeCertificateErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &acm.DescribeCertificateOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeCertificateError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("arn:aws:acm:ap-northeast-1:123456789012:certificate/test"),
+				Err:          fmt.Errorf("operation error ACM: DescribeCertificate, DescribeCertificateError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := acm.NewFromConfig(cfg)
+			acmClient := NewAcm(client)
+
+			_, err = acmClient.GetCertificateInUseBy(tt.args.ctx, tt.args.certificateArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
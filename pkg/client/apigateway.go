@@ -0,0 +1,141 @@
+//go:generate mockgen -source=$GOFILE -destination=apigateway_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+)
+
+var SleepTimeSecForApiGateway = 10
+
+type IApiGateway interface {
+	GetBasePathMappings(ctx context.Context, domainName *string) ([]types.BasePathMapping, error)
+	DeleteBasePathMapping(ctx context.Context, domainName *string, basePath *string) error
+	DeleteDomainName(ctx context.Context, domainName *string) error
+	CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error)
+}
+
+var _ IApiGateway = (*ApiGateway)(nil)
+
+type ApiGateway struct {
+	client  *apigateway.Client
+	retryer *Retryer
+}
+
+func NewApiGateway(client *apigateway.Client) *ApiGateway {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "TooManyRequestsException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForApiGateway)
+
+	return &ApiGateway{
+		client,
+		retryer,
+	}
+}
+
+func (a *ApiGateway) GetBasePathMappings(ctx context.Context, domainName *string) ([]types.BasePathMapping, error) {
+	var position *string
+	basePathMappings := []types.BasePathMapping{}
+
+	optFn := func(o *apigateway.Options) {
+		o.Retryer = a.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return basePathMappings, &ClientError{
+				ResourceName: domainName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &apigateway.GetBasePathMappingsInput{
+			DomainName: domainName,
+			Position:   position,
+		}
+
+		output, err := a.client.GetBasePathMappings(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: domainName,
+				Err:          err,
+			}
+		}
+		basePathMappings = append(basePathMappings, output.Items...)
+
+		position = output.Position
+		if position == nil {
+			break
+		}
+	}
+
+	return basePathMappings, nil
+}
+
+func (a *ApiGateway) DeleteBasePathMapping(ctx context.Context, domainName *string, basePath *string) error {
+	input := &apigateway.DeleteBasePathMappingInput{
+		DomainName: domainName,
+		BasePath:   basePath,
+	}
+
+	optFn := func(o *apigateway.Options) {
+		o.Retryer = a.retryer
+	}
+
+	_, err := a.client.DeleteBasePathMapping(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (a *ApiGateway) DeleteDomainName(ctx context.Context, domainName *string) error {
+	input := &apigateway.DeleteDomainNameInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(o *apigateway.Options) {
+		o.Retryer = a.retryer
+	}
+
+	_, err := a.client.DeleteDomainName(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (a *ApiGateway) CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error) {
+	input := &apigateway.GetDomainNameInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(o *apigateway.Options) {
+		o.Retryer = a.retryer
+	}
+
+	_, err := a.client.GetDomainName(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "NotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
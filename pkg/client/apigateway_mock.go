@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: apigateway.go
+//
+// Generated by this command:
+//
+//	mockgen -source=apigateway.go -destination=apigateway_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiGateway is a mock of IApiGateway interface.
+type MockIApiGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockIApiGatewayMockRecorder is the mock recorder for MockIApiGateway.
+type MockIApiGatewayMockRecorder struct {
+	mock *MockIApiGateway
+}
+
+// NewMockIApiGateway creates a new mock instance.
+func NewMockIApiGateway(ctrl *gomock.Controller) *MockIApiGateway {
+	mock := &MockIApiGateway{ctrl: ctrl}
+	mock.recorder = &MockIApiGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiGateway) EXPECT() *MockIApiGatewayMockRecorder {
+	return m.recorder
+}
+
+// CheckDomainNameExists mocks base method.
+func (m *MockIApiGateway) CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDomainNameExists", ctx, domainName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDomainNameExists indicates an expected call of CheckDomainNameExists.
+func (mr *MockIApiGatewayMockRecorder) CheckDomainNameExists(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDomainNameExists", reflect.TypeOf((*MockIApiGateway)(nil).CheckDomainNameExists), ctx, domainName)
+}
+
+// DeleteBasePathMapping mocks base method.
+func (m *MockIApiGateway) DeleteBasePathMapping(ctx context.Context, domainName, basePath *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBasePathMapping", ctx, domainName, basePath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBasePathMapping indicates an expected call of DeleteBasePathMapping.
+func (mr *MockIApiGatewayMockRecorder) DeleteBasePathMapping(ctx, domainName, basePath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBasePathMapping", reflect.TypeOf((*MockIApiGateway)(nil).DeleteBasePathMapping), ctx, domainName, basePath)
+}
+
+// DeleteDomainName mocks base method.
+func (m *MockIApiGateway) DeleteDomainName(ctx context.Context, domainName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainName", ctx, domainName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomainName indicates an expected call of DeleteDomainName.
+func (mr *MockIApiGatewayMockRecorder) DeleteDomainName(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainName", reflect.TypeOf((*MockIApiGateway)(nil).DeleteDomainName), ctx, domainName)
+}
+
+// GetBasePathMappings mocks base method.
+func (m *MockIApiGateway) GetBasePathMappings(ctx context.Context, domainName *string) ([]types.BasePathMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBasePathMappings", ctx, domainName)
+	ret0, _ := ret[0].([]types.BasePathMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBasePathMappings indicates an expected call of GetBasePathMappings.
+func (mr *MockIApiGatewayMockRecorder) GetBasePathMappings(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBasePathMappings", reflect.TypeOf((*MockIApiGateway)(nil).GetBasePathMappings), ctx, domainName)
+}
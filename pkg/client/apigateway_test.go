@@ -0,0 +1,469 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type tokenKeyForApiGateway struct{}
+
+func getNextTokenForApiGatewayInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	if v, ok := in.Parameters.(*apigateway.GetBasePathMappingsInput); ok {
+		ctx = middleware.WithStackValue(ctx, tokenKeyForApiGateway{}, v.Position)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+/*
+	Test Cases
+*/
+
+func TestApiGateway_DeleteBasePathMapping(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		basePath           *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete base path mapping successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				basePath:   aws.String("(none)"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteBasePathMappingMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.DeleteBasePathMappingOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete base path mapping failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				basePath:   aws.String("(none)"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteBasePathMappingErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.DeleteBasePathMappingOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteBasePathMappingError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("example.com"),
+				Err:          fmt.Errorf("operation error API Gateway: DeleteBasePathMapping, DeleteBasePathMappingError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigateway.NewFromConfig(cfg)
+			apiGatewayClient := NewApiGateway(client)
+
+			err = apiGatewayClient.DeleteBasePathMapping(tt.args.ctx, tt.args.domainName, tt.args.basePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApiGateway_DeleteDomainName(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete domain name successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDomainNameMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.DeleteDomainNameOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain name failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDomainNameErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.DeleteDomainNameOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteDomainNameError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("example.com"),
+				Err:          fmt.Errorf("operation error API Gateway: DeleteDomainName, DeleteDomainNameError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigateway.NewFromConfig(cfg)
+			apiGatewayClient := NewApiGateway(client)
+
+			err = apiGatewayClient.DeleteDomainName(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApiGateway_GetBasePathMappings(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.BasePathMapping
+		wantErr bool
+	}{
+		{
+			name: "get mappings successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetBasePathMappingsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.GetBasePathMappingsOutput{
+										Items: []types.BasePathMapping{
+											{
+												BasePath: aws.String("(none)"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.BasePathMapping{
+				{
+					BasePath: aws.String("(none)"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get mappings with next token successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetBasePathMappingsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForApiGateway{}).(*string)
+
+								var nextToken *string
+								var items []types.BasePathMapping
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.BasePathMapping{
+										{
+											BasePath: aws.String("(none)"),
+										},
+									}
+								} else {
+									items = []types.BasePathMapping{
+										{
+											BasePath: aws.String("v1"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &apigateway.GetBasePathMappingsOutput{
+										Items:    items,
+										Position: nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.BasePathMapping{
+				{
+					BasePath: aws.String("(none)"),
+				},
+				{
+					BasePath: aws.String("v1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get mappings failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetBasePathMappingsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.GetBasePathMappingsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetBasePathMappingsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigateway.NewFromConfig(cfg, func(o *apigateway.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForApiGatewayInitialize), middleware.Before)
+				})
+			})
+			apiGatewayClient := NewApiGateway(client)
+
+			output, err := apiGatewayClient.GetBasePathMappings(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestApiGateway_CheckDomainNameExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check domain name exists successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDomainNameMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.GetDomainNameOutput{
+										DomainName: aws.String("example.com"),
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check domain name not exists successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDomainNameNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.GetDomainNameOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("NotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check domain name exists failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDomainNameErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigateway.GetDomainNameOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetDomainNameError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigateway.NewFromConfig(cfg)
+			apiGatewayClient := NewApiGateway(client)
+
+			output, err := apiGatewayClient.CheckDomainNameExists(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+//go:generate mockgen -source=$GOFILE -destination=apigatewayv2_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+var SleepTimeSecForApiGatewayV2 = 10
+
+type IApiGatewayV2 interface {
+	GetApiMappings(ctx context.Context, domainName *string) ([]types.ApiMapping, error)
+	DeleteApiMapping(ctx context.Context, domainName *string, apiMappingId *string) error
+	DeleteDomainName(ctx context.Context, domainName *string) error
+	CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error)
+}
+
+var _ IApiGatewayV2 = (*ApiGatewayV2)(nil)
+
+type ApiGatewayV2 struct {
+	client  *apigatewayv2.Client
+	retryer *Retryer
+}
+
+func NewApiGatewayV2(client *apigatewayv2.Client) *ApiGatewayV2 {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "TooManyRequestsException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForApiGatewayV2)
+
+	return &ApiGatewayV2{
+		client,
+		retryer,
+	}
+}
+
+func (a *ApiGatewayV2) GetApiMappings(ctx context.Context, domainName *string) ([]types.ApiMapping, error) {
+	var nextToken *string
+	apiMappings := []types.ApiMapping{}
+
+	optFn := func(o *apigatewayv2.Options) {
+		o.Retryer = a.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return apiMappings, &ClientError{
+				ResourceName: domainName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &apigatewayv2.GetApiMappingsInput{
+			DomainName: domainName,
+			NextToken:  nextToken,
+		}
+
+		output, err := a.client.GetApiMappings(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: domainName,
+				Err:          err,
+			}
+		}
+		apiMappings = append(apiMappings, output.Items...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return apiMappings, nil
+}
+
+func (a *ApiGatewayV2) DeleteApiMapping(ctx context.Context, domainName *string, apiMappingId *string) error {
+	input := &apigatewayv2.DeleteApiMappingInput{
+		DomainName:   domainName,
+		ApiMappingId: apiMappingId,
+	}
+
+	optFn := func(o *apigatewayv2.Options) {
+		o.Retryer = a.retryer
+	}
+
+	_, err := a.client.DeleteApiMapping(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (a *ApiGatewayV2) DeleteDomainName(ctx context.Context, domainName *string) error {
+	input := &apigatewayv2.DeleteDomainNameInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(o *apigatewayv2.Options) {
+		o.Retryer = a.retryer
+	}
+
+	_, err := a.client.DeleteDomainName(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (a *ApiGatewayV2) CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error) {
+	input := &apigatewayv2.GetDomainNameInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(o *apigatewayv2.Options) {
+		o.Retryer = a.retryer
+	}
+
+	_, err := a.client.GetDomainName(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "NotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
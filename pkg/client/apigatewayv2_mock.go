@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: apigatewayv2.go
+//
+// Generated by this command:
+//
+//	mockgen -source=apigatewayv2.go -destination=apigatewayv2_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIApiGatewayV2 is a mock of IApiGatewayV2 interface.
+type MockIApiGatewayV2 struct {
+	ctrl     *gomock.Controller
+	recorder *MockIApiGatewayV2MockRecorder
+	isgomock struct{}
+}
+
+// MockIApiGatewayV2MockRecorder is the mock recorder for MockIApiGatewayV2.
+type MockIApiGatewayV2MockRecorder struct {
+	mock *MockIApiGatewayV2
+}
+
+// NewMockIApiGatewayV2 creates a new mock instance.
+func NewMockIApiGatewayV2(ctrl *gomock.Controller) *MockIApiGatewayV2 {
+	mock := &MockIApiGatewayV2{ctrl: ctrl}
+	mock.recorder = &MockIApiGatewayV2MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIApiGatewayV2) EXPECT() *MockIApiGatewayV2MockRecorder {
+	return m.recorder
+}
+
+// CheckDomainNameExists mocks base method.
+func (m *MockIApiGatewayV2) CheckDomainNameExists(ctx context.Context, domainName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDomainNameExists", ctx, domainName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDomainNameExists indicates an expected call of CheckDomainNameExists.
+func (mr *MockIApiGatewayV2MockRecorder) CheckDomainNameExists(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDomainNameExists", reflect.TypeOf((*MockIApiGatewayV2)(nil).CheckDomainNameExists), ctx, domainName)
+}
+
+// DeleteApiMapping mocks base method.
+func (m *MockIApiGatewayV2) DeleteApiMapping(ctx context.Context, domainName, apiMappingId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiMapping", ctx, domainName, apiMappingId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApiMapping indicates an expected call of DeleteApiMapping.
+func (mr *MockIApiGatewayV2MockRecorder) DeleteApiMapping(ctx, domainName, apiMappingId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiMapping", reflect.TypeOf((*MockIApiGatewayV2)(nil).DeleteApiMapping), ctx, domainName, apiMappingId)
+}
+
+// DeleteDomainName mocks base method.
+func (m *MockIApiGatewayV2) DeleteDomainName(ctx context.Context, domainName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainName", ctx, domainName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomainName indicates an expected call of DeleteDomainName.
+func (mr *MockIApiGatewayV2MockRecorder) DeleteDomainName(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainName", reflect.TypeOf((*MockIApiGatewayV2)(nil).DeleteDomainName), ctx, domainName)
+}
+
+// GetApiMappings mocks base method.
+func (m *MockIApiGatewayV2) GetApiMappings(ctx context.Context, domainName *string) ([]types.ApiMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiMappings", ctx, domainName)
+	ret0, _ := ret[0].([]types.ApiMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiMappings indicates an expected call of GetApiMappings.
+func (mr *MockIApiGatewayV2MockRecorder) GetApiMappings(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiMappings", reflect.TypeOf((*MockIApiGatewayV2)(nil).GetApiMappings), ctx, domainName)
+}
@@ -0,0 +1,469 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type tokenKeyForApiGatewayV2 struct{}
+
+func getNextTokenForApiGatewayV2Initialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	if v, ok := in.Parameters.(*apigatewayv2.GetApiMappingsInput); ok {
+		ctx = middleware.WithStackValue(ctx, tokenKeyForApiGatewayV2{}, v.NextToken)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+/*
+	Test Cases
+*/
+
+func TestApiGatewayV2_DeleteApiMapping(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		apiMappingId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete api mapping successfully",
+			args: args{
+				ctx:          context.Background(),
+				domainName:   aws.String("example.com"),
+				apiMappingId: aws.String("abc123"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteApiMappingMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.DeleteApiMappingOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete api mapping failure",
+			args: args{
+				ctx:          context.Background(),
+				domainName:   aws.String("example.com"),
+				apiMappingId: aws.String("abc123"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteApiMappingErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.DeleteApiMappingOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteApiMappingError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("example.com"),
+				Err:          fmt.Errorf("operation error ApiGatewayV2: DeleteApiMapping, DeleteApiMappingError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigatewayv2.NewFromConfig(cfg)
+			apiGatewayV2Client := NewApiGatewayV2(client)
+
+			err = apiGatewayV2Client.DeleteApiMapping(tt.args.ctx, tt.args.domainName, tt.args.apiMappingId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApiGatewayV2_DeleteDomainName(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete domain name successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDomainNameMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.DeleteDomainNameOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain name failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDomainNameErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.DeleteDomainNameOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteDomainNameError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("example.com"),
+				Err:          fmt.Errorf("operation error ApiGatewayV2: DeleteDomainName, DeleteDomainNameError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigatewayv2.NewFromConfig(cfg)
+			apiGatewayV2Client := NewApiGatewayV2(client)
+
+			err = apiGatewayV2Client.DeleteDomainName(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApiGatewayV2_GetApiMappings(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.ApiMapping
+		wantErr bool
+	}{
+		{
+			name: "get mappings successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetApiMappingsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.GetApiMappingsOutput{
+										Items: []types.ApiMapping{
+											{
+												ApiMappingId: aws.String("abc123"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.ApiMapping{
+				{
+					ApiMappingId: aws.String("abc123"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get mappings with next token successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetApiMappingsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForApiGatewayV2{}).(*string)
+
+								var nextToken *string
+								var items []types.ApiMapping
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.ApiMapping{
+										{
+											ApiMappingId: aws.String("abc123"),
+										},
+									}
+								} else {
+									items = []types.ApiMapping{
+										{
+											ApiMappingId: aws.String("def456"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.GetApiMappingsOutput{
+										Items:     items,
+										NextToken: nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.ApiMapping{
+				{
+					ApiMappingId: aws.String("abc123"),
+				},
+				{
+					ApiMappingId: aws.String("def456"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get mappings failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetApiMappingsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.GetApiMappingsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetApiMappingsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigatewayv2.NewFromConfig(cfg, func(o *apigatewayv2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForApiGatewayV2Initialize), middleware.Before)
+				})
+			})
+			apiGatewayV2Client := NewApiGatewayV2(client)
+
+			output, err := apiGatewayV2Client.GetApiMappings(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestApiGatewayV2_CheckDomainNameExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check domain name exists successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDomainNameMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.GetDomainNameOutput{
+										DomainName: aws.String("example.com"),
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check domain name not exists successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDomainNameNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.GetDomainNameOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("NotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check domain name exists failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("example.com"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDomainNameErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &apigatewayv2.GetDomainNameOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetDomainNameError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := apigatewayv2.NewFromConfig(cfg)
+			apiGatewayV2Client := NewApiGatewayV2(client)
+
+			output, err := apiGatewayV2Client.CheckDomainNameExists(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
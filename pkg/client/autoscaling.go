@@ -0,0 +1,61 @@
+//go:generate mockgen -source=$GOFILE -destination=autoscaling_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+)
+
+type IAutoScaling interface {
+	SuspendProcesses(ctx context.Context, autoScalingGroupName *string, scalingProcesses []string) error
+	ScaleToZero(ctx context.Context, autoScalingGroupName *string) error
+}
+
+var _ IAutoScaling = (*AutoScaling)(nil)
+
+type AutoScaling struct {
+	client *autoscaling.Client
+}
+
+func NewAutoScaling(client *autoscaling.Client) *AutoScaling {
+	return &AutoScaling{
+		client,
+	}
+}
+
+func (a *AutoScaling) SuspendProcesses(ctx context.Context, autoScalingGroupName *string, scalingProcesses []string) error {
+	input := &autoscaling.SuspendProcessesInput{
+		AutoScalingGroupName: autoScalingGroupName,
+		ScalingProcesses:     scalingProcesses,
+	}
+
+	_, err := a.client.SuspendProcesses(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: autoScalingGroupName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+// ScaleToZero sets the minimum size and the desired capacity of the group to 0, so that all the
+// instances start terminating.
+func (a *AutoScaling) ScaleToZero(ctx context.Context, autoScalingGroupName *string) error {
+	input := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: autoScalingGroupName,
+		MinSize:              aws.Int32(0),
+		DesiredCapacity:      aws.Int32(0),
+	}
+
+	_, err := a.client.UpdateAutoScalingGroup(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: autoScalingGroupName,
+			Err:          err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: autoscaling.go
+//
+// Generated by this command:
+//
+//	mockgen -source=autoscaling.go -destination=autoscaling_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAutoScaling is a mock of IAutoScaling interface.
+type MockIAutoScaling struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAutoScalingMockRecorder
+	isgomock struct{}
+}
+
+// MockIAutoScalingMockRecorder is the mock recorder for MockIAutoScaling.
+type MockIAutoScalingMockRecorder struct {
+	mock *MockIAutoScaling
+}
+
+// NewMockIAutoScaling creates a new mock instance.
+func NewMockIAutoScaling(ctrl *gomock.Controller) *MockIAutoScaling {
+	mock := &MockIAutoScaling{ctrl: ctrl}
+	mock.recorder = &MockIAutoScalingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAutoScaling) EXPECT() *MockIAutoScalingMockRecorder {
+	return m.recorder
+}
+
+// ScaleToZero mocks base method.
+func (m *MockIAutoScaling) ScaleToZero(ctx context.Context, autoScalingGroupName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleToZero", ctx, autoScalingGroupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleToZero indicates an expected call of ScaleToZero.
+func (mr *MockIAutoScalingMockRecorder) ScaleToZero(ctx, autoScalingGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleToZero", reflect.TypeOf((*MockIAutoScaling)(nil).ScaleToZero), ctx, autoScalingGroupName)
+}
+
+// SuspendProcesses mocks base method.
+func (m *MockIAutoScaling) SuspendProcesses(ctx context.Context, autoScalingGroupName *string, scalingProcesses []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendProcesses", ctx, autoScalingGroupName, scalingProcesses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendProcesses indicates an expected call of SuspendProcesses.
+func (mr *MockIAutoScalingMockRecorder) SuspendProcesses(ctx, autoScalingGroupName, scalingProcesses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProcesses", reflect.TypeOf((*MockIAutoScaling)(nil).SuspendProcesses), ctx, autoScalingGroupName, scalingProcesses)
+}
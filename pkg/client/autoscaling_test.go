@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/smithy-go/middleware"
+)
+
+/*
+	Test Cases
+*/
+
+func TestAutoScaling_SuspendProcesses(t *testing.T) {
+	type args struct {
+		ctx                  context.Context
+		autoScalingGroupName *string
+		scalingProcesses     []string
+		withAPIOptionsFunc   func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "suspend processes successfully",
+			args: args{
+				ctx:                  context.Background(),
+				autoScalingGroupName: aws.String("test"),
+				scalingProcesses:     []string{"Launch"},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"SuspendProcessesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &autoscaling.SuspendProcessesOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "suspend processes failure",
+			args: args{
+				ctx:                  context.Background(),
+				autoScalingGroupName: aws.String("test"),
+				scalingProcesses:     []string{"Launch"},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"SuspendProcessesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &autoscaling.SuspendProcessesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("SuspendProcessesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error Auto Scaling: SuspendProcesses, SuspendProcessesError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := autoscaling.NewFromConfig(cfg)
+			autoScalingClient := NewAutoScaling(client)
+
+			err = autoScalingClient.SuspendProcesses(tt.args.ctx, tt.args.autoScalingGroupName, tt.args.scalingProcesses)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoScaling_ScaleToZero(t *testing.T) {
+	type args struct {
+		ctx                  context.Context
+		autoScalingGroupName *string
+		withAPIOptionsFunc   func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "scale to zero successfully",
+			args: args{
+				ctx:                  context.Background(),
+				autoScalingGroupName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"UpdateAutoScalingGroupMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &autoscaling.UpdateAutoScalingGroupOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "scale to zero failure",
+			args: args{
+				ctx:                  context.Background(),
+				autoScalingGroupName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"UpdateAutoScalingGroupErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &autoscaling.UpdateAutoScalingGroupOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("UpdateAutoScalingGroupError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error Auto Scaling: UpdateAutoScalingGroup, UpdateAutoScalingGroupError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := autoscaling.NewFromConfig(cfg)
+			autoScalingClient := NewAutoScaling(client)
+
+			err = autoScalingClient.ScaleToZero(tt.args.ctx, tt.args.autoScalingGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
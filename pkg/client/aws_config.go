@@ -9,6 +9,9 @@ import (
 
 const DefaultAwsRegion = "us-east-1"
 
+// SDKRetryMaxAttempts is the maximum number of attempts of the SDK clients created by delstack.
+const SDKRetryMaxAttempts = 3
+
 func LoadAWSConfig(ctx context.Context, region string, profile string) (aws.Config, error) {
 	var (
 		cfg aws.Config
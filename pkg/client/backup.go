@@ -5,6 +5,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	"github.com/aws/aws-sdk-go-v2/service/backup/types"
 )
@@ -14,6 +15,12 @@ type IBackup interface {
 	DeleteRecoveryPoints(ctx context.Context, backupVaultName *string, recoveryPoints []types.RecoveryPointByBackupVault) error
 	DeleteBackupVault(ctx context.Context, backupVaultName *string) error
 	CheckBackupVaultExists(ctx context.Context, backupVaultName *string) (bool, error)
+	DescribeBackupVault(ctx context.Context, backupVaultName *string) (*backup.DescribeBackupVaultOutput, error)
+	DeleteBackupVaultLockConfiguration(ctx context.Context, backupVaultName *string) error
+	ListActiveBackupJobs(ctx context.Context, backupVaultName *string) ([]types.BackupJob, error)
+	StopBackupJob(ctx context.Context, backupJobId *string) error
+	ListActiveCopyJobs(ctx context.Context, backupVaultArn *string, recoveryPointArns []*string) ([]types.CopyJob, error)
+	ListActiveRestoreJobs(ctx context.Context, recoveryPointArns []*string) ([]types.RestoreJobsListMember, error)
 }
 
 var _ IBackup = (*Backup)(nil)
@@ -150,3 +157,239 @@ func (b *Backup) CheckBackupVaultExists(ctx context.Context, backupVaultName *st
 
 	return false, nil
 }
+
+func (b *Backup) DescribeBackupVault(ctx context.Context, backupVaultName *string) (*backup.DescribeBackupVaultOutput, error) {
+	input := &backup.DescribeBackupVaultInput{
+		BackupVaultName: backupVaultName,
+	}
+
+	output, err := b.client.DescribeBackupVault(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: backupVaultName,
+			Err:          err,
+		}
+	}
+	return output, nil
+}
+
+func (b *Backup) DeleteBackupVaultLockConfiguration(ctx context.Context, backupVaultName *string) error {
+	input := &backup.DeleteBackupVaultLockConfigurationInput{
+		BackupVaultName: backupVaultName,
+	}
+
+	_, err := b.client.DeleteBackupVaultLockConfiguration(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: backupVaultName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+// ListActiveBackupJobs returns the backup jobs for the vault that have not finished yet,
+// including jobs that are being aborted.
+func (b *Backup) ListActiveBackupJobs(ctx context.Context, backupVaultName *string) ([]types.BackupJob, error) {
+	activeStates := []types.BackupJobState{
+		types.BackupJobStateCreated,
+		types.BackupJobStatePending,
+		types.BackupJobStateRunning,
+		types.BackupJobStateAborting,
+	}
+
+	backupJobs := []types.BackupJob{}
+	for _, state := range activeStates {
+		var nextToken *string
+
+		for {
+			select {
+			case <-ctx.Done():
+				return backupJobs, &ClientError{
+					ResourceName: backupVaultName,
+					Err:          ctx.Err(),
+				}
+			default:
+			}
+
+			input := &backup.ListBackupJobsInput{
+				ByBackupVaultName: backupVaultName,
+				ByState:           state,
+				NextToken:         nextToken,
+			}
+
+			output, err := b.client.ListBackupJobs(ctx, input)
+			if err != nil {
+				return nil, &ClientError{
+					ResourceName: backupVaultName,
+					Err:          err,
+				}
+			}
+			backupJobs = append(backupJobs, output.BackupJobs...)
+
+			nextToken = output.NextToken
+			if nextToken == nil {
+				break
+			}
+		}
+	}
+
+	return backupJobs, nil
+}
+
+func (b *Backup) StopBackupJob(ctx context.Context, backupJobId *string) error {
+	input := &backup.StopBackupJobInput{
+		BackupJobId: backupJobId,
+	}
+
+	_, err := b.client.StopBackupJob(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: backupJobId,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+// ListActiveCopyJobs returns the unfinished copy jobs into the vault and from the recovery points in
+// it. The ones into the vault are filtered by the destination vault on the server side. The ones from
+// the recovery points are filtered from the active copy jobs in the account, since filtering them on
+// the server side takes calls for each recovery point. Copy jobs cannot be stopped, so callers can
+// only wait for them to finish.
+func (b *Backup) ListActiveCopyJobs(ctx context.Context, backupVaultArn *string, recoveryPointArns []*string) ([]types.CopyJob, error) {
+	copyJobs, err := b.listActiveCopyJobs(ctx, backupVaultArn, backup.ListCopyJobsInput{
+		ByDestinationVaultArn: backupVaultArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recoveryPointArns) == 0 {
+		return copyJobs, nil
+	}
+
+	isSource := map[string]struct{}{}
+	for _, recoveryPointArn := range recoveryPointArns {
+		isSource[aws.ToString(recoveryPointArn)] = struct{}{}
+	}
+
+	accountCopyJobs, err := b.listActiveCopyJobs(ctx, backupVaultArn, backup.ListCopyJobsInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, copyJob := range accountCopyJobs {
+		if _, ok := isSource[aws.ToString(copyJob.SourceRecoveryPointArn)]; !ok {
+			continue
+		}
+		// The copy jobs into the vault itself are already listed.
+		if aws.ToString(copyJob.DestinationBackupVaultArn) == aws.ToString(backupVaultArn) {
+			continue
+		}
+		copyJobs = append(copyJobs, copyJob)
+	}
+
+	return copyJobs, nil
+}
+
+func (b *Backup) listActiveCopyJobs(ctx context.Context, resourceName *string, filter backup.ListCopyJobsInput) ([]types.CopyJob, error) {
+	activeStates := []types.CopyJobState{
+		types.CopyJobStateCreated,
+		types.CopyJobStateRunning,
+	}
+
+	copyJobs := []types.CopyJob{}
+	for _, state := range activeStates {
+		var nextToken *string
+
+		for {
+			select {
+			case <-ctx.Done():
+				return copyJobs, &ClientError{
+					ResourceName: resourceName,
+					Err:          ctx.Err(),
+				}
+			default:
+			}
+
+			input := filter
+			input.ByState = state
+			input.NextToken = nextToken
+
+			output, err := b.client.ListCopyJobs(ctx, &input)
+			if err != nil {
+				return nil, &ClientError{
+					ResourceName: resourceName,
+					Err:          err,
+				}
+			}
+			copyJobs = append(copyJobs, output.CopyJobs...)
+
+			nextToken = output.NextToken
+			if nextToken == nil {
+				break
+			}
+		}
+	}
+
+	return copyJobs, nil
+}
+
+// ListActiveRestoreJobs returns the unfinished restore jobs from the recovery points. Restore jobs
+// cannot be filtered by the vault nor by the recovery point on the server side, so the active ones
+// in the account are filtered here. They cannot be stopped, so callers can only wait for them to
+// finish.
+func (b *Backup) ListActiveRestoreJobs(ctx context.Context, recoveryPointArns []*string) ([]types.RestoreJobsListMember, error) {
+	activeStatuses := []types.RestoreJobStatus{
+		types.RestoreJobStatusPending,
+		types.RestoreJobStatusRunning,
+	}
+
+	isTarget := map[string]struct{}{}
+	for _, recoveryPointArn := range recoveryPointArns {
+		isTarget[aws.ToString(recoveryPointArn)] = struct{}{}
+	}
+
+	restoreJobs := []types.RestoreJobsListMember{}
+	if len(isTarget) == 0 {
+		return restoreJobs, nil
+	}
+
+	for _, status := range activeStatuses {
+		var nextToken *string
+
+		for {
+			select {
+			case <-ctx.Done():
+				return restoreJobs, &ClientError{
+					Err: ctx.Err(),
+				}
+			default:
+			}
+
+			input := &backup.ListRestoreJobsInput{
+				ByStatus:  status,
+				NextToken: nextToken,
+			}
+
+			output, err := b.client.ListRestoreJobs(ctx, input)
+			if err != nil {
+				return nil, &ClientError{
+					Err: err,
+				}
+			}
+			for _, restoreJob := range output.RestoreJobs {
+				if _, ok := isTarget[aws.ToString(restoreJob.RecoveryPointArn)]; ok {
+					restoreJobs = append(restoreJobs, restoreJob)
+				}
+			}
+
+			nextToken = output.NextToken
+			if nextToken == nil {
+				break
+			}
+		}
+	}
+
+	return restoreJobs, nil
+}
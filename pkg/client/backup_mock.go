@@ -12,6 +12,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	backup "github.com/aws/aws-sdk-go-v2/service/backup"
 	types "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -69,6 +70,20 @@ func (mr *MockIBackupMockRecorder) DeleteBackupVault(ctx, backupVaultName any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupVault", reflect.TypeOf((*MockIBackup)(nil).DeleteBackupVault), ctx, backupVaultName)
 }
 
+// DeleteBackupVaultLockConfiguration mocks base method.
+func (m *MockIBackup) DeleteBackupVaultLockConfiguration(ctx context.Context, backupVaultName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupVaultLockConfiguration", ctx, backupVaultName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBackupVaultLockConfiguration indicates an expected call of DeleteBackupVaultLockConfiguration.
+func (mr *MockIBackupMockRecorder) DeleteBackupVaultLockConfiguration(ctx, backupVaultName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupVaultLockConfiguration", reflect.TypeOf((*MockIBackup)(nil).DeleteBackupVaultLockConfiguration), ctx, backupVaultName)
+}
+
 // DeleteRecoveryPoints mocks base method.
 func (m *MockIBackup) DeleteRecoveryPoints(ctx context.Context, backupVaultName *string, recoveryPoints []types.RecoveryPointByBackupVault) error {
 	m.ctrl.T.Helper()
@@ -83,6 +98,66 @@ func (mr *MockIBackupMockRecorder) DeleteRecoveryPoints(ctx, backupVaultName, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryPoints", reflect.TypeOf((*MockIBackup)(nil).DeleteRecoveryPoints), ctx, backupVaultName, recoveryPoints)
 }
 
+// DescribeBackupVault mocks base method.
+func (m *MockIBackup) DescribeBackupVault(ctx context.Context, backupVaultName *string) (*backup.DescribeBackupVaultOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBackupVault", ctx, backupVaultName)
+	ret0, _ := ret[0].(*backup.DescribeBackupVaultOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBackupVault indicates an expected call of DescribeBackupVault.
+func (mr *MockIBackupMockRecorder) DescribeBackupVault(ctx, backupVaultName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBackupVault", reflect.TypeOf((*MockIBackup)(nil).DescribeBackupVault), ctx, backupVaultName)
+}
+
+// ListActiveBackupJobs mocks base method.
+func (m *MockIBackup) ListActiveBackupJobs(ctx context.Context, backupVaultName *string) ([]types.BackupJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveBackupJobs", ctx, backupVaultName)
+	ret0, _ := ret[0].([]types.BackupJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveBackupJobs indicates an expected call of ListActiveBackupJobs.
+func (mr *MockIBackupMockRecorder) ListActiveBackupJobs(ctx, backupVaultName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveBackupJobs", reflect.TypeOf((*MockIBackup)(nil).ListActiveBackupJobs), ctx, backupVaultName)
+}
+
+// ListActiveCopyJobs mocks base method.
+func (m *MockIBackup) ListActiveCopyJobs(ctx context.Context, backupVaultArn *string, recoveryPointArns []*string) ([]types.CopyJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveCopyJobs", ctx, backupVaultArn, recoveryPointArns)
+	ret0, _ := ret[0].([]types.CopyJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveCopyJobs indicates an expected call of ListActiveCopyJobs.
+func (mr *MockIBackupMockRecorder) ListActiveCopyJobs(ctx, backupVaultArn, recoveryPointArns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveCopyJobs", reflect.TypeOf((*MockIBackup)(nil).ListActiveCopyJobs), ctx, backupVaultArn, recoveryPointArns)
+}
+
+// ListActiveRestoreJobs mocks base method.
+func (m *MockIBackup) ListActiveRestoreJobs(ctx context.Context, recoveryPointArns []*string) ([]types.RestoreJobsListMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveRestoreJobs", ctx, recoveryPointArns)
+	ret0, _ := ret[0].([]types.RestoreJobsListMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveRestoreJobs indicates an expected call of ListActiveRestoreJobs.
+func (mr *MockIBackupMockRecorder) ListActiveRestoreJobs(ctx, recoveryPointArns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveRestoreJobs", reflect.TypeOf((*MockIBackup)(nil).ListActiveRestoreJobs), ctx, recoveryPointArns)
+}
+
 // ListRecoveryPointsByBackupVault mocks base method.
 func (m *MockIBackup) ListRecoveryPointsByBackupVault(ctx context.Context, backupVaultName *string) ([]types.RecoveryPointByBackupVault, error) {
 	m.ctrl.T.Helper()
@@ -97,3 +172,17 @@ func (mr *MockIBackupMockRecorder) ListRecoveryPointsByBackupVault(ctx, backupVa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecoveryPointsByBackupVault", reflect.TypeOf((*MockIBackup)(nil).ListRecoveryPointsByBackupVault), ctx, backupVaultName)
 }
+
+// StopBackupJob mocks base method.
+func (m *MockIBackup) StopBackupJob(ctx context.Context, backupJobId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopBackupJob", ctx, backupJobId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopBackupJob indicates an expected call of StopBackupJob.
+func (mr *MockIBackupMockRecorder) StopBackupJob(ctx, backupJobId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBackupJob", reflect.TypeOf((*MockIBackup)(nil).StopBackupJob), ctx, backupJobId)
+}
@@ -29,6 +29,40 @@ func getNextTokenForBackupInitialize(
 	return next.HandleInitialize(ctx, in)
 }
 
+type backupJobStateKeyForBackup struct{}
+
+func getBackupJobStateForBackupInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	if v, ok := in.Parameters.(*backup.ListBackupJobsInput); ok {
+		ctx = middleware.WithStackValue(ctx, backupJobStateKeyForBackup{}, v.ByState)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+type copyJobFilterKeyForBackup struct{}
+
+type copyJobFilterForBackup struct {
+	destinationVaultArn    string
+	sourceRecoveryPointArn string
+}
+
+func getCopyJobFilterForBackupInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	if v, ok := in.Parameters.(*backup.ListCopyJobsInput); ok {
+		ctx = middleware.WithStackValue(ctx, copyJobFilterKeyForBackup{}, copyJobFilterForBackup{
+			destinationVaultArn:    aws.ToString(v.ByDestinationVaultArn),
+			sourceRecoveryPointArn: aws.ToString(v.BySourceRecoveryPointArn),
+		})
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
 /*
 	Test Cases
 */
@@ -870,3 +904,689 @@ func TestBackup_CheckBackupVaultExists(t *testing.T) {
 		})
 	}
 }
+
+func TestBackup_DescribeBackupVault(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		backupVaultName    *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    *backup.DescribeBackupVaultOutput
+		wantErr bool
+	}{
+		{
+			name: "describe backup vault successfully",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeBackupVaultMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.DescribeBackupVaultOutput{
+										BackupVaultName: aws.String("test"),
+										Locked:          aws.Bool(true),
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &backup.DescribeBackupVaultOutput{
+				BackupVaultName: aws.String("test"),
+				Locked:          aws.Bool(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe backup vault failure",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeBackupVaultErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.DescribeBackupVaultOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeBackupVaultError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := backup.NewFromConfig(cfg)
+			backupClient := NewBackup(client)
+
+			output, err := backupClient.DescribeBackupVault(tt.args.ctx, tt.args.backupVaultName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if aws.ToString(output.BackupVaultName) != aws.ToString(tt.want.BackupVaultName) ||
+				aws.ToBool(output.Locked) != aws.ToBool(tt.want.Locked) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackup_DeleteBackupVaultLockConfiguration(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		backupVaultName    *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete backup vault lock configuration successfully",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteBackupVaultLockConfigurationMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.DeleteBackupVaultLockConfigurationOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete backup vault lock configuration failure",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteBackupVaultLockConfigurationErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.DeleteBackupVaultLockConfigurationOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteBackupVaultLockConfigurationError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error Backup: DeleteBackupVaultLockConfiguration, DeleteBackupVaultLockConfigurationError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := backup.NewFromConfig(cfg)
+			backupClient := NewBackup(client)
+
+			err = backupClient.DeleteBackupVaultLockConfiguration(tt.args.ctx, tt.args.backupVaultName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackup_ListActiveBackupJobs(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		backupVaultName    *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.BackupJob
+		wantErr bool
+	}{
+		{
+			name: "list active backup jobs for every active state successfully",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListBackupJobsMock",
+							func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								state := middleware.GetStackValue(ctx, backupJobStateKeyForBackup{}).(types.BackupJobState)
+								if state != types.BackupJobStateRunning {
+									return middleware.FinalizeOutput{
+										Result: &backup.ListBackupJobsOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								return middleware.FinalizeOutput{
+									Result: &backup.ListBackupJobsOutput{
+										BackupJobs: []types.BackupJob{
+											{
+												BackupJobId: aws.String("BackupJobId1"),
+												State:       types.BackupJobStateRunning,
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.BackupJob{
+				{
+					BackupJobId: aws.String("BackupJobId1"),
+					State:       types.BackupJobStateRunning,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list active backup jobs failure",
+			args: args{
+				ctx:             context.Background(),
+				backupVaultName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListBackupJobsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.ListBackupJobsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListBackupJobsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := backup.NewFromConfig(cfg, func(o *backup.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetBackupJobState", getBackupJobStateForBackupInitialize), middleware.Before)
+				})
+			})
+			backupClient := NewBackup(client)
+
+			output, err := backupClient.ListActiveBackupJobs(tt.args.ctx, tt.args.backupVaultName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackup_StopBackupJob(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		backupJobId        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "stop backup job successfully",
+			args: args{
+				ctx:         context.Background(),
+				backupJobId: aws.String("BackupJobId1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"StopBackupJobMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.StopBackupJobOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "stop backup job failure",
+			args: args{
+				ctx:         context.Background(),
+				backupJobId: aws.String("BackupJobId1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"StopBackupJobErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.StopBackupJobOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("StopBackupJobError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("BackupJobId1"),
+				Err:          fmt.Errorf("operation error Backup: StopBackupJob, StopBackupJobError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := backup.NewFromConfig(cfg)
+			backupClient := NewBackup(client)
+
+			err = backupClient.StopBackupJob(tt.args.ctx, tt.args.backupJobId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackup_ListActiveCopyJobs(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		backupVaultArn     *string
+		recoveryPointArns  []*string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.CopyJob
+		wantErr bool
+	}{
+		{
+			name: "list active copy jobs into the vault and from its recovery points successfully",
+			args: args{
+				ctx:               context.Background(),
+				backupVaultArn:    aws.String("VaultArn"),
+				recoveryPointArns: []*string{aws.String("RecoveryPointArn1")},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetCopyJobFilter", getCopyJobFilterForBackupInitialize), middleware.Before)
+					if err != nil {
+						return err
+					}
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListCopyJobsMock",
+							func(ctx context.Context, input middleware.FinalizeInput, handler middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								filter := middleware.GetStackValue(ctx, copyJobFilterKeyForBackup{}).(copyJobFilterForBackup)
+
+								var output *backup.ListCopyJobsOutput
+								switch {
+								case filter.destinationVaultArn == "VaultArn":
+									output = &backup.ListCopyJobsOutput{
+										CopyJobs: []types.CopyJob{
+											{
+												CopyJobId:                 aws.String("CopyJobId1"),
+												SourceBackupVaultArn:      aws.String("OtherVaultArn"),
+												DestinationBackupVaultArn: aws.String("VaultArn"),
+											},
+										},
+									}
+								case filter == copyJobFilterForBackup{}:
+									output = &backup.ListCopyJobsOutput{
+										CopyJobs: []types.CopyJob{
+											{
+												CopyJobId:                 aws.String("CopyJobId1"),
+												SourceBackupVaultArn:      aws.String("OtherVaultArn"),
+												DestinationBackupVaultArn: aws.String("VaultArn"),
+											},
+											{
+												CopyJobId:                 aws.String("CopyJobId2"),
+												SourceBackupVaultArn:      aws.String("VaultArn"),
+												SourceRecoveryPointArn:    aws.String("RecoveryPointArn1"),
+												DestinationBackupVaultArn: aws.String("OtherVaultArn"),
+											},
+											{
+												CopyJobId:                 aws.String("CopyJobId3"),
+												SourceBackupVaultArn:      aws.String("OtherVaultArn"),
+												SourceRecoveryPointArn:    aws.String("OtherRecoveryPointArn"),
+												DestinationBackupVaultArn: aws.String("OtherVaultArn"),
+											},
+										},
+									}
+								default:
+									return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected filter: %#v", filter)
+								}
+								return middleware.FinalizeOutput{
+									Result: output,
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.CopyJob{
+				// Listed once for each of the CREATED and RUNNING states.
+				{
+					CopyJobId:                 aws.String("CopyJobId1"),
+					SourceBackupVaultArn:      aws.String("OtherVaultArn"),
+					DestinationBackupVaultArn: aws.String("VaultArn"),
+				},
+				{
+					CopyJobId:                 aws.String("CopyJobId1"),
+					SourceBackupVaultArn:      aws.String("OtherVaultArn"),
+					DestinationBackupVaultArn: aws.String("VaultArn"),
+				},
+				{
+					CopyJobId:                 aws.String("CopyJobId2"),
+					SourceBackupVaultArn:      aws.String("VaultArn"),
+					SourceRecoveryPointArn:    aws.String("RecoveryPointArn1"),
+					DestinationBackupVaultArn: aws.String("OtherVaultArn"),
+				},
+				{
+					CopyJobId:                 aws.String("CopyJobId2"),
+					SourceBackupVaultArn:      aws.String("VaultArn"),
+					SourceRecoveryPointArn:    aws.String("RecoveryPointArn1"),
+					DestinationBackupVaultArn: aws.String("OtherVaultArn"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list active copy jobs successfully when there are no recovery points",
+			args: args{
+				ctx:               context.Background(),
+				backupVaultArn:    aws.String("VaultArn"),
+				recoveryPointArns: []*string{},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListCopyJobsEmptyMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.ListCopyJobsOutput{
+										CopyJobs: []types.CopyJob{},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    []types.CopyJob{},
+			wantErr: false,
+		},
+		{
+			name: "list active copy jobs failure",
+			args: args{
+				ctx:               context.Background(),
+				backupVaultArn:    aws.String("VaultArn"),
+				recoveryPointArns: []*string{aws.String("RecoveryPointArn1")},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListCopyJobsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.ListCopyJobsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListCopyJobsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := backup.NewFromConfig(cfg)
+			backupClient := NewBackup(client)
+
+			output, err := backupClient.ListActiveCopyJobs(tt.args.ctx, tt.args.backupVaultArn, tt.args.recoveryPointArns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackup_ListActiveRestoreJobs(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		recoveryPointArns  []*string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.RestoreJobsListMember
+		wantErr bool
+	}{
+		{
+			name: "list active restore jobs from the recovery points successfully",
+			args: args{
+				ctx:               context.Background(),
+				recoveryPointArns: []*string{aws.String("RecoveryPointArn1")},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListRestoreJobsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.ListRestoreJobsOutput{
+										RestoreJobs: []types.RestoreJobsListMember{
+											{
+												RestoreJobId:     aws.String("RestoreJobId1"),
+												RecoveryPointArn: aws.String("RecoveryPointArn1"),
+											},
+											{
+												RestoreJobId:     aws.String("RestoreJobId2"),
+												RecoveryPointArn: aws.String("OtherRecoveryPointArn"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.RestoreJobsListMember{
+				// Listed once for each of the PENDING and RUNNING statuses.
+				{
+					RestoreJobId:     aws.String("RestoreJobId1"),
+					RecoveryPointArn: aws.String("RecoveryPointArn1"),
+				},
+				{
+					RestoreJobId:     aws.String("RestoreJobId1"),
+					RecoveryPointArn: aws.String("RecoveryPointArn1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list no restore jobs without calling the api when there are no recovery points",
+			args: args{
+				ctx:               context.Background(),
+				recoveryPointArns: []*string{},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListRestoreJobsUnexpectedMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.ListRestoreJobsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListRestoreJobsUnexpectedCall")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    []types.RestoreJobsListMember{},
+			wantErr: false,
+		},
+		{
+			name: "list active restore jobs failure",
+			args: args{
+				ctx:               context.Background(),
+				recoveryPointArns: []*string{aws.String("RecoveryPointArn1")},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListRestoreJobsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &backup.ListRestoreJobsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListRestoreJobsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := backup.NewFromConfig(cfg)
+			backupClient := NewBackup(client)
+
+			output, err := backupClient.ListActiveRestoreJobs(tt.args.ctx, tt.args.recoveryPointArns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
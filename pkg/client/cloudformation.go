@@ -17,6 +17,7 @@ type ICloudFormation interface {
 	DeleteStack(ctx context.Context, stackName *string, retainResources []string) error
 	DescribeStacks(ctx context.Context, stackName *string) ([]types.Stack, error)
 	ListStackResources(ctx context.Context, stackName *string) ([]types.StackResourceSummary, error)
+	DescribeStackEvents(ctx context.Context, stackName *string) ([]types.StackEvent, error)
 	GetTemplate(ctx context.Context, stackName *string) (*string, error)
 	UpdateStack(ctx context.Context, stackName *string, templateBody *string, parameters []types.Parameter) error
 	UpdateStackWithTemplateURL(ctx context.Context, stackName *string, templateURL *string, parameters []types.Parameter) error
@@ -145,6 +146,44 @@ func (c *CloudFormation) ListStackResources(ctx context.Context, stackName *stri
 	return stackResourceSummaries, nil
 }
 
+func (c *CloudFormation) DescribeStackEvents(ctx context.Context, stackName *string) ([]types.StackEvent, error) {
+	var nextToken *string
+	stackEvents := []types.StackEvent{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return stackEvents, &ClientError{
+				ResourceName: stackName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &cloudformation.DescribeStackEventsInput{
+			StackName: stackName,
+			NextToken: nextToken,
+		}
+
+		output, err := c.client.DescribeStackEvents(ctx, input)
+		if err != nil {
+			return stackEvents, &ClientError{
+				ResourceName: stackName,
+				Err:          err,
+			}
+		}
+
+		stackEvents = append(stackEvents, output.StackEvents...)
+		nextToken = output.NextToken
+
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return stackEvents, nil
+}
+
 func (c *CloudFormation) GetTemplate(ctx context.Context, stackName *string) (*string, error) {
 	input := &cloudformation.GetTemplateInput{
 		StackName: stackName,
@@ -26,6 +26,8 @@ func getNextTokenForCloudFormationInitialize(
 		ctx = middleware.WithStackValue(ctx, tokenKeyForCloudFormation{}, v.NextToken)
 	case *cloudformation.ListStackResourcesInput:
 		ctx = middleware.WithStackValue(ctx, tokenKeyForCloudFormation{}, v.NextToken)
+	case *cloudformation.DescribeStackEventsInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForCloudFormation{}, v.NextToken)
 	case *cloudformation.ListImportsInput:
 		ctx = middleware.WithStackValue(ctx, tokenKeyForCloudFormation{}, v.NextToken)
 	}
@@ -1014,6 +1016,164 @@ func TestCloudFormation_ListStackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DescribeStackEvents(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		stackName          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	type want struct {
+		output []types.StackEvent
+		err    error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    want
+		wantErr bool
+	}{
+		{
+			name: "describe stack events with next token successfully",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					err := stack.Initialize.Add(
+						middleware.InitializeMiddlewareFunc(
+							"GetNextToken",
+							getNextTokenForCloudFormationInitialize,
+						), middleware.Before,
+					)
+					if err != nil {
+						return err
+					}
+
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStackEventsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, handler middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForCloudFormation{}).(*string)
+
+								if token == nil {
+									return middleware.FinalizeOutput{
+										Result: &cloudformation.DescribeStackEventsOutput{
+											NextToken: aws.String("NextToken"),
+											StackEvents: []types.StackEvent{
+												{
+													EventId:            aws.String("EventId1"),
+													LogicalResourceId:  aws.String("LogicalResourceId1"),
+													PhysicalResourceId: aws.String("PhysicalResourceId1"),
+													ResourceStatus:     "CREATE_COMPLETE",
+												},
+											},
+										},
+									}, middleware.Metadata{}, nil
+								}
+								return middleware.FinalizeOutput{
+									Result: &cloudformation.DescribeStackEventsOutput{
+										StackEvents: []types.StackEvent{
+											{
+												EventId:            aws.String("EventId2"),
+												LogicalResourceId:  aws.String("LogicalResourceId2"),
+												PhysicalResourceId: aws.String("PhysicalResourceId2"),
+												ResourceStatus:     "CREATE_COMPLETE",
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: []types.StackEvent{
+					{
+						EventId:            aws.String("EventId1"),
+						LogicalResourceId:  aws.String("LogicalResourceId1"),
+						PhysicalResourceId: aws.String("PhysicalResourceId1"),
+						ResourceStatus:     "CREATE_COMPLETE",
+					},
+					{
+						EventId:            aws.String("EventId2"),
+						LogicalResourceId:  aws.String("LogicalResourceId2"),
+						PhysicalResourceId: aws.String("PhysicalResourceId2"),
+						ResourceStatus:     "CREATE_COMPLETE",
+					},
+				},
+				err: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe stack events failure",
+			args: args{
+				ctx:       context.Background(),
+				stackName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStackEventsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudformation.DescribeStackEventsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeStackEventsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: []types.StackEvent{},
+				err: &ClientError{
+					ResourceName: aws.String("test"),
+					Err:          fmt.Errorf("operation error CloudFormation: DescribeStackEvents, DescribeStackEventsError"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := cloudformation.NewFromConfig(cfg)
+			cfnDeleteWaiter := cloudformation.NewStackDeleteCompleteWaiter(client)
+			cfnUpdateWaiter := cloudformation.NewStackUpdateCompleteWaiter(client)
+			cfnClient := NewCloudFormation(
+				client,
+				cfnDeleteWaiter,
+				cfnUpdateWaiter,
+			)
+
+			output, err := cfnClient.DescribeStackEvents(tt.args.ctx, tt.args.stackName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.err.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+				return
+			}
+			if !reflect.DeepEqual(output, tt.want.output) {
+				t.Errorf("output = %#v, want %#v", output, tt.want.output)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_GetTemplate(t *testing.T) {
 	type args struct {
 		ctx                context.Context
@@ -0,0 +1,60 @@
+//go:generate mockgen -source=$GOFILE -destination=cloudfront_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+type ICloudFront interface {
+	GetDistributionConfig(ctx context.Context, distributionId *string) (*types.DistributionConfig, *string, error)
+	UpdateDistributionConfig(ctx context.Context, distributionId *string, distributionConfig *types.DistributionConfig, eTag *string) error
+}
+
+var _ ICloudFront = (*CloudFront)(nil)
+
+type CloudFront struct {
+	client *cloudfront.Client
+}
+
+func NewCloudFront(client *cloudfront.Client) *CloudFront {
+	return &CloudFront{
+		client,
+	}
+}
+
+// GetDistributionConfig returns the config of the distribution with its ETag,
+// which is required to update the distribution.
+func (c *CloudFront) GetDistributionConfig(ctx context.Context, distributionId *string) (*types.DistributionConfig, *string, error) {
+	input := &cloudfront.GetDistributionConfigInput{
+		Id: distributionId,
+	}
+
+	output, err := c.client.GetDistributionConfig(ctx, input)
+	if err != nil {
+		return nil, nil, &ClientError{
+			ResourceName: distributionId,
+			Err:          err,
+		}
+	}
+	return output.DistributionConfig, output.ETag, nil
+}
+
+func (c *CloudFront) UpdateDistributionConfig(ctx context.Context, distributionId *string, distributionConfig *types.DistributionConfig, eTag *string) error {
+	input := &cloudfront.UpdateDistributionInput{
+		Id:                 distributionId,
+		DistributionConfig: distributionConfig,
+		IfMatch:            eTag,
+	}
+
+	_, err := c.client.UpdateDistribution(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: distributionId,
+			Err:          err,
+		}
+	}
+	return nil
+}
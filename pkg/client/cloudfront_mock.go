@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cloudfront.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cloudfront.go -destination=cloudfront_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICloudFront is a mock of ICloudFront interface.
+type MockICloudFront struct {
+	ctrl     *gomock.Controller
+	recorder *MockICloudFrontMockRecorder
+	isgomock struct{}
+}
+
+// MockICloudFrontMockRecorder is the mock recorder for MockICloudFront.
+type MockICloudFrontMockRecorder struct {
+	mock *MockICloudFront
+}
+
+// NewMockICloudFront creates a new mock instance.
+func NewMockICloudFront(ctrl *gomock.Controller) *MockICloudFront {
+	mock := &MockICloudFront{ctrl: ctrl}
+	mock.recorder = &MockICloudFrontMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICloudFront) EXPECT() *MockICloudFrontMockRecorder {
+	return m.recorder
+}
+
+// GetDistributionConfig mocks base method.
+func (m *MockICloudFront) GetDistributionConfig(ctx context.Context, distributionId *string) (*types.DistributionConfig, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDistributionConfig", ctx, distributionId)
+	ret0, _ := ret[0].(*types.DistributionConfig)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDistributionConfig indicates an expected call of GetDistributionConfig.
+func (mr *MockICloudFrontMockRecorder) GetDistributionConfig(ctx, distributionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDistributionConfig", reflect.TypeOf((*MockICloudFront)(nil).GetDistributionConfig), ctx, distributionId)
+}
+
+// UpdateDistributionConfig mocks base method.
+func (m *MockICloudFront) UpdateDistributionConfig(ctx context.Context, distributionId *string, distributionConfig *types.DistributionConfig, eTag *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDistributionConfig", ctx, distributionId, distributionConfig, eTag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDistributionConfig indicates an expected call of UpdateDistributionConfig.
+func (mr *MockICloudFrontMockRecorder) UpdateDistributionConfig(ctx, distributionId, distributionConfig, eTag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDistributionConfig", reflect.TypeOf((*MockICloudFront)(nil).UpdateDistributionConfig), ctx, distributionId, distributionConfig, eTag)
+}
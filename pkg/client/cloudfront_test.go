@@ -0,0 +1,233 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+/*
+	Test Cases
+*/
+
+func TestCloudFront_GetDistributionConfig(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		distributionId     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "get distribution config successfully",
+			args: args{
+				ctx:            context.Background(),
+				distributionId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDistributionConfigMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudfront.GetDistributionConfigOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "get distribution config failure",
+			args: args{
+				ctx:            context.Background(),
+				distributionId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDistributionConfigErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudfront.GetDistributionConfigOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetDistributionConfigError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error CloudFront: GetDistributionConfig, GetDistributionConfigError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := cloudfront.NewFromConfig(cfg)
+			cloudFrontClient := NewCloudFront(client)
+
+			_, _, err = cloudFrontClient.GetDistributionConfig(tt.args.ctx, tt.args.distributionId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloudFront_UpdateDistributionConfig(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		distributionId     *string
+		distributionConfig *types.DistributionConfig
+		eTag               *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "update distribution config successfully",
+			args: args{
+				ctx:            context.Background(),
+				distributionId: aws.String("test"),
+				distributionConfig: &types.DistributionConfig{
+					CallerReference: aws.String("CallerReference"),
+					Comment:         aws.String(""),
+					DefaultCacheBehavior: &types.DefaultCacheBehavior{
+						TargetOriginId:       aws.String("Origin"),
+						ViewerProtocolPolicy: types.ViewerProtocolPolicyAllowAll,
+					},
+					Enabled: aws.Bool(false),
+					Origins: &types.Origins{
+						Quantity: aws.Int32(1),
+						Items: []types.Origin{
+							{
+								DomainName: aws.String("example.com"),
+								Id:         aws.String("Origin"),
+							},
+						},
+					},
+				},
+				eTag: aws.String("ETag"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"UpdateDistributionMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudfront.UpdateDistributionOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "update distribution config failure",
+			args: args{
+				ctx:            context.Background(),
+				distributionId: aws.String("test"),
+				distributionConfig: &types.DistributionConfig{
+					CallerReference: aws.String("CallerReference"),
+					Comment:         aws.String(""),
+					DefaultCacheBehavior: &types.DefaultCacheBehavior{
+						TargetOriginId:       aws.String("Origin"),
+						ViewerProtocolPolicy: types.ViewerProtocolPolicyAllowAll,
+					},
+					Enabled: aws.Bool(false),
+					Origins: &types.Origins{
+						Quantity: aws.Int32(1),
+						Items: []types.Origin{
+							{
+								DomainName: aws.String("example.com"),
+								Id:         aws.String("Origin"),
+							},
+						},
+					},
+				},
+				eTag: aws.String("ETag"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"UpdateDistributionErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudfront.UpdateDistributionOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("UpdateDistributionError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error CloudFront: UpdateDistribution, UpdateDistributionError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := cloudfront.NewFromConfig(cfg)
+			cloudFrontClient := NewCloudFront(client)
+
+			err = cloudFrontClient.UpdateDistributionConfig(tt.args.ctx, tt.args.distributionId, tt.args.distributionConfig, tt.args.eTag)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
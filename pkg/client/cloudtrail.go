@@ -0,0 +1,63 @@
+//go:generate mockgen -source=$GOFILE -destination=cloudtrail_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+)
+
+type ICloudTrail interface {
+	CheckEventDataStoreTerminationProtection(ctx context.Context, eventDataStoreArn *string) (bool, error)
+	DisableEventDataStoreTerminationProtection(ctx context.Context, eventDataStoreArn *string) error
+}
+
+var _ ICloudTrail = (*CloudTrail)(nil)
+
+type CloudTrail struct {
+	client *cloudtrail.Client
+}
+
+func NewCloudTrail(client *cloudtrail.Client) *CloudTrail {
+	return &CloudTrail{
+		client: client,
+	}
+}
+
+func (c *CloudTrail) CheckEventDataStoreTerminationProtection(ctx context.Context, eventDataStoreArn *string) (bool, error) {
+	input := &cloudtrail.GetEventDataStoreInput{
+		EventDataStore: eventDataStoreArn,
+	}
+
+	output, err := c.client.GetEventDataStore(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "EventDataStoreNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: eventDataStoreArn,
+			Err:          err,
+		}
+	}
+
+	return aws.ToBool(output.TerminationProtectionEnabled), nil
+}
+
+func (c *CloudTrail) DisableEventDataStoreTerminationProtection(ctx context.Context, eventDataStoreArn *string) error {
+	input := &cloudtrail.UpdateEventDataStoreInput{
+		EventDataStore:               eventDataStoreArn,
+		TerminationProtectionEnabled: aws.Bool(false),
+	}
+
+	_, err := c.client.UpdateEventDataStore(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: eventDataStoreArn,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
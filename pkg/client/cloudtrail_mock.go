@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cloudtrail.go
+//
+// Generated by this command:
+//
+//	mockgen -source=cloudtrail.go -destination=cloudtrail_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICloudTrail is a mock of ICloudTrail interface.
+type MockICloudTrail struct {
+	ctrl     *gomock.Controller
+	recorder *MockICloudTrailMockRecorder
+	isgomock struct{}
+}
+
+// MockICloudTrailMockRecorder is the mock recorder for MockICloudTrail.
+type MockICloudTrailMockRecorder struct {
+	mock *MockICloudTrail
+}
+
+// NewMockICloudTrail creates a new mock instance.
+func NewMockICloudTrail(ctrl *gomock.Controller) *MockICloudTrail {
+	mock := &MockICloudTrail{ctrl: ctrl}
+	mock.recorder = &MockICloudTrailMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICloudTrail) EXPECT() *MockICloudTrailMockRecorder {
+	return m.recorder
+}
+
+// CheckEventDataStoreTerminationProtection mocks base method.
+func (m *MockICloudTrail) CheckEventDataStoreTerminationProtection(ctx context.Context, eventDataStoreArn *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEventDataStoreTerminationProtection", ctx, eventDataStoreArn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEventDataStoreTerminationProtection indicates an expected call of CheckEventDataStoreTerminationProtection.
+func (mr *MockICloudTrailMockRecorder) CheckEventDataStoreTerminationProtection(ctx, eventDataStoreArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEventDataStoreTerminationProtection", reflect.TypeOf((*MockICloudTrail)(nil).CheckEventDataStoreTerminationProtection), ctx, eventDataStoreArn)
+}
+
+// DisableEventDataStoreTerminationProtection mocks base method.
+func (m *MockICloudTrail) DisableEventDataStoreTerminationProtection(ctx context.Context, eventDataStoreArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableEventDataStoreTerminationProtection", ctx, eventDataStoreArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableEventDataStoreTerminationProtection indicates an expected call of DisableEventDataStoreTerminationProtection.
+func (mr *MockICloudTrailMockRecorder) DisableEventDataStoreTerminationProtection(ctx, eventDataStoreArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableEventDataStoreTerminationProtection", reflect.TypeOf((*MockICloudTrail)(nil).DisableEventDataStoreTerminationProtection), ctx, eventDataStoreArn)
+}
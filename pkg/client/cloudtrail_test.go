@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/smithy-go/middleware"
+)
+
+/*
+	Test Cases
+*/
+
+func TestCloudTrail_CheckEventDataStoreTerminationProtection(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		eventDataStoreArn  *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check event data store termination protection successfully for enabled",
+			args: args{
+				ctx:               context.Background(),
+				eventDataStoreArn: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetEventDataStoreEnabledMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudtrail.GetEventDataStoreOutput{
+										TerminationProtectionEnabled: aws.Bool(true),
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check event data store termination protection successfully for disabled",
+			args: args{
+				ctx:               context.Background(),
+				eventDataStoreArn: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetEventDataStoreDisabledMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudtrail.GetEventDataStoreOutput{
+										TerminationProtectionEnabled: aws.Bool(false),
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check event data store termination protection successfully for not found",
+			args: args{
+				ctx:               context.Background(),
+				eventDataStoreArn: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetEventDataStoreNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudtrail.GetEventDataStoreOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("EventDataStoreNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check event data store termination protection failure",
+			args: args{
+				ctx:               context.Background(),
+				eventDataStoreArn: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetEventDataStoreErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudtrail.GetEventDataStoreOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetEventDataStoreError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := cloudtrail.NewFromConfig(cfg)
+			cloudTrailClient := NewCloudTrail(client)
+
+			output, err := cloudTrailClient.CheckEventDataStoreTerminationProtection(tt.args.ctx, tt.args.eventDataStoreArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestCloudTrail_DisableEventDataStoreTerminationProtection(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		eventDataStoreArn  *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "disable event data store termination protection successfully",
+			args: args{
+				ctx:               context.Background(),
+				eventDataStoreArn: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"UpdateEventDataStoreMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudtrail.UpdateEventDataStoreOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "disable event data store termination protection failure",
+			args: args{
+				ctx:               context.Background(),
+				eventDataStoreArn: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"UpdateEventDataStoreErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudtrail.UpdateEventDataStoreOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("UpdateEventDataStoreError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("arn:aws:cloudtrail:ap-northeast-1:123456789012:eventdatastore/test"),
+				Err:          fmt.Errorf("operation error CloudTrail: UpdateEventDataStore, UpdateEventDataStoreError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := cloudtrail.NewFromConfig(cfg)
+			cloudTrailClient := NewCloudTrail(client)
+
+			err = cloudTrailClient.DisableEventDataStoreTerminationProtection(tt.args.ctx, tt.args.eventDataStoreArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
@@ -6,11 +6,20 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
 type ICloudWatchLogs interface {
 	CheckLogGroupDeletionProtection(ctx context.Context, logGroupName *string) (bool, error)
 	DisableLogGroupDeletionProtection(ctx context.Context, logGroupName *string) error
+	CheckLogGroupExists(ctx context.Context, logGroupName *string) (bool, error)
+	DescribeSubscriptionFilters(ctx context.Context, logGroupName *string) ([]types.SubscriptionFilter, error)
+	DeleteSubscriptionFilter(ctx context.Context, logGroupName *string, filterName *string) error
+	DescribeMetricFilters(ctx context.Context, logGroupName *string) ([]types.MetricFilter, error)
+	DeleteMetricFilter(ctx context.Context, logGroupName *string, filterName *string) error
+	GetDataProtectionPolicy(ctx context.Context, logGroupName *string) (*string, error)
+	DeleteDataProtectionPolicy(ctx context.Context, logGroupName *string) error
+	DeleteLogGroup(ctx context.Context, logGroupName *string) error
 }
 
 var _ ICloudWatchLogs = (*CloudWatchLogs)(nil)
@@ -64,3 +73,205 @@ func (c *CloudWatchLogs) DisableLogGroupDeletionProtection(ctx context.Context,
 
 	return nil
 }
+
+func (c *CloudWatchLogs) CheckLogGroupExists(ctx context.Context, logGroupName *string) (bool, error) {
+	var nextToken *string
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, &ClientError{
+				ResourceName: logGroupName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &cloudwatchlogs.DescribeLogGroupsInput{
+			LogGroupNamePrefix: logGroupName,
+			NextToken:          nextToken,
+		}
+
+		output, err := c.client.DescribeLogGroups(ctx, input)
+		if err != nil {
+			return false, &ClientError{
+				ResourceName: logGroupName,
+				Err:          err,
+			}
+		}
+
+		// Find exact match since we used prefix filter
+		for _, lg := range output.LogGroups {
+			if aws.ToString(lg.LogGroupName) == aws.ToString(logGroupName) {
+				return true, nil
+			}
+		}
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return false, nil
+}
+
+func (c *CloudWatchLogs) DescribeSubscriptionFilters(ctx context.Context, logGroupName *string) ([]types.SubscriptionFilter, error) {
+	var nextToken *string
+	filters := []types.SubscriptionFilter{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return filters, &ClientError{
+				ResourceName: logGroupName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+			LogGroupName: logGroupName,
+			NextToken:    nextToken,
+		}
+
+		output, err := c.client.DescribeSubscriptionFilters(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: logGroupName,
+				Err:          err,
+			}
+		}
+		filters = append(filters, output.SubscriptionFilters...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return filters, nil
+}
+
+func (c *CloudWatchLogs) DeleteSubscriptionFilter(ctx context.Context, logGroupName *string, filterName *string) error {
+	input := &cloudwatchlogs.DeleteSubscriptionFilterInput{
+		LogGroupName: logGroupName,
+		FilterName:   filterName,
+	}
+
+	_, err := c.client.DeleteSubscriptionFilter(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: logGroupName,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *CloudWatchLogs) DescribeMetricFilters(ctx context.Context, logGroupName *string) ([]types.MetricFilter, error) {
+	var nextToken *string
+	filters := []types.MetricFilter{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return filters, &ClientError{
+				ResourceName: logGroupName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &cloudwatchlogs.DescribeMetricFiltersInput{
+			LogGroupName: logGroupName,
+			NextToken:    nextToken,
+		}
+
+		output, err := c.client.DescribeMetricFilters(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: logGroupName,
+				Err:          err,
+			}
+		}
+		filters = append(filters, output.MetricFilters...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return filters, nil
+}
+
+func (c *CloudWatchLogs) DeleteMetricFilter(ctx context.Context, logGroupName *string, filterName *string) error {
+	input := &cloudwatchlogs.DeleteMetricFilterInput{
+		LogGroupName: logGroupName,
+		FilterName:   filterName,
+	}
+
+	_, err := c.client.DeleteMetricFilter(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: logGroupName,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+// GetDataProtectionPolicy returns the policy document of the log group, or nil if it has no data protection policy.
+func (c *CloudWatchLogs) GetDataProtectionPolicy(ctx context.Context, logGroupName *string) (*string, error) {
+	input := &cloudwatchlogs.GetDataProtectionPolicyInput{
+		LogGroupIdentifier: logGroupName,
+	}
+
+	output, err := c.client.GetDataProtectionPolicy(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: logGroupName,
+			Err:          err,
+		}
+	}
+
+	if aws.ToString(output.PolicyDocument) == "" {
+		return nil, nil
+	}
+	return output.PolicyDocument, nil
+}
+
+func (c *CloudWatchLogs) DeleteDataProtectionPolicy(ctx context.Context, logGroupName *string) error {
+	input := &cloudwatchlogs.DeleteDataProtectionPolicyInput{
+		LogGroupIdentifier: logGroupName,
+	}
+
+	_, err := c.client.DeleteDataProtectionPolicy(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: logGroupName,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *CloudWatchLogs) DeleteLogGroup(ctx context.Context, logGroupName *string) error {
+	input := &cloudwatchlogs.DeleteLogGroupInput{
+		LogGroupName: logGroupName,
+	}
+
+	_, err := c.client.DeleteLogGroup(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: logGroupName,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,6 +55,107 @@ func (mr *MockICloudWatchLogsMockRecorder) CheckLogGroupDeletionProtection(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLogGroupDeletionProtection", reflect.TypeOf((*MockICloudWatchLogs)(nil).CheckLogGroupDeletionProtection), ctx, logGroupName)
 }
 
+// CheckLogGroupExists mocks base method.
+func (m *MockICloudWatchLogs) CheckLogGroupExists(ctx context.Context, logGroupName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLogGroupExists", ctx, logGroupName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLogGroupExists indicates an expected call of CheckLogGroupExists.
+func (mr *MockICloudWatchLogsMockRecorder) CheckLogGroupExists(ctx, logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLogGroupExists", reflect.TypeOf((*MockICloudWatchLogs)(nil).CheckLogGroupExists), ctx, logGroupName)
+}
+
+// DeleteDataProtectionPolicy mocks base method.
+func (m *MockICloudWatchLogs) DeleteDataProtectionPolicy(ctx context.Context, logGroupName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDataProtectionPolicy", ctx, logGroupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDataProtectionPolicy indicates an expected call of DeleteDataProtectionPolicy.
+func (mr *MockICloudWatchLogsMockRecorder) DeleteDataProtectionPolicy(ctx, logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataProtectionPolicy", reflect.TypeOf((*MockICloudWatchLogs)(nil).DeleteDataProtectionPolicy), ctx, logGroupName)
+}
+
+// DeleteLogGroup mocks base method.
+func (m *MockICloudWatchLogs) DeleteLogGroup(ctx context.Context, logGroupName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", ctx, logGroupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup.
+func (mr *MockICloudWatchLogsMockRecorder) DeleteLogGroup(ctx, logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MockICloudWatchLogs)(nil).DeleteLogGroup), ctx, logGroupName)
+}
+
+// DeleteMetricFilter mocks base method.
+func (m *MockICloudWatchLogs) DeleteMetricFilter(ctx context.Context, logGroupName, filterName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMetricFilter", ctx, logGroupName, filterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMetricFilter indicates an expected call of DeleteMetricFilter.
+func (mr *MockICloudWatchLogsMockRecorder) DeleteMetricFilter(ctx, logGroupName, filterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricFilter", reflect.TypeOf((*MockICloudWatchLogs)(nil).DeleteMetricFilter), ctx, logGroupName, filterName)
+}
+
+// DeleteSubscriptionFilter mocks base method.
+func (m *MockICloudWatchLogs) DeleteSubscriptionFilter(ctx context.Context, logGroupName, filterName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscriptionFilter", ctx, logGroupName, filterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscriptionFilter indicates an expected call of DeleteSubscriptionFilter.
+func (mr *MockICloudWatchLogsMockRecorder) DeleteSubscriptionFilter(ctx, logGroupName, filterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionFilter", reflect.TypeOf((*MockICloudWatchLogs)(nil).DeleteSubscriptionFilter), ctx, logGroupName, filterName)
+}
+
+// DescribeMetricFilters mocks base method.
+func (m *MockICloudWatchLogs) DescribeMetricFilters(ctx context.Context, logGroupName *string) ([]types.MetricFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeMetricFilters", ctx, logGroupName)
+	ret0, _ := ret[0].([]types.MetricFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMetricFilters indicates an expected call of DescribeMetricFilters.
+func (mr *MockICloudWatchLogsMockRecorder) DescribeMetricFilters(ctx, logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMetricFilters", reflect.TypeOf((*MockICloudWatchLogs)(nil).DescribeMetricFilters), ctx, logGroupName)
+}
+
+// DescribeSubscriptionFilters mocks base method.
+func (m *MockICloudWatchLogs) DescribeSubscriptionFilters(ctx context.Context, logGroupName *string) ([]types.SubscriptionFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSubscriptionFilters", ctx, logGroupName)
+	ret0, _ := ret[0].([]types.SubscriptionFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSubscriptionFilters indicates an expected call of DescribeSubscriptionFilters.
+func (mr *MockICloudWatchLogsMockRecorder) DescribeSubscriptionFilters(ctx, logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubscriptionFilters", reflect.TypeOf((*MockICloudWatchLogs)(nil).DescribeSubscriptionFilters), ctx, logGroupName)
+}
+
 // DisableLogGroupDeletionProtection mocks base method.
 func (m *MockICloudWatchLogs) DisableLogGroupDeletionProtection(ctx context.Context, logGroupName *string) error {
 	m.ctrl.T.Helper()
@@ -67,3 +169,18 @@ func (mr *MockICloudWatchLogsMockRecorder) DisableLogGroupDeletionProtection(ctx
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableLogGroupDeletionProtection", reflect.TypeOf((*MockICloudWatchLogs)(nil).DisableLogGroupDeletionProtection), ctx, logGroupName)
 }
+
+// GetDataProtectionPolicy mocks base method.
+func (m *MockICloudWatchLogs) GetDataProtectionPolicy(ctx context.Context, logGroupName *string) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataProtectionPolicy", ctx, logGroupName)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataProtectionPolicy indicates an expected call of GetDataProtectionPolicy.
+func (mr *MockICloudWatchLogsMockRecorder) GetDataProtectionPolicy(ctx, logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataProtectionPolicy", reflect.TypeOf((*MockICloudWatchLogs)(nil).GetDataProtectionPolicy), ctx, logGroupName)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -315,3 +316,810 @@ func TestCloudWatchLogs_DisableLogGroupDeletionProtection(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatchLogs_CheckLogGroupExists(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check log group exists",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeLogGroupsExistsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeLogGroupsOutput{
+										LogGroups: []cloudwatchlogstypes.LogGroup{
+											{
+												LogGroupName: aws.String("/aws/test/log-group-2"),
+											},
+											{
+												LogGroupName: aws.String("/aws/test/log-group"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check log group not exists with no exact match",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeLogGroupsNoMatchMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeLogGroupsOutput{
+										LogGroups: []cloudwatchlogstypes.LogGroup{
+											{
+												LogGroupName: aws.String("/aws/test/log-group-2"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check log group exists failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeLogGroupsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeLogGroupsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeLogGroupsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			got, err := cwlClient.CheckLogGroupExists(tt.args.ctx, tt.args.logGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_DescribeSubscriptionFilters(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []cloudwatchlogstypes.SubscriptionFilter
+		wantErr bool
+	}{
+		{
+			name: "describe subscription filters successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeSubscriptionFiltersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeSubscriptionFiltersOutput{
+										SubscriptionFilters: []cloudwatchlogstypes.SubscriptionFilter{
+											{
+												FilterName: aws.String("test-filter"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []cloudwatchlogstypes.SubscriptionFilter{
+				{
+					FilterName: aws.String("test-filter"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe subscription filters failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeSubscriptionFiltersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeSubscriptionFiltersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeSubscriptionFiltersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			got, err := cwlClient.DescribeSubscriptionFilters(tt.args.ctx, tt.args.logGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_DeleteSubscriptionFilter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		filterName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete subscription filter successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				filterName:   aws.String("test-filter"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteSubscriptionFilterMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteSubscriptionFilterOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete subscription filter failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				filterName:   aws.String("test-filter"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteSubscriptionFilterErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteSubscriptionFilterOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteSubscriptionFilterError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			err = cwlClient.DeleteSubscriptionFilter(tt.args.ctx, tt.args.logGroupName, tt.args.filterName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_DescribeMetricFilters(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []cloudwatchlogstypes.MetricFilter
+		wantErr bool
+	}{
+		{
+			name: "describe metric filters successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeMetricFiltersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeMetricFiltersOutput{
+										MetricFilters: []cloudwatchlogstypes.MetricFilter{
+											{
+												FilterName: aws.String("test-filter"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []cloudwatchlogstypes.MetricFilter{
+				{
+					FilterName: aws.String("test-filter"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe metric filters failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeMetricFiltersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DescribeMetricFiltersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeMetricFiltersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			got, err := cwlClient.DescribeMetricFilters(tt.args.ctx, tt.args.logGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_DeleteMetricFilter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		filterName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete metric filter successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				filterName:   aws.String("test-filter"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteMetricFilterMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteMetricFilterOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete metric filter failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				filterName:   aws.String("test-filter"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteMetricFilterErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteMetricFilterOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteMetricFilterError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			err = cwlClient.DeleteMetricFilter(tt.args.ctx, tt.args.logGroupName, tt.args.filterName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_GetDataProtectionPolicy(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    *string
+		wantErr bool
+	}{
+		{
+			name: "get data protection policy successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDataProtectionPolicyMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.GetDataProtectionPolicyOutput{
+										PolicyDocument: aws.String("{}"),
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    aws.String("{}"),
+			wantErr: false,
+		},
+		{
+			name: "get data protection policy successfully for log group without policy",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDataProtectionPolicyEmptyMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.GetDataProtectionPolicyOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "get data protection policy failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetDataProtectionPolicyErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.GetDataProtectionPolicyOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetDataProtectionPolicyError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			got, err := cwlClient.GetDataProtectionPolicy(tt.args.ctx, tt.args.logGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && aws.ToString(got) != aws.ToString(tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_DeleteDataProtectionPolicy(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete data protection policy successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDataProtectionPolicyMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteDataProtectionPolicyOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete data protection policy failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDataProtectionPolicyErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteDataProtectionPolicyOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteDataProtectionPolicyError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			err = cwlClient.DeleteDataProtectionPolicy(tt.args.ctx, tt.args.logGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudWatchLogs_DeleteLogGroup(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		logGroupName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete log group successfully",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteLogGroupMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteLogGroupOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete log group failure",
+			args: args{
+				ctx:          context.Background(),
+				logGroupName: aws.String("/aws/test/log-group"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteLogGroupErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cloudwatchlogs.DeleteLogGroupOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteLogGroupError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cloudwatchlogs.NewFromConfig(cfg)
+			cwlClient := NewCloudWatchLogs(sdkClient)
+
+			err = cwlClient.DeleteLogGroup(tt.args.ctx, tt.args.logGroupName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
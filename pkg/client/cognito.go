@@ -3,6 +3,9 @@ package client
 
 import (
 	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 
 	cognitotypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
 
@@ -12,6 +15,16 @@ import (
 type ICognito interface {
 	CheckUserPoolDeletionProtection(ctx context.Context, userPoolId *string) (bool, error)
 	DisableUserPoolDeletionProtection(ctx context.Context, userPoolId *string) error
+	DescribeUserPool(ctx context.Context, userPoolId *string) (*cognitotypes.UserPoolType, error)
+	DescribeUserPoolDomain(ctx context.Context, domain *string) (*cognitotypes.DomainDescriptionType, error)
+	DeleteUserPoolDomain(ctx context.Context, domain *string, userPoolId *string) error
+	ListUserPoolClients(ctx context.Context, userPoolId *string) ([]cognitotypes.UserPoolClientDescription, error)
+	DescribeManagedLoginBrandingByClient(ctx context.Context, userPoolId *string, clientId *string) (*cognitotypes.ManagedLoginBrandingType, error)
+	DeleteManagedLoginBranding(ctx context.Context, userPoolId *string, managedLoginBrandingId *string) error
+	ListResourceServers(ctx context.Context, userPoolId *string) ([]cognitotypes.ResourceServerType, error)
+	DeleteResourceServer(ctx context.Context, userPoolId *string, identifier *string) error
+	DeleteUserPool(ctx context.Context, userPoolId *string) error
+	CheckUserPoolExists(ctx context.Context, userPoolId *string) (bool, error)
 }
 
 var _ ICognito = (*Cognito)(nil)
@@ -62,3 +75,222 @@ func (c *Cognito) DisableUserPoolDeletionProtection(ctx context.Context, userPoo
 
 	return nil
 }
+
+func (c *Cognito) DescribeUserPool(ctx context.Context, userPoolId *string) (*cognitotypes.UserPoolType, error) {
+	input := &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: userPoolId,
+	}
+
+	output, err := c.client.DescribeUserPool(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: userPoolId,
+			Err:          err,
+		}
+	}
+
+	return output.UserPool, nil
+}
+
+// DescribeUserPoolDomain returns the description of the domain, or nil if the domain does not exist.
+func (c *Cognito) DescribeUserPoolDomain(ctx context.Context, domain *string) (*cognitotypes.DomainDescriptionType, error) {
+	input := &cognitoidentityprovider.DescribeUserPoolDomainInput{
+		Domain: domain,
+	}
+
+	output, err := c.client.DescribeUserPoolDomain(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: domain,
+			Err:          err,
+		}
+	}
+
+	// DescribeUserPoolDomain returns an empty description instead of an error for a missing domain.
+	if output.DomainDescription == nil || aws.ToString(output.DomainDescription.Domain) == "" {
+		return nil, nil
+	}
+	return output.DomainDescription, nil
+}
+
+func (c *Cognito) DeleteUserPoolDomain(ctx context.Context, domain *string, userPoolId *string) error {
+	input := &cognitoidentityprovider.DeleteUserPoolDomainInput{
+		Domain:     domain,
+		UserPoolId: userPoolId,
+	}
+
+	_, err := c.client.DeleteUserPoolDomain(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domain,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *Cognito) ListUserPoolClients(ctx context.Context, userPoolId *string) ([]cognitotypes.UserPoolClientDescription, error) {
+	var nextToken *string
+	userPoolClients := []cognitotypes.UserPoolClientDescription{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return userPoolClients, &ClientError{
+				ResourceName: userPoolId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &cognitoidentityprovider.ListUserPoolClientsInput{
+			UserPoolId: userPoolId,
+			NextToken:  nextToken,
+		}
+
+		output, err := c.client.ListUserPoolClients(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: userPoolId,
+				Err:          err,
+			}
+		}
+		userPoolClients = append(userPoolClients, output.UserPoolClients...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return userPoolClients, nil
+}
+
+// DescribeManagedLoginBrandingByClient returns the managed login branding of the app client, or nil if it has none.
+func (c *Cognito) DescribeManagedLoginBrandingByClient(ctx context.Context, userPoolId *string, clientId *string) (*cognitotypes.ManagedLoginBrandingType, error) {
+	input := &cognitoidentityprovider.DescribeManagedLoginBrandingByClientInput{
+		UserPoolId: userPoolId,
+		ClientId:   clientId,
+	}
+
+	output, err := c.client.DescribeManagedLoginBrandingByClient(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: clientId,
+			Err:          err,
+		}
+	}
+
+	return output.ManagedLoginBranding, nil
+}
+
+func (c *Cognito) DeleteManagedLoginBranding(ctx context.Context, userPoolId *string, managedLoginBrandingId *string) error {
+	input := &cognitoidentityprovider.DeleteManagedLoginBrandingInput{
+		UserPoolId:             userPoolId,
+		ManagedLoginBrandingId: managedLoginBrandingId,
+	}
+
+	_, err := c.client.DeleteManagedLoginBranding(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: managedLoginBrandingId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *Cognito) ListResourceServers(ctx context.Context, userPoolId *string) ([]cognitotypes.ResourceServerType, error) {
+	var nextToken *string
+	resourceServers := []cognitotypes.ResourceServerType{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceServers, &ClientError{
+				ResourceName: userPoolId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &cognitoidentityprovider.ListResourceServersInput{
+			UserPoolId: userPoolId,
+			MaxResults: aws.Int32(50),
+			NextToken:  nextToken,
+		}
+
+		output, err := c.client.ListResourceServers(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: userPoolId,
+				Err:          err,
+			}
+		}
+		resourceServers = append(resourceServers, output.ResourceServers...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return resourceServers, nil
+}
+
+func (c *Cognito) DeleteResourceServer(ctx context.Context, userPoolId *string, identifier *string) error {
+	input := &cognitoidentityprovider.DeleteResourceServerInput{
+		UserPoolId: userPoolId,
+		Identifier: identifier,
+	}
+
+	_, err := c.client.DeleteResourceServer(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: identifier,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *Cognito) DeleteUserPool(ctx context.Context, userPoolId *string) error {
+	input := &cognitoidentityprovider.DeleteUserPoolInput{
+		UserPoolId: userPoolId,
+	}
+
+	_, err := c.client.DeleteUserPool(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: userPoolId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *Cognito) CheckUserPoolExists(ctx context.Context, userPoolId *string) (bool, error) {
+	input := &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: userPoolId,
+	}
+
+	_, err := c.client.DescribeUserPool(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: userPoolId,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
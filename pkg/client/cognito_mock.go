@@ -12,6 +12,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,6 +55,122 @@ func (mr *MockICognitoMockRecorder) CheckUserPoolDeletionProtection(ctx, userPoo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserPoolDeletionProtection", reflect.TypeOf((*MockICognito)(nil).CheckUserPoolDeletionProtection), ctx, userPoolId)
 }
 
+// CheckUserPoolExists mocks base method.
+func (m *MockICognito) CheckUserPoolExists(ctx context.Context, userPoolId *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserPoolExists", ctx, userPoolId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckUserPoolExists indicates an expected call of CheckUserPoolExists.
+func (mr *MockICognitoMockRecorder) CheckUserPoolExists(ctx, userPoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserPoolExists", reflect.TypeOf((*MockICognito)(nil).CheckUserPoolExists), ctx, userPoolId)
+}
+
+// DeleteManagedLoginBranding mocks base method.
+func (m *MockICognito) DeleteManagedLoginBranding(ctx context.Context, userPoolId, managedLoginBrandingId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagedLoginBranding", ctx, userPoolId, managedLoginBrandingId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManagedLoginBranding indicates an expected call of DeleteManagedLoginBranding.
+func (mr *MockICognitoMockRecorder) DeleteManagedLoginBranding(ctx, userPoolId, managedLoginBrandingId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedLoginBranding", reflect.TypeOf((*MockICognito)(nil).DeleteManagedLoginBranding), ctx, userPoolId, managedLoginBrandingId)
+}
+
+// DeleteResourceServer mocks base method.
+func (m *MockICognito) DeleteResourceServer(ctx context.Context, userPoolId, identifier *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceServer", ctx, userPoolId, identifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResourceServer indicates an expected call of DeleteResourceServer.
+func (mr *MockICognitoMockRecorder) DeleteResourceServer(ctx, userPoolId, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceServer", reflect.TypeOf((*MockICognito)(nil).DeleteResourceServer), ctx, userPoolId, identifier)
+}
+
+// DeleteUserPool mocks base method.
+func (m *MockICognito) DeleteUserPool(ctx context.Context, userPoolId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserPool", ctx, userPoolId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserPool indicates an expected call of DeleteUserPool.
+func (mr *MockICognitoMockRecorder) DeleteUserPool(ctx, userPoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPool", reflect.TypeOf((*MockICognito)(nil).DeleteUserPool), ctx, userPoolId)
+}
+
+// DeleteUserPoolDomain mocks base method.
+func (m *MockICognito) DeleteUserPoolDomain(ctx context.Context, domain, userPoolId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserPoolDomain", ctx, domain, userPoolId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserPoolDomain indicates an expected call of DeleteUserPoolDomain.
+func (mr *MockICognitoMockRecorder) DeleteUserPoolDomain(ctx, domain, userPoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPoolDomain", reflect.TypeOf((*MockICognito)(nil).DeleteUserPoolDomain), ctx, domain, userPoolId)
+}
+
+// DescribeManagedLoginBrandingByClient mocks base method.
+func (m *MockICognito) DescribeManagedLoginBrandingByClient(ctx context.Context, userPoolId, clientId *string) (*types.ManagedLoginBrandingType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeManagedLoginBrandingByClient", ctx, userPoolId, clientId)
+	ret0, _ := ret[0].(*types.ManagedLoginBrandingType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeManagedLoginBrandingByClient indicates an expected call of DescribeManagedLoginBrandingByClient.
+func (mr *MockICognitoMockRecorder) DescribeManagedLoginBrandingByClient(ctx, userPoolId, clientId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeManagedLoginBrandingByClient", reflect.TypeOf((*MockICognito)(nil).DescribeManagedLoginBrandingByClient), ctx, userPoolId, clientId)
+}
+
+// DescribeUserPool mocks base method.
+func (m *MockICognito) DescribeUserPool(ctx context.Context, userPoolId *string) (*types.UserPoolType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeUserPool", ctx, userPoolId)
+	ret0, _ := ret[0].(*types.UserPoolType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeUserPool indicates an expected call of DescribeUserPool.
+func (mr *MockICognitoMockRecorder) DescribeUserPool(ctx, userPoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeUserPool", reflect.TypeOf((*MockICognito)(nil).DescribeUserPool), ctx, userPoolId)
+}
+
+// DescribeUserPoolDomain mocks base method.
+func (m *MockICognito) DescribeUserPoolDomain(ctx context.Context, domain *string) (*types.DomainDescriptionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeUserPoolDomain", ctx, domain)
+	ret0, _ := ret[0].(*types.DomainDescriptionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeUserPoolDomain indicates an expected call of DescribeUserPoolDomain.
+func (mr *MockICognitoMockRecorder) DescribeUserPoolDomain(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeUserPoolDomain", reflect.TypeOf((*MockICognito)(nil).DescribeUserPoolDomain), ctx, domain)
+}
+
 // DisableUserPoolDeletionProtection mocks base method.
 func (m *MockICognito) DisableUserPoolDeletionProtection(ctx context.Context, userPoolId *string) error {
 	m.ctrl.T.Helper()
@@ -67,3 +184,33 @@ func (mr *MockICognitoMockRecorder) DisableUserPoolDeletionProtection(ctx, userP
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUserPoolDeletionProtection", reflect.TypeOf((*MockICognito)(nil).DisableUserPoolDeletionProtection), ctx, userPoolId)
 }
+
+// ListResourceServers mocks base method.
+func (m *MockICognito) ListResourceServers(ctx context.Context, userPoolId *string) ([]types.ResourceServerType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceServers", ctx, userPoolId)
+	ret0, _ := ret[0].([]types.ResourceServerType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceServers indicates an expected call of ListResourceServers.
+func (mr *MockICognitoMockRecorder) ListResourceServers(ctx, userPoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceServers", reflect.TypeOf((*MockICognito)(nil).ListResourceServers), ctx, userPoolId)
+}
+
+// ListUserPoolClients mocks base method.
+func (m *MockICognito) ListUserPoolClients(ctx context.Context, userPoolId *string) ([]types.UserPoolClientDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserPoolClients", ctx, userPoolId)
+	ret0, _ := ret[0].([]types.UserPoolClientDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserPoolClients indicates an expected call of ListUserPoolClients.
+func (mr *MockICognitoMockRecorder) ListUserPoolClients(ctx, userPoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserPoolClients", reflect.TypeOf((*MockICognito)(nil).ListUserPoolClients), ctx, userPoolId)
+}
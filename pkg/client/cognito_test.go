@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -247,3 +248,1036 @@ func TestCognito_DisableUserPoolDeletionProtection(t *testing.T) {
 		})
 	}
 }
+
+func TestCognito_DescribeUserPool(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    *cognitotypes.UserPoolType
+		wantErr bool
+	}{
+		{
+			name: "describe user pool successfully",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolOutput{
+										UserPool: &cognitotypes.UserPoolType{
+											Id:     aws.String("test-pool"),
+											Domain: aws.String("test-domain"),
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &cognitotypes.UserPoolType{
+				Id:     aws.String("test-pool"),
+				Domain: aws.String("test-domain"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe user pool failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeUserPoolError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			got, err := cognitoClient.DescribeUserPool(tt.args.ctx, tt.args.userPoolId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && aws.ToString(got.Domain) != aws.ToString(tt.want.Domain) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_DescribeUserPoolDomain(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		domain             *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    *cognitotypes.DomainDescriptionType
+		wantErr bool
+	}{
+		{
+			name: "describe user pool domain successfully",
+			args: args{
+				ctx:    context.Background(),
+				domain: aws.String("test-domain"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolDomainOutput{
+										DomainDescription: &cognitotypes.DomainDescriptionType{
+											Domain:     aws.String("test-domain"),
+											UserPoolId: aws.String("test-pool"),
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &cognitotypes.DomainDescriptionType{
+				Domain:     aws.String("test-domain"),
+				UserPoolId: aws.String("test-pool"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe user pool domain successfully for domain not exists",
+			args: args{
+				ctx:    context.Background(),
+				domain: aws.String("test-domain"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolDomainEmptyMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolDomainOutput{
+										DomainDescription: &cognitotypes.DomainDescriptionType{},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe user pool domain failure",
+			args: args{
+				ctx:    context.Background(),
+				domain: aws.String("test-domain"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeUserPoolDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			got, err := cognitoClient.DescribeUserPoolDomain(tt.args.ctx, tt.args.domain)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil) != (tt.want == nil) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_DeleteUserPoolDomain(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		domain             *string
+		userPoolId         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete user pool domain successfully",
+			args: args{
+				ctx:        context.Background(),
+				domain:     aws.String("test-domain"),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteUserPoolDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteUserPoolDomainOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete user pool domain failure",
+			args: args{
+				ctx:        context.Background(),
+				domain:     aws.String("test-domain"),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteUserPoolDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteUserPoolDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteUserPoolDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			err = cognitoClient.DeleteUserPoolDomain(tt.args.ctx, tt.args.domain, tt.args.userPoolId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_ListUserPoolClients(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []cognitotypes.UserPoolClientDescription
+		wantErr bool
+	}{
+		{
+			name: "list user pool clients successfully",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListUserPoolClientsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.ListUserPoolClientsOutput{
+										UserPoolClients: []cognitotypes.UserPoolClientDescription{
+											{
+												ClientId: aws.String("test-client"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []cognitotypes.UserPoolClientDescription{
+				{
+					ClientId: aws.String("test-client"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list user pool clients failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListUserPoolClientsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.ListUserPoolClientsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListUserPoolClientsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			got, err := cognitoClient.ListUserPoolClients(tt.args.ctx, tt.args.userPoolId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_DescribeManagedLoginBrandingByClient(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		clientId           *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    *cognitotypes.ManagedLoginBrandingType
+		wantErr bool
+	}{
+		{
+			name: "describe managed login branding successfully",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				clientId:   aws.String("test-client"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeManagedLoginBrandingByClientMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeManagedLoginBrandingByClientOutput{
+										ManagedLoginBranding: &cognitotypes.ManagedLoginBrandingType{
+											ManagedLoginBrandingId: aws.String("test-branding"),
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &cognitotypes.ManagedLoginBrandingType{
+				ManagedLoginBrandingId: aws.String("test-branding"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe managed login branding successfully for client without branding",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				clientId:   aws.String("test-client"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeManagedLoginBrandingByClientNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeManagedLoginBrandingByClientOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe managed login branding failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				clientId:   aws.String("test-client"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeManagedLoginBrandingByClientErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeManagedLoginBrandingByClientOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeManagedLoginBrandingByClientError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			got, err := cognitoClient.DescribeManagedLoginBrandingByClient(tt.args.ctx, tt.args.userPoolId, tt.args.clientId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (got == nil) != (tt.want == nil) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_DeleteManagedLoginBranding(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                    context.Context
+		userPoolId             *string
+		managedLoginBrandingId *string
+		withAPIOptionsFunc     func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete managed login branding successfully",
+			args: args{
+				ctx:                    context.Background(),
+				userPoolId:             aws.String("test-pool"),
+				managedLoginBrandingId: aws.String("test-branding"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteManagedLoginBrandingMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteManagedLoginBrandingOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete managed login branding failure",
+			args: args{
+				ctx:                    context.Background(),
+				userPoolId:             aws.String("test-pool"),
+				managedLoginBrandingId: aws.String("test-branding"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteManagedLoginBrandingErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteManagedLoginBrandingOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteManagedLoginBrandingError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			err = cognitoClient.DeleteManagedLoginBranding(tt.args.ctx, tt.args.userPoolId, tt.args.managedLoginBrandingId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_ListResourceServers(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []cognitotypes.ResourceServerType
+		wantErr bool
+	}{
+		{
+			name: "list resource servers successfully",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListResourceServersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.ListResourceServersOutput{
+										ResourceServers: []cognitotypes.ResourceServerType{
+											{
+												Identifier: aws.String("test-server"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []cognitotypes.ResourceServerType{
+				{
+					Identifier: aws.String("test-server"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list resource servers failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListResourceServersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.ListResourceServersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListResourceServersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			got, err := cognitoClient.ListResourceServers(tt.args.ctx, tt.args.userPoolId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_DeleteResourceServer(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		identifier         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete resource server successfully",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				identifier: aws.String("test-server"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteResourceServerMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteResourceServerOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete resource server failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				identifier: aws.String("test-server"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteResourceServerErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteResourceServerOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteResourceServerError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			err = cognitoClient.DeleteResourceServer(tt.args.ctx, tt.args.userPoolId, tt.args.identifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_DeleteUserPool(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete user pool successfully",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteUserPoolMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteUserPoolOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete user pool failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteUserPoolErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DeleteUserPoolOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteUserPoolError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			err = cognitoClient.DeleteUserPool(tt.args.ctx, tt.args.userPoolId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCognito_CheckUserPoolExists(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		userPoolId         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check user pool exists",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolOutput{
+										UserPool: &cognitotypes.UserPoolType{
+											Id: aws.String("test-pool"),
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check user pool not exists",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check user pool exists failure",
+			args: args{
+				ctx:        context.Background(),
+				userPoolId: aws.String("test-pool"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeUserPoolErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &cognitoidentityprovider.DescribeUserPoolOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeUserPoolError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sdkClient := cognitoidentityprovider.NewFromConfig(cfg)
+			cognitoClient := NewCognito(sdkClient)
+
+			got, err := cognitoClient.CheckUserPoolExists(tt.args.ctx, tt.args.userPoolId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+//go:generate mockgen -source=$GOFILE -destination=docdb_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/docdb"
+)
+
+type IDocDB interface {
+	CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error)
+	DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error
+}
+
+var _ IDocDB = (*DocDB)(nil)
+
+type DocDB struct {
+	client *docdb.Client
+}
+
+func NewDocDB(client *docdb.Client) *DocDB {
+	return &DocDB{
+		client: client,
+	}
+}
+
+func (d *DocDB) CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error) {
+	input := &docdb.DescribeDBClustersInput{
+		DBClusterIdentifier: dbClusterId,
+	}
+
+	output, err := d.client.DescribeDBClusters(ctx, input)
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	if len(output.DBClusters) == 0 {
+		return false, nil
+	}
+
+	return aws.ToBool(output.DBClusters[0].DeletionProtection), nil
+}
+
+func (d *DocDB) DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error {
+	input := &docdb.ModifyDBClusterInput{
+		DBClusterIdentifier: dbClusterId,
+		DeletionProtection:  aws.Bool(false),
+	}
+
+	_, err := d.client.ModifyDBCluster(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
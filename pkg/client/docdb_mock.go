@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: docdb.go
+//
+// Generated by this command:
+//
+//	mockgen -source=docdb.go -destination=docdb_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDocDB is a mock of IDocDB interface.
+type MockIDocDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDocDBMockRecorder
+	isgomock struct{}
+}
+
+// MockIDocDBMockRecorder is the mock recorder for MockIDocDB.
+type MockIDocDBMockRecorder struct {
+	mock *MockIDocDB
+}
+
+// NewMockIDocDB creates a new mock instance.
+func NewMockIDocDB(ctrl *gomock.Controller) *MockIDocDB {
+	mock := &MockIDocDB{ctrl: ctrl}
+	mock.recorder = &MockIDocDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDocDB) EXPECT() *MockIDocDBMockRecorder {
+	return m.recorder
+}
+
+// CheckDBClusterDeletionProtection mocks base method.
+func (m *MockIDocDB) CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDBClusterDeletionProtection", ctx, dbClusterId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDBClusterDeletionProtection indicates an expected call of CheckDBClusterDeletionProtection.
+func (mr *MockIDocDBMockRecorder) CheckDBClusterDeletionProtection(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDBClusterDeletionProtection", reflect.TypeOf((*MockIDocDB)(nil).CheckDBClusterDeletionProtection), ctx, dbClusterId)
+}
+
+// DisableDBClusterDeletionProtection mocks base method.
+func (m *MockIDocDB) DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableDBClusterDeletionProtection", ctx, dbClusterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableDBClusterDeletionProtection indicates an expected call of DisableDBClusterDeletionProtection.
+func (mr *MockIDocDBMockRecorder) DisableDBClusterDeletionProtection(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableDBClusterDeletionProtection", reflect.TypeOf((*MockIDocDB)(nil).DisableDBClusterDeletionProtection), ctx, dbClusterId)
+}
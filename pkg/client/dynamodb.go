@@ -0,0 +1,175 @@
+//go:generate mockgen -source=$GOFILE -destination=dynamodb_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+var SleepTimeSecForDynamoDB = 5
+
+type IDynamoDB interface {
+	DescribeTable(ctx context.Context, tableName *string) (*types.TableDescription, error)
+	CheckTableDeletionProtection(ctx context.Context, tableName *string) (bool, error)
+	DisableTableDeletionProtection(ctx context.Context, tableName *string) error
+	DeleteReplica(ctx context.Context, tableName *string, regionName *string) error
+	DeleteTable(ctx context.Context, tableName *string) error
+	CheckTableExists(ctx context.Context, tableName *string) (bool, error)
+}
+
+var _ IDynamoDB = (*DynamoDB)(nil)
+
+type DynamoDB struct {
+	client  *dynamodb.Client
+	retryer *Retryer
+}
+
+func NewDynamoDB(client *dynamodb.Client) *DynamoDB {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "LimitExceededException") ||
+			strings.Contains(err.Error(), "ThrottlingException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForDynamoDB)
+
+	return &DynamoDB{
+		client,
+		retryer,
+	}
+}
+
+func (d *DynamoDB) DescribeTable(ctx context.Context, tableName *string) (*types.TableDescription, error) {
+	input := &dynamodb.DescribeTableInput{
+		TableName: tableName,
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Retryer = d.retryer
+	}
+
+	output, err := d.client.DescribeTable(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: tableName,
+			Err:          err,
+		}
+	}
+
+	return output.Table, nil
+}
+
+func (d *DynamoDB) CheckTableDeletionProtection(ctx context.Context, tableName *string) (bool, error) {
+	input := &dynamodb.DescribeTableInput{
+		TableName: tableName,
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Retryer = d.retryer
+	}
+
+	output, err := d.client.DescribeTable(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: tableName,
+			Err:          err,
+		}
+	}
+
+	return aws.ToBool(output.Table.DeletionProtectionEnabled), nil
+}
+
+func (d *DynamoDB) DisableTableDeletionProtection(ctx context.Context, tableName *string) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:                 tableName,
+		DeletionProtectionEnabled: aws.Bool(false),
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Retryer = d.retryer
+	}
+
+	_, err := d.client.UpdateTable(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: tableName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+// DeleteReplica removes the replica in the given region from a global table (version 2019.11.21).
+// Only one replica can be removed per UpdateTable call.
+func (d *DynamoDB) DeleteReplica(ctx context.Context, tableName *string, regionName *string) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName: tableName,
+		ReplicaUpdates: []types.ReplicationGroupUpdate{
+			{
+				Delete: &types.DeleteReplicationGroupMemberAction{
+					RegionName: regionName,
+				},
+			},
+		},
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Retryer = d.retryer
+	}
+
+	_, err := d.client.UpdateTable(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: tableName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (d *DynamoDB) DeleteTable(ctx context.Context, tableName *string) error {
+	input := &dynamodb.DeleteTableInput{
+		TableName: tableName,
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Retryer = d.retryer
+	}
+
+	_, err := d.client.DeleteTable(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: tableName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (d *DynamoDB) CheckTableExists(ctx context.Context, tableName *string) (bool, error) {
+	input := &dynamodb.DescribeTableInput{
+		TableName: tableName,
+	}
+
+	optFn := func(o *dynamodb.Options) {
+		o.Retryer = d.retryer
+	}
+
+	_, err := d.client.DescribeTable(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: tableName,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
@@ -23,6 +23,23 @@ type IEC2 interface {
 	DeleteSecurityGroup(ctx context.Context, securityGroupId *string) error
 	CheckTerminationProtection(ctx context.Context, instanceId *string) (bool, error)
 	DisableTerminationProtection(ctx context.Context, instanceId *string) error
+	DescribeTransitGateway(ctx context.Context, transitGatewayId *string) (*types.TransitGateway, error)
+	DeleteTransitGateway(ctx context.Context, transitGatewayId *string) error
+	DescribeTransitGatewayAttachments(ctx context.Context, transitGatewayId *string) ([]types.TransitGatewayAttachment, error)
+	DeleteTransitGatewayVpcAttachment(ctx context.Context, attachmentId *string) error
+	RejectTransitGatewayVpcAttachment(ctx context.Context, attachmentId *string) error
+	DeleteTransitGatewayPeeringAttachment(ctx context.Context, attachmentId *string) error
+	RejectTransitGatewayPeeringAttachment(ctx context.Context, attachmentId *string) error
+	DescribeTransitGatewayRouteTables(ctx context.Context, transitGatewayId *string) ([]types.TransitGatewayRouteTable, error)
+	DeleteTransitGatewayRouteTable(ctx context.Context, routeTableId *string) error
+	GetTransitGatewayRouteTablePropagations(ctx context.Context, routeTableId *string) ([]types.TransitGatewayRouteTablePropagation, error)
+	DisableTransitGatewayRouteTablePropagation(ctx context.Context, routeTableId *string, attachmentId *string) error
+	GetTransitGatewayRouteTableAssociations(ctx context.Context, routeTableId *string) ([]types.TransitGatewayRouteTableAssociation, error)
+	DisassociateTransitGatewayRouteTable(ctx context.Context, routeTableId *string, attachmentId *string) error
+	CheckVpcEndpointServiceExists(ctx context.Context, serviceId *string) (bool, error)
+	DescribeVpcEndpointConnections(ctx context.Context, serviceId *string) ([]types.VpcEndpointConnection, error)
+	RejectVpcEndpointConnections(ctx context.Context, serviceId *string, vpcEndpointIds []string) error
+	DeleteVpcEndpointServiceConfiguration(ctx context.Context, serviceId *string) error
 }
 
 var _ IEC2 = (*EC2Client)(nil)
@@ -165,3 +182,417 @@ func (c *EC2Client) DisableTerminationProtection(ctx context.Context, instanceId
 
 	return nil
 }
+
+// DescribeTransitGateway returns nil if the transit gateway does not exist.
+func (c *EC2Client) DescribeTransitGateway(ctx context.Context, transitGatewayId *string) (*types.TransitGateway, error) {
+	input := &ec2.DescribeTransitGatewaysInput{
+		TransitGatewayIds: []string{aws.ToString(transitGatewayId)},
+	}
+
+	output, err := c.client.DescribeTransitGateways(ctx, input)
+	if err != nil {
+		if strings.Contains(err.Error(), "InvalidTransitGatewayID.NotFound") {
+			return nil, nil
+		}
+		return nil, &ClientError{
+			ResourceName: transitGatewayId,
+			Err:          err,
+		}
+	}
+
+	if len(output.TransitGateways) == 0 {
+		return nil, nil
+	}
+
+	return &output.TransitGateways[0], nil
+}
+
+func (c *EC2Client) DeleteTransitGateway(ctx context.Context, transitGatewayId *string) error {
+	input := &ec2.DeleteTransitGatewayInput{
+		TransitGatewayId: transitGatewayId,
+	}
+
+	_, err := c.client.DeleteTransitGateway(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: transitGatewayId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) DescribeTransitGatewayAttachments(ctx context.Context, transitGatewayId *string) ([]types.TransitGatewayAttachment, error) {
+	var nextToken *string
+	transitGatewayAttachments := []types.TransitGatewayAttachment{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return transitGatewayAttachments, &ClientError{
+				ResourceName: transitGatewayId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ec2.DescribeTransitGatewayAttachmentsInput{
+			Filters: []types.Filter{
+				{
+					Name:   aws.String("transit-gateway-id"),
+					Values: []string{aws.ToString(transitGatewayId)},
+				},
+			},
+			NextToken: nextToken,
+		}
+
+		output, err := c.client.DescribeTransitGatewayAttachments(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: transitGatewayId,
+				Err:          err,
+			}
+		}
+		transitGatewayAttachments = append(transitGatewayAttachments, output.TransitGatewayAttachments...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return transitGatewayAttachments, nil
+}
+
+func (c *EC2Client) DeleteTransitGatewayVpcAttachment(ctx context.Context, attachmentId *string) error {
+	input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: attachmentId,
+	}
+
+	_, err := c.client.DeleteTransitGatewayVpcAttachment(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: attachmentId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) RejectTransitGatewayVpcAttachment(ctx context.Context, attachmentId *string) error {
+	input := &ec2.RejectTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: attachmentId,
+	}
+
+	_, err := c.client.RejectTransitGatewayVpcAttachment(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: attachmentId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) DeleteTransitGatewayPeeringAttachment(ctx context.Context, attachmentId *string) error {
+	input := &ec2.DeleteTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: attachmentId,
+	}
+
+	_, err := c.client.DeleteTransitGatewayPeeringAttachment(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: attachmentId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) RejectTransitGatewayPeeringAttachment(ctx context.Context, attachmentId *string) error {
+	input := &ec2.RejectTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: attachmentId,
+	}
+
+	_, err := c.client.RejectTransitGatewayPeeringAttachment(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: attachmentId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) DescribeTransitGatewayRouteTables(ctx context.Context, transitGatewayId *string) ([]types.TransitGatewayRouteTable, error) {
+	var nextToken *string
+	transitGatewayRouteTables := []types.TransitGatewayRouteTable{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return transitGatewayRouteTables, &ClientError{
+				ResourceName: transitGatewayId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ec2.DescribeTransitGatewayRouteTablesInput{
+			Filters: []types.Filter{
+				{
+					Name:   aws.String("transit-gateway-id"),
+					Values: []string{aws.ToString(transitGatewayId)},
+				},
+			},
+			NextToken: nextToken,
+		}
+
+		output, err := c.client.DescribeTransitGatewayRouteTables(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: transitGatewayId,
+				Err:          err,
+			}
+		}
+		transitGatewayRouteTables = append(transitGatewayRouteTables, output.TransitGatewayRouteTables...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return transitGatewayRouteTables, nil
+}
+
+func (c *EC2Client) DeleteTransitGatewayRouteTable(ctx context.Context, routeTableId *string) error {
+	input := &ec2.DeleteTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: routeTableId,
+	}
+
+	_, err := c.client.DeleteTransitGatewayRouteTable(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: routeTableId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) GetTransitGatewayRouteTablePropagations(ctx context.Context, routeTableId *string) ([]types.TransitGatewayRouteTablePropagation, error) {
+	var nextToken *string
+	transitGatewayRouteTablePropagations := []types.TransitGatewayRouteTablePropagation{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return transitGatewayRouteTablePropagations, &ClientError{
+				ResourceName: routeTableId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ec2.GetTransitGatewayRouteTablePropagationsInput{
+			TransitGatewayRouteTableId: routeTableId,
+			NextToken:                  nextToken,
+		}
+
+		output, err := c.client.GetTransitGatewayRouteTablePropagations(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: routeTableId,
+				Err:          err,
+			}
+		}
+		transitGatewayRouteTablePropagations = append(transitGatewayRouteTablePropagations, output.TransitGatewayRouteTablePropagations...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return transitGatewayRouteTablePropagations, nil
+}
+
+func (c *EC2Client) DisableTransitGatewayRouteTablePropagation(ctx context.Context, routeTableId *string, attachmentId *string) error {
+	input := &ec2.DisableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: routeTableId,
+		TransitGatewayAttachmentId: attachmentId,
+	}
+
+	_, err := c.client.DisableTransitGatewayRouteTablePropagation(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: routeTableId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) GetTransitGatewayRouteTableAssociations(ctx context.Context, routeTableId *string) ([]types.TransitGatewayRouteTableAssociation, error) {
+	var nextToken *string
+	associations := []types.TransitGatewayRouteTableAssociation{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return associations, &ClientError{
+				ResourceName: routeTableId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+			TransitGatewayRouteTableId: routeTableId,
+			NextToken:                  nextToken,
+		}
+
+		output, err := c.client.GetTransitGatewayRouteTableAssociations(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: routeTableId,
+				Err:          err,
+			}
+		}
+		associations = append(associations, output.Associations...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return associations, nil
+}
+
+func (c *EC2Client) DisassociateTransitGatewayRouteTable(ctx context.Context, routeTableId *string, attachmentId *string) error {
+	input := &ec2.DisassociateTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: routeTableId,
+		TransitGatewayAttachmentId: attachmentId,
+	}
+
+	_, err := c.client.DisassociateTransitGatewayRouteTable(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: routeTableId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) CheckVpcEndpointServiceExists(ctx context.Context, serviceId *string) (bool, error) {
+	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{aws.ToString(serviceId)},
+	}
+
+	output, err := c.client.DescribeVpcEndpointServiceConfigurations(ctx, input)
+	if err != nil {
+		if strings.Contains(err.Error(), "InvalidVpcEndpointServiceId.NotFound") {
+			return false, nil
+		}
+		return false, &ClientError{
+			ResourceName: serviceId,
+			Err:          err,
+		}
+	}
+
+	return len(output.ServiceConfigurations) > 0, nil
+}
+
+func (c *EC2Client) DescribeVpcEndpointConnections(ctx context.Context, serviceId *string) ([]types.VpcEndpointConnection, error) {
+	var nextToken *string
+	vpcEndpointConnections := []types.VpcEndpointConnection{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return vpcEndpointConnections, &ClientError{
+				ResourceName: serviceId,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ec2.DescribeVpcEndpointConnectionsInput{
+			Filters: []types.Filter{
+				{
+					Name:   aws.String("service-id"),
+					Values: []string{aws.ToString(serviceId)},
+				},
+			},
+			NextToken: nextToken,
+		}
+
+		output, err := c.client.DescribeVpcEndpointConnections(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: serviceId,
+				Err:          err,
+			}
+		}
+		vpcEndpointConnections = append(vpcEndpointConnections, output.VpcEndpointConnections...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return vpcEndpointConnections, nil
+}
+
+func (c *EC2Client) RejectVpcEndpointConnections(ctx context.Context, serviceId *string, vpcEndpointIds []string) error {
+	input := &ec2.RejectVpcEndpointConnectionsInput{
+		ServiceId:      serviceId,
+		VpcEndpointIds: vpcEndpointIds,
+	}
+
+	_, err := c.client.RejectVpcEndpointConnections(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: serviceId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (c *EC2Client) DeleteVpcEndpointServiceConfiguration(ctx context.Context, serviceId *string) error {
+	input := &ec2.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{aws.ToString(serviceId)},
+	}
+
+	output, err := c.client.DeleteVpcEndpointServiceConfigurations(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: serviceId,
+			Err:          err,
+		}
+	}
+
+	// The API succeeds even if the deletion fails, reporting it as an unsuccessful item.
+	if len(output.Unsuccessful) > 0 && output.Unsuccessful[0].Error != nil {
+		return &ClientError{
+			ResourceName: serviceId,
+			Err:          fmt.Errorf("%s: %s", aws.ToString(output.Unsuccessful[0].Error.Code), aws.ToString(output.Unsuccessful[0].Error.Message)),
+		}
+	}
+
+	return nil
+}
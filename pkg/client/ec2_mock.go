@@ -55,6 +55,21 @@ func (mr *MockIEC2MockRecorder) CheckTerminationProtection(ctx, instanceId any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTerminationProtection", reflect.TypeOf((*MockIEC2)(nil).CheckTerminationProtection), ctx, instanceId)
 }
 
+// CheckVpcEndpointServiceExists mocks base method.
+func (m *MockIEC2) CheckVpcEndpointServiceExists(ctx context.Context, serviceId *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVpcEndpointServiceExists", ctx, serviceId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVpcEndpointServiceExists indicates an expected call of CheckVpcEndpointServiceExists.
+func (mr *MockIEC2MockRecorder) CheckVpcEndpointServiceExists(ctx, serviceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVpcEndpointServiceExists", reflect.TypeOf((*MockIEC2)(nil).CheckVpcEndpointServiceExists), ctx, serviceId)
+}
+
 // DeleteNetworkInterface mocks base method.
 func (m *MockIEC2) DeleteNetworkInterface(ctx context.Context, networkInterfaceId *string) error {
 	m.ctrl.T.Helper()
@@ -97,6 +112,76 @@ func (mr *MockIEC2MockRecorder) DeleteSubnet(ctx, subnetId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockIEC2)(nil).DeleteSubnet), ctx, subnetId)
 }
 
+// DeleteTransitGateway mocks base method.
+func (m *MockIEC2) DeleteTransitGateway(ctx context.Context, transitGatewayId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGateway", ctx, transitGatewayId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitGateway indicates an expected call of DeleteTransitGateway.
+func (mr *MockIEC2MockRecorder) DeleteTransitGateway(ctx, transitGatewayId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGateway", reflect.TypeOf((*MockIEC2)(nil).DeleteTransitGateway), ctx, transitGatewayId)
+}
+
+// DeleteTransitGatewayPeeringAttachment mocks base method.
+func (m *MockIEC2) DeleteTransitGatewayPeeringAttachment(ctx context.Context, attachmentId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayPeeringAttachment", ctx, attachmentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitGatewayPeeringAttachment indicates an expected call of DeleteTransitGatewayPeeringAttachment.
+func (mr *MockIEC2MockRecorder) DeleteTransitGatewayPeeringAttachment(ctx, attachmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayPeeringAttachment", reflect.TypeOf((*MockIEC2)(nil).DeleteTransitGatewayPeeringAttachment), ctx, attachmentId)
+}
+
+// DeleteTransitGatewayRouteTable mocks base method.
+func (m *MockIEC2) DeleteTransitGatewayRouteTable(ctx context.Context, routeTableId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayRouteTable", ctx, routeTableId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitGatewayRouteTable indicates an expected call of DeleteTransitGatewayRouteTable.
+func (mr *MockIEC2MockRecorder) DeleteTransitGatewayRouteTable(ctx, routeTableId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayRouteTable", reflect.TypeOf((*MockIEC2)(nil).DeleteTransitGatewayRouteTable), ctx, routeTableId)
+}
+
+// DeleteTransitGatewayVpcAttachment mocks base method.
+func (m *MockIEC2) DeleteTransitGatewayVpcAttachment(ctx context.Context, attachmentId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayVpcAttachment", ctx, attachmentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitGatewayVpcAttachment indicates an expected call of DeleteTransitGatewayVpcAttachment.
+func (mr *MockIEC2MockRecorder) DeleteTransitGatewayVpcAttachment(ctx, attachmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayVpcAttachment", reflect.TypeOf((*MockIEC2)(nil).DeleteTransitGatewayVpcAttachment), ctx, attachmentId)
+}
+
+// DeleteVpcEndpointServiceConfiguration mocks base method.
+func (m *MockIEC2) DeleteVpcEndpointServiceConfiguration(ctx context.Context, serviceId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpointServiceConfiguration", ctx, serviceId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpcEndpointServiceConfiguration indicates an expected call of DeleteVpcEndpointServiceConfiguration.
+func (mr *MockIEC2MockRecorder) DeleteVpcEndpointServiceConfiguration(ctx, serviceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointServiceConfiguration", reflect.TypeOf((*MockIEC2)(nil).DeleteVpcEndpointServiceConfiguration), ctx, serviceId)
+}
+
 // DescribeNetworkInterfaces mocks base method.
 func (m *MockIEC2) DescribeNetworkInterfaces(ctx context.Context, filters []types.Filter) ([]types.NetworkInterface, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +197,66 @@ func (mr *MockIEC2MockRecorder) DescribeNetworkInterfaces(ctx, filters any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkInterfaces", reflect.TypeOf((*MockIEC2)(nil).DescribeNetworkInterfaces), ctx, filters)
 }
 
+// DescribeTransitGateway mocks base method.
+func (m *MockIEC2) DescribeTransitGateway(ctx context.Context, transitGatewayId *string) (*types.TransitGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTransitGateway", ctx, transitGatewayId)
+	ret0, _ := ret[0].(*types.TransitGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTransitGateway indicates an expected call of DescribeTransitGateway.
+func (mr *MockIEC2MockRecorder) DescribeTransitGateway(ctx, transitGatewayId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTransitGateway", reflect.TypeOf((*MockIEC2)(nil).DescribeTransitGateway), ctx, transitGatewayId)
+}
+
+// DescribeTransitGatewayAttachments mocks base method.
+func (m *MockIEC2) DescribeTransitGatewayAttachments(ctx context.Context, transitGatewayId *string) ([]types.TransitGatewayAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTransitGatewayAttachments", ctx, transitGatewayId)
+	ret0, _ := ret[0].([]types.TransitGatewayAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTransitGatewayAttachments indicates an expected call of DescribeTransitGatewayAttachments.
+func (mr *MockIEC2MockRecorder) DescribeTransitGatewayAttachments(ctx, transitGatewayId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTransitGatewayAttachments", reflect.TypeOf((*MockIEC2)(nil).DescribeTransitGatewayAttachments), ctx, transitGatewayId)
+}
+
+// DescribeTransitGatewayRouteTables mocks base method.
+func (m *MockIEC2) DescribeTransitGatewayRouteTables(ctx context.Context, transitGatewayId *string) ([]types.TransitGatewayRouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTransitGatewayRouteTables", ctx, transitGatewayId)
+	ret0, _ := ret[0].([]types.TransitGatewayRouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTransitGatewayRouteTables indicates an expected call of DescribeTransitGatewayRouteTables.
+func (mr *MockIEC2MockRecorder) DescribeTransitGatewayRouteTables(ctx, transitGatewayId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTransitGatewayRouteTables", reflect.TypeOf((*MockIEC2)(nil).DescribeTransitGatewayRouteTables), ctx, transitGatewayId)
+}
+
+// DescribeVpcEndpointConnections mocks base method.
+func (m *MockIEC2) DescribeVpcEndpointConnections(ctx context.Context, serviceId *string) ([]types.VpcEndpointConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVpcEndpointConnections", ctx, serviceId)
+	ret0, _ := ret[0].([]types.VpcEndpointConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVpcEndpointConnections indicates an expected call of DescribeVpcEndpointConnections.
+func (mr *MockIEC2MockRecorder) DescribeVpcEndpointConnections(ctx, serviceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcEndpointConnections", reflect.TypeOf((*MockIEC2)(nil).DescribeVpcEndpointConnections), ctx, serviceId)
+}
+
 // DisableTerminationProtection mocks base method.
 func (m *MockIEC2) DisableTerminationProtection(ctx context.Context, instanceId *string) error {
 	m.ctrl.T.Helper()
@@ -125,3 +270,103 @@ func (mr *MockIEC2MockRecorder) DisableTerminationProtection(ctx, instanceId any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTerminationProtection", reflect.TypeOf((*MockIEC2)(nil).DisableTerminationProtection), ctx, instanceId)
 }
+
+// DisableTransitGatewayRouteTablePropagation mocks base method.
+func (m *MockIEC2) DisableTransitGatewayRouteTablePropagation(ctx context.Context, routeTableId, attachmentId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTransitGatewayRouteTablePropagation", ctx, routeTableId, attachmentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTransitGatewayRouteTablePropagation indicates an expected call of DisableTransitGatewayRouteTablePropagation.
+func (mr *MockIEC2MockRecorder) DisableTransitGatewayRouteTablePropagation(ctx, routeTableId, attachmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTransitGatewayRouteTablePropagation", reflect.TypeOf((*MockIEC2)(nil).DisableTransitGatewayRouteTablePropagation), ctx, routeTableId, attachmentId)
+}
+
+// DisassociateTransitGatewayRouteTable mocks base method.
+func (m *MockIEC2) DisassociateTransitGatewayRouteTable(ctx context.Context, routeTableId, attachmentId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateTransitGatewayRouteTable", ctx, routeTableId, attachmentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisassociateTransitGatewayRouteTable indicates an expected call of DisassociateTransitGatewayRouteTable.
+func (mr *MockIEC2MockRecorder) DisassociateTransitGatewayRouteTable(ctx, routeTableId, attachmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateTransitGatewayRouteTable", reflect.TypeOf((*MockIEC2)(nil).DisassociateTransitGatewayRouteTable), ctx, routeTableId, attachmentId)
+}
+
+// GetTransitGatewayRouteTableAssociations mocks base method.
+func (m *MockIEC2) GetTransitGatewayRouteTableAssociations(ctx context.Context, routeTableId *string) ([]types.TransitGatewayRouteTableAssociation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitGatewayRouteTableAssociations", ctx, routeTableId)
+	ret0, _ := ret[0].([]types.TransitGatewayRouteTableAssociation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransitGatewayRouteTableAssociations indicates an expected call of GetTransitGatewayRouteTableAssociations.
+func (mr *MockIEC2MockRecorder) GetTransitGatewayRouteTableAssociations(ctx, routeTableId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitGatewayRouteTableAssociations", reflect.TypeOf((*MockIEC2)(nil).GetTransitGatewayRouteTableAssociations), ctx, routeTableId)
+}
+
+// GetTransitGatewayRouteTablePropagations mocks base method.
+func (m *MockIEC2) GetTransitGatewayRouteTablePropagations(ctx context.Context, routeTableId *string) ([]types.TransitGatewayRouteTablePropagation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitGatewayRouteTablePropagations", ctx, routeTableId)
+	ret0, _ := ret[0].([]types.TransitGatewayRouteTablePropagation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransitGatewayRouteTablePropagations indicates an expected call of GetTransitGatewayRouteTablePropagations.
+func (mr *MockIEC2MockRecorder) GetTransitGatewayRouteTablePropagations(ctx, routeTableId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitGatewayRouteTablePropagations", reflect.TypeOf((*MockIEC2)(nil).GetTransitGatewayRouteTablePropagations), ctx, routeTableId)
+}
+
+// RejectTransitGatewayPeeringAttachment mocks base method.
+func (m *MockIEC2) RejectTransitGatewayPeeringAttachment(ctx context.Context, attachmentId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectTransitGatewayPeeringAttachment", ctx, attachmentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectTransitGatewayPeeringAttachment indicates an expected call of RejectTransitGatewayPeeringAttachment.
+func (mr *MockIEC2MockRecorder) RejectTransitGatewayPeeringAttachment(ctx, attachmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectTransitGatewayPeeringAttachment", reflect.TypeOf((*MockIEC2)(nil).RejectTransitGatewayPeeringAttachment), ctx, attachmentId)
+}
+
+// RejectTransitGatewayVpcAttachment mocks base method.
+func (m *MockIEC2) RejectTransitGatewayVpcAttachment(ctx context.Context, attachmentId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectTransitGatewayVpcAttachment", ctx, attachmentId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectTransitGatewayVpcAttachment indicates an expected call of RejectTransitGatewayVpcAttachment.
+func (mr *MockIEC2MockRecorder) RejectTransitGatewayVpcAttachment(ctx, attachmentId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectTransitGatewayVpcAttachment", reflect.TypeOf((*MockIEC2)(nil).RejectTransitGatewayVpcAttachment), ctx, attachmentId)
+}
+
+// RejectVpcEndpointConnections mocks base method.
+func (m *MockIEC2) RejectVpcEndpointConnections(ctx context.Context, serviceId *string, vpcEndpointIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectVpcEndpointConnections", ctx, serviceId, vpcEndpointIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectVpcEndpointConnections indicates an expected call of RejectVpcEndpointConnections.
+func (mr *MockIEC2MockRecorder) RejectVpcEndpointConnections(ctx, serviceId, vpcEndpointIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectVpcEndpointConnections", reflect.TypeOf((*MockIEC2)(nil).RejectVpcEndpointConnections), ctx, serviceId, vpcEndpointIds)
+}
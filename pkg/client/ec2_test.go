@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,6 +15,32 @@ import (
 	"go.uber.org/goleak"
 )
 
+type tokenKeyForEC2Client struct{}
+
+func getNextTokenForEC2ClientInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	switch v := in.Parameters.(type) {
+	case *ec2.DescribeTransitGatewayAttachmentsInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForEC2Client{}, v.NextToken)
+	case *ec2.DescribeTransitGatewayRouteTablesInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForEC2Client{}, v.NextToken)
+	case *ec2.GetTransitGatewayRouteTablePropagationsInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForEC2Client{}, v.NextToken)
+	case *ec2.GetTransitGatewayRouteTableAssociationsInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForEC2Client{}, v.NextToken)
+	case *ec2.DescribeVpcEndpointConnectionsInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForEC2Client{}, v.NextToken)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+/*
+	Test Cases
+*/
+
 func TestEC2Client_DescribeNetworkInterfaces(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
@@ -705,3 +732,1878 @@ func TestEC2Client_DisableTerminationProtection(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2Client_DescribeTransitGateway(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		transitGatewayId   *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe transit gateway successfully",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewaysMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewaysOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe transit gateway failure",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewaysErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewaysOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeTransitGatewaysError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-1"),
+				Err:          fmt.Errorf("operation error EC2: DescribeTransitGateways, DescribeTransitGatewaysError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			_, err = ec2Client.DescribeTransitGateway(tt.args.ctx, tt.args.transitGatewayId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DeleteTransitGateway(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		transitGatewayId   *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete transit gateway successfully",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway failure",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteTransitGatewayError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-1"),
+				Err:          fmt.Errorf("operation error EC2: DeleteTransitGateway, DeleteTransitGatewayError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DeleteTransitGateway(tt.args.ctx, tt.args.transitGatewayId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DescribeTransitGatewayAttachments(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		transitGatewayId   *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.TransitGatewayAttachment
+		wantErr bool
+	}{
+		{
+			name: "describe transit gateway attachments successfully",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewayAttachmentsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewayAttachmentsOutput{
+										TransitGatewayAttachments: []types.TransitGatewayAttachment{
+											{
+												TransitGatewayAttachmentId: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe transit gateway attachments with next token successfully",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewayAttachmentsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEC2Client{}).(*string)
+
+								var nextToken *string
+								var items []types.TransitGatewayAttachment
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.TransitGatewayAttachment{
+										{
+											TransitGatewayAttachmentId: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.TransitGatewayAttachment{
+										{
+											TransitGatewayAttachmentId: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewayAttachmentsOutput{
+										TransitGatewayAttachments: items,
+										NextToken:                 nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String("Item1"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe transit gateway attachments failure",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewayAttachmentsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewayAttachmentsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeTransitGatewayAttachmentsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForEC2ClientInitialize), middleware.Before)
+				})
+			})
+			ec2Client := NewEC2Client(client)
+
+			output, err := ec2Client.DescribeTransitGatewayAttachments(tt.args.ctx, tt.args.transitGatewayId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DeleteTransitGatewayVpcAttachment(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		attachmentId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete transit gateway vpc attachment successfully",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayVpcAttachmentMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayVpcAttachmentOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway vpc attachment failure",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayVpcAttachmentErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayVpcAttachmentOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteTransitGatewayVpcAttachmentError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-attach-1"),
+				Err:          fmt.Errorf("operation error EC2: DeleteTransitGatewayVpcAttachment, DeleteTransitGatewayVpcAttachmentError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DeleteTransitGatewayVpcAttachment(tt.args.ctx, tt.args.attachmentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_RejectTransitGatewayVpcAttachment(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		attachmentId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "reject transit gateway vpc attachment successfully",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RejectTransitGatewayVpcAttachmentMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.RejectTransitGatewayVpcAttachmentOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "reject transit gateway vpc attachment failure",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RejectTransitGatewayVpcAttachmentErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.RejectTransitGatewayVpcAttachmentOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("RejectTransitGatewayVpcAttachmentError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-attach-1"),
+				Err:          fmt.Errorf("operation error EC2: RejectTransitGatewayVpcAttachment, RejectTransitGatewayVpcAttachmentError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.RejectTransitGatewayVpcAttachment(tt.args.ctx, tt.args.attachmentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DeleteTransitGatewayPeeringAttachment(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		attachmentId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete transit gateway peering attachment successfully",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayPeeringAttachmentMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayPeeringAttachmentOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway peering attachment failure",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayPeeringAttachmentErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayPeeringAttachmentOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteTransitGatewayPeeringAttachmentError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-attach-1"),
+				Err:          fmt.Errorf("operation error EC2: DeleteTransitGatewayPeeringAttachment, DeleteTransitGatewayPeeringAttachmentError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DeleteTransitGatewayPeeringAttachment(tt.args.ctx, tt.args.attachmentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_RejectTransitGatewayPeeringAttachment(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		attachmentId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "reject transit gateway peering attachment successfully",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RejectTransitGatewayPeeringAttachmentMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.RejectTransitGatewayPeeringAttachmentOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "reject transit gateway peering attachment failure",
+			args: args{
+				ctx:          context.Background(),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RejectTransitGatewayPeeringAttachmentErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.RejectTransitGatewayPeeringAttachmentOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("RejectTransitGatewayPeeringAttachmentError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-attach-1"),
+				Err:          fmt.Errorf("operation error EC2: RejectTransitGatewayPeeringAttachment, RejectTransitGatewayPeeringAttachmentError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.RejectTransitGatewayPeeringAttachment(tt.args.ctx, tt.args.attachmentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DescribeTransitGatewayRouteTables(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		transitGatewayId   *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.TransitGatewayRouteTable
+		wantErr bool
+	}{
+		{
+			name: "describe transit gateway route tables successfully",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewayRouteTablesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewayRouteTablesOutput{
+										TransitGatewayRouteTables: []types.TransitGatewayRouteTable{
+											{
+												TransitGatewayRouteTableId: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayRouteTable{
+				{
+					TransitGatewayRouteTableId: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe transit gateway route tables with next token successfully",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewayRouteTablesWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEC2Client{}).(*string)
+
+								var nextToken *string
+								var items []types.TransitGatewayRouteTable
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.TransitGatewayRouteTable{
+										{
+											TransitGatewayRouteTableId: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.TransitGatewayRouteTable{
+										{
+											TransitGatewayRouteTableId: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewayRouteTablesOutput{
+										TransitGatewayRouteTables: items,
+										NextToken:                 nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayRouteTable{
+				{
+					TransitGatewayRouteTableId: aws.String("Item1"),
+				},
+				{
+					TransitGatewayRouteTableId: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe transit gateway route tables failure",
+			args: args{
+				ctx:              context.Background(),
+				transitGatewayId: aws.String("tgw-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTransitGatewayRouteTablesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeTransitGatewayRouteTablesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeTransitGatewayRouteTablesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForEC2ClientInitialize), middleware.Before)
+				})
+			})
+			ec2Client := NewEC2Client(client)
+
+			output, err := ec2Client.DescribeTransitGatewayRouteTables(tt.args.ctx, tt.args.transitGatewayId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DeleteTransitGatewayRouteTable(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		routeTableId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete transit gateway route table successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayRouteTableMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayRouteTableOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete transit gateway route table failure",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteTransitGatewayRouteTableErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteTransitGatewayRouteTableOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteTransitGatewayRouteTableError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-rtb-1"),
+				Err:          fmt.Errorf("operation error EC2: DeleteTransitGatewayRouteTable, DeleteTransitGatewayRouteTableError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DeleteTransitGatewayRouteTable(tt.args.ctx, tt.args.routeTableId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_GetTransitGatewayRouteTablePropagations(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		routeTableId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.TransitGatewayRouteTablePropagation
+		wantErr bool
+	}{
+		{
+			name: "get transit gateway route table propagations successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetTransitGatewayRouteTablePropagationsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.GetTransitGatewayRouteTablePropagationsOutput{
+										TransitGatewayRouteTablePropagations: []types.TransitGatewayRouteTablePropagation{
+											{
+												TransitGatewayAttachmentId: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayRouteTablePropagation{
+				{
+					TransitGatewayAttachmentId: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get transit gateway route table propagations with next token successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetTransitGatewayRouteTablePropagationsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEC2Client{}).(*string)
+
+								var nextToken *string
+								var items []types.TransitGatewayRouteTablePropagation
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.TransitGatewayRouteTablePropagation{
+										{
+											TransitGatewayAttachmentId: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.TransitGatewayRouteTablePropagation{
+										{
+											TransitGatewayAttachmentId: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &ec2.GetTransitGatewayRouteTablePropagationsOutput{
+										TransitGatewayRouteTablePropagations: items,
+										NextToken:                            nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayRouteTablePropagation{
+				{
+					TransitGatewayAttachmentId: aws.String("Item1"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get transit gateway route table propagations failure",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetTransitGatewayRouteTablePropagationsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.GetTransitGatewayRouteTablePropagationsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetTransitGatewayRouteTablePropagationsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForEC2ClientInitialize), middleware.Before)
+				})
+			})
+			ec2Client := NewEC2Client(client)
+
+			output, err := ec2Client.GetTransitGatewayRouteTablePropagations(tt.args.ctx, tt.args.routeTableId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DisableTransitGatewayRouteTablePropagation(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		routeTableId       *string
+		attachmentId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "disable transit gateway route table propagation successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DisableTransitGatewayRouteTablePropagationMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DisableTransitGatewayRouteTablePropagationOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "disable transit gateway route table propagation failure",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DisableTransitGatewayRouteTablePropagationErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DisableTransitGatewayRouteTablePropagationOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DisableTransitGatewayRouteTablePropagationError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-rtb-1"),
+				Err:          fmt.Errorf("operation error EC2: DisableTransitGatewayRouteTablePropagation, DisableTransitGatewayRouteTablePropagationError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DisableTransitGatewayRouteTablePropagation(tt.args.ctx, tt.args.routeTableId, tt.args.attachmentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_GetTransitGatewayRouteTableAssociations(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		routeTableId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.TransitGatewayRouteTableAssociation
+		wantErr bool
+	}{
+		{
+			name: "get transit gateway route table associations successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetTransitGatewayRouteTableAssociationsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.GetTransitGatewayRouteTableAssociationsOutput{
+										Associations: []types.TransitGatewayRouteTableAssociation{
+											{
+												TransitGatewayAttachmentId: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayRouteTableAssociation{
+				{
+					TransitGatewayAttachmentId: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get transit gateway route table associations with next token successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetTransitGatewayRouteTableAssociationsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEC2Client{}).(*string)
+
+								var nextToken *string
+								var items []types.TransitGatewayRouteTableAssociation
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.TransitGatewayRouteTableAssociation{
+										{
+											TransitGatewayAttachmentId: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.TransitGatewayRouteTableAssociation{
+										{
+											TransitGatewayAttachmentId: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &ec2.GetTransitGatewayRouteTableAssociationsOutput{
+										Associations: items,
+										NextToken:    nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TransitGatewayRouteTableAssociation{
+				{
+					TransitGatewayAttachmentId: aws.String("Item1"),
+				},
+				{
+					TransitGatewayAttachmentId: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "get transit gateway route table associations failure",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"GetTransitGatewayRouteTableAssociationsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.GetTransitGatewayRouteTableAssociationsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("GetTransitGatewayRouteTableAssociationsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForEC2ClientInitialize), middleware.Before)
+				})
+			})
+			ec2Client := NewEC2Client(client)
+
+			output, err := ec2Client.GetTransitGatewayRouteTableAssociations(tt.args.ctx, tt.args.routeTableId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DisassociateTransitGatewayRouteTable(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		routeTableId       *string
+		attachmentId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "disassociate transit gateway route table successfully",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DisassociateTransitGatewayRouteTableMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DisassociateTransitGatewayRouteTableOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "disassociate transit gateway route table failure",
+			args: args{
+				ctx:          context.Background(),
+				routeTableId: aws.String("tgw-rtb-1"),
+				attachmentId: aws.String("tgw-attach-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DisassociateTransitGatewayRouteTableErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DisassociateTransitGatewayRouteTableOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DisassociateTransitGatewayRouteTableError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("tgw-rtb-1"),
+				Err:          fmt.Errorf("operation error EC2: DisassociateTransitGatewayRouteTable, DisassociateTransitGatewayRouteTableError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DisassociateTransitGatewayRouteTable(tt.args.ctx, tt.args.routeTableId, tt.args.attachmentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_CheckVpcEndpointServiceExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		serviceId          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check vpc endpoint service exists successfully",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeVpcEndpointServiceConfigurationsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeVpcEndpointServiceConfigurationsOutput{
+										ServiceConfigurations: []types.ServiceConfiguration{
+											{
+												ServiceId: aws.String("vpce-svc-1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check vpc endpoint service exists successfully for not found",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeVpcEndpointServiceConfigurationsNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeVpcEndpointServiceConfigurationsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("InvalidVpcEndpointServiceId.NotFound: The Vpc Endpoint Service Id 'vpce-svc-1' does not exist")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check vpc endpoint service exists failure",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeVpcEndpointServiceConfigurationsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeVpcEndpointServiceConfigurationsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeVpcEndpointServiceConfigurationsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			output, err := ec2Client.CheckVpcEndpointServiceExists(tt.args.ctx, tt.args.serviceId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DescribeVpcEndpointConnections(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		serviceId          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.VpcEndpointConnection
+		wantErr bool
+	}{
+		{
+			name: "describe vpc endpoint connections successfully",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeVpcEndpointConnectionsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeVpcEndpointConnectionsOutput{
+										VpcEndpointConnections: []types.VpcEndpointConnection{
+											{
+												VpcEndpointId: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.VpcEndpointConnection{
+				{
+					VpcEndpointId: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe vpc endpoint connections with next token successfully",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeVpcEndpointConnectionsWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEC2Client{}).(*string)
+
+								var nextToken *string
+								var items []types.VpcEndpointConnection
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.VpcEndpointConnection{
+										{
+											VpcEndpointId: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.VpcEndpointConnection{
+										{
+											VpcEndpointId: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeVpcEndpointConnectionsOutput{
+										VpcEndpointConnections: items,
+										NextToken:              nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.VpcEndpointConnection{
+				{
+					VpcEndpointId: aws.String("Item1"),
+				},
+				{
+					VpcEndpointId: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe vpc endpoint connections failure",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeVpcEndpointConnectionsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DescribeVpcEndpointConnectionsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeVpcEndpointConnectionsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForEC2ClientInitialize), middleware.Before)
+				})
+			})
+			ec2Client := NewEC2Client(client)
+
+			output, err := ec2Client.DescribeVpcEndpointConnections(tt.args.ctx, tt.args.serviceId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_RejectVpcEndpointConnections(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		serviceId          *string
+		vpcEndpointIds     []string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "reject vpc endpoint connections successfully",
+			args: args{
+				ctx:            context.Background(),
+				serviceId:      aws.String("vpce-svc-1"),
+				vpcEndpointIds: []string{"vpce-1"},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RejectVpcEndpointConnectionsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.RejectVpcEndpointConnectionsOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "reject vpc endpoint connections failure",
+			args: args{
+				ctx:            context.Background(),
+				serviceId:      aws.String("vpce-svc-1"),
+				vpcEndpointIds: []string{"vpce-1"},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RejectVpcEndpointConnectionsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.RejectVpcEndpointConnectionsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("RejectVpcEndpointConnectionsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("vpce-svc-1"),
+				Err:          fmt.Errorf("operation error EC2: RejectVpcEndpointConnections, RejectVpcEndpointConnectionsError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.RejectVpcEndpointConnections(tt.args.ctx, tt.args.serviceId, tt.args.vpcEndpointIds)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEC2Client_DeleteVpcEndpointServiceConfiguration(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		serviceId          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete vpc endpoint service configuration successfully",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteVpcEndpointServiceConfigurationsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteVpcEndpointServiceConfigurationsOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete vpc endpoint service configuration failure",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteVpcEndpointServiceConfigurationsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteVpcEndpointServiceConfigurationsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteVpcEndpointServiceConfigurationsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("vpce-svc-1"),
+				Err:          fmt.Errorf("operation error EC2: DeleteVpcEndpointServiceConfigurations, DeleteVpcEndpointServiceConfigurationsError"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete vpc endpoint service configuration failure for unsuccessful items",
+			args: args{
+				ctx:       context.Background(),
+				serviceId: aws.String("vpce-svc-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteVpcEndpointServiceConfigurationsUnsuccessfulMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ec2.DeleteVpcEndpointServiceConfigurationsOutput{
+										Unsuccessful: []types.UnsuccessfulItem{
+											{
+												Error: &types.UnsuccessfulItemError{
+													Code:    aws.String("ExistingVpcEndpointConnections"),
+													Message: aws.String("Service has existing active VPC Endpoint connections"),
+												},
+												ResourceId: aws.String("vpce-svc-1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("vpce-svc-1"),
+				Err:          fmt.Errorf("ExistingVpcEndpointConnections: Service has existing active VPC Endpoint connections"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ec2.NewFromConfig(cfg)
+			ec2Client := NewEC2Client(client)
+
+			err = ec2Client.DeleteVpcEndpointServiceConfiguration(tt.args.ctx, tt.args.serviceId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
@@ -3,14 +3,23 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 )
 
+// ecrBatchDeleteImageLimit is the maximum number of images that BatchDeleteImage accepts at a time.
+const ecrBatchDeleteImageLimit = 100
+
 type IEcr interface {
 	DeleteRepository(ctx context.Context, repositoryName *string) error
 	CheckEcrExists(ctx context.Context, repositoryName *string) (bool, error)
+	ListRepositoryNamesByPrefix(ctx context.Context, prefix *string) ([]string, error)
+	ListImageIds(ctx context.Context, repositoryName *string) ([]types.ImageIdentifier, error)
+	BatchDeleteImages(ctx context.Context, repositoryName *string, imageIds []types.ImageIdentifier) error
 }
 
 var _ IEcr = (*Ecr)(nil)
@@ -87,3 +96,127 @@ func (e *Ecr) CheckEcrExists(ctx context.Context, repositoryName *string) (bool,
 
 	return false, nil
 }
+
+// ListRepositoryNamesByPrefix returns the names of repositories under the given namespace prefix
+// (e.g. repositories created implicitly by a pull through cache rule as `<prefix>/<upstream-repository>`).
+func (e *Ecr) ListRepositoryNamesByPrefix(ctx context.Context, prefix *string) ([]string, error) {
+	var nextToken *string
+	repositoryNames := []string{}
+	namespace := *prefix + "/"
+
+	for {
+		select {
+		case <-ctx.Done():
+			return repositoryNames, &ClientError{
+				ResourceName: prefix,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ecr.DescribeRepositoriesInput{
+			NextToken: nextToken,
+		}
+
+		output, err := e.client.DescribeRepositories(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: prefix,
+				Err:          err,
+			}
+		}
+
+		for _, repository := range output.Repositories {
+			if strings.HasPrefix(*repository.RepositoryName, namespace) {
+				repositoryNames = append(repositoryNames, *repository.RepositoryName)
+			}
+		}
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return repositoryNames, nil
+}
+
+func (e *Ecr) ListImageIds(ctx context.Context, repositoryName *string) ([]types.ImageIdentifier, error) {
+	var nextToken *string
+	imageIds := []types.ImageIdentifier{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return imageIds, &ClientError{
+				ResourceName: repositoryName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &ecr.ListImagesInput{
+			RepositoryName: repositoryName,
+			NextToken:      nextToken,
+		}
+
+		output, err := e.client.ListImages(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: repositoryName,
+				Err:          err,
+			}
+		}
+
+		imageIds = append(imageIds, output.ImageIds...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return imageIds, nil
+}
+
+// BatchDeleteImages deletes the images in batches of the BatchDeleteImage limit. The images that
+// failed to be deleted are returned together as an error.
+func (e *Ecr) BatchDeleteImages(ctx context.Context, repositoryName *string, imageIds []types.ImageIdentifier) error {
+	failures := []string{}
+
+	for i := 0; i < len(imageIds); i += ecrBatchDeleteImageLimit {
+		end := min(i+ecrBatchDeleteImageLimit, len(imageIds))
+
+		input := &ecr.BatchDeleteImageInput{
+			RepositoryName: repositoryName,
+			ImageIds:       imageIds[i:end],
+		}
+
+		output, err := e.client.BatchDeleteImage(ctx, input)
+		if err != nil {
+			return &ClientError{
+				ResourceName: repositoryName,
+				Err:          err,
+			}
+		}
+
+		for _, failure := range output.Failures {
+			imageId := ""
+			if failure.ImageId != nil {
+				imageId = aws.ToString(failure.ImageId.ImageDigest)
+				if imageId == "" {
+					imageId = aws.ToString(failure.ImageId.ImageTag)
+				}
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", imageId, aws.ToString(failure.FailureReason)))
+		}
+	}
+
+	if len(failures) > 0 {
+		return &ClientError{
+			ResourceName: repositoryName,
+			Err:          fmt.Errorf("BatchDeleteImageError: %v images failed to be deleted, %s", len(failures), strings.Join(failures, ", ")),
+		}
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,6 +40,20 @@ func (m *MockIEcr) EXPECT() *MockIEcrMockRecorder {
 	return m.recorder
 }
 
+// BatchDeleteImages mocks base method.
+func (m *MockIEcr) BatchDeleteImages(ctx context.Context, repositoryName *string, imageIds []types.ImageIdentifier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDeleteImages", ctx, repositoryName, imageIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDeleteImages indicates an expected call of BatchDeleteImages.
+func (mr *MockIEcrMockRecorder) BatchDeleteImages(ctx, repositoryName, imageIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteImages", reflect.TypeOf((*MockIEcr)(nil).BatchDeleteImages), ctx, repositoryName, imageIds)
+}
+
 // CheckEcrExists mocks base method.
 func (m *MockIEcr) CheckEcrExists(ctx context.Context, repositoryName *string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -67,3 +82,33 @@ func (mr *MockIEcrMockRecorder) DeleteRepository(ctx, repositoryName any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockIEcr)(nil).DeleteRepository), ctx, repositoryName)
 }
+
+// ListImageIds mocks base method.
+func (m *MockIEcr) ListImageIds(ctx context.Context, repositoryName *string) ([]types.ImageIdentifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImageIds", ctx, repositoryName)
+	ret0, _ := ret[0].([]types.ImageIdentifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageIds indicates an expected call of ListImageIds.
+func (mr *MockIEcrMockRecorder) ListImageIds(ctx, repositoryName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageIds", reflect.TypeOf((*MockIEcr)(nil).ListImageIds), ctx, repositoryName)
+}
+
+// ListRepositoryNamesByPrefix mocks base method.
+func (m *MockIEcr) ListRepositoryNamesByPrefix(ctx context.Context, prefix *string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryNamesByPrefix", ctx, prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositoryNamesByPrefix indicates an expected call of ListRepositoryNamesByPrefix.
+func (mr *MockIEcrMockRecorder) ListRepositoryNamesByPrefix(ctx, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryNamesByPrefix", reflect.TypeOf((*MockIEcr)(nil).ListRepositoryNamesByPrefix), ctx, prefix)
+}
@@ -0,0 +1,72 @@
+//go:generate mockgen -source=$GOFILE -destination=ecr_public_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+)
+
+// EcrPublicRegion is the only region that provides the ECR Public API endpoint.
+const EcrPublicRegion = "us-east-1"
+
+type IEcrPublic interface {
+	DeleteRepository(ctx context.Context, repositoryName *string) error
+	CheckEcrPublicExists(ctx context.Context, repositoryName *string) (bool, error)
+}
+
+var _ IEcrPublic = (*EcrPublic)(nil)
+
+type EcrPublic struct {
+	client *ecrpublic.Client
+}
+
+func NewEcrPublic(client *ecrpublic.Client) *EcrPublic {
+	return &EcrPublic{
+		client,
+	}
+}
+
+func (e *EcrPublic) DeleteRepository(ctx context.Context, repositoryName *string) error {
+	input := &ecrpublic.DeleteRepositoryInput{
+		RepositoryName: repositoryName,
+		Force:          true,
+	}
+
+	_, err := e.client.DeleteRepository(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: repositoryName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (e *EcrPublic) CheckEcrPublicExists(ctx context.Context, repositoryName *string) (bool, error) {
+	input := &ecrpublic.DescribeRepositoriesInput{
+		RepositoryNames: []string{
+			*repositoryName,
+		},
+	}
+
+	output, err := e.client.DescribeRepositories(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "does not exist") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: repositoryName,
+			Err:          err,
+		}
+	}
+
+	for _, repository := range output.Repositories {
+		if *repository.RepositoryName == *repositoryName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
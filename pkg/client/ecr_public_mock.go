@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ecr_public.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ecr_public.go -destination=ecr_public_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEcrPublic is a mock of IEcrPublic interface.
+type MockIEcrPublic struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEcrPublicMockRecorder
+	isgomock struct{}
+}
+
+// MockIEcrPublicMockRecorder is the mock recorder for MockIEcrPublic.
+type MockIEcrPublicMockRecorder struct {
+	mock *MockIEcrPublic
+}
+
+// NewMockIEcrPublic creates a new mock instance.
+func NewMockIEcrPublic(ctrl *gomock.Controller) *MockIEcrPublic {
+	mock := &MockIEcrPublic{ctrl: ctrl}
+	mock.recorder = &MockIEcrPublicMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEcrPublic) EXPECT() *MockIEcrPublicMockRecorder {
+	return m.recorder
+}
+
+// CheckEcrPublicExists mocks base method.
+func (m *MockIEcrPublic) CheckEcrPublicExists(ctx context.Context, repositoryName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckEcrPublicExists", ctx, repositoryName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckEcrPublicExists indicates an expected call of CheckEcrPublicExists.
+func (mr *MockIEcrPublicMockRecorder) CheckEcrPublicExists(ctx, repositoryName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckEcrPublicExists", reflect.TypeOf((*MockIEcrPublic)(nil).CheckEcrPublicExists), ctx, repositoryName)
+}
+
+// DeleteRepository mocks base method.
+func (m *MockIEcrPublic) DeleteRepository(ctx context.Context, repositoryName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepository", ctx, repositoryName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepository indicates an expected call of DeleteRepository.
+func (mr *MockIEcrPublicMockRecorder) DeleteRepository(ctx, repositoryName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockIEcrPublic)(nil).DeleteRepository), ctx, repositoryName)
+}
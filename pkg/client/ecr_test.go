@@ -13,6 +13,20 @@ import (
 	"github.com/aws/smithy-go/middleware"
 )
 
+type tokenKeyForEcr struct{}
+
+func getNextTokenForEcrInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	switch v := in.Parameters.(type) {
+	case *ecr.DescribeRepositoriesInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForEcr{}, v.NextToken)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
 /*
 	Test Cases
 */
@@ -242,3 +256,469 @@ func TestEcr_CheckRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestEcr_ListRepositoryNamesByPrefix(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		prefix             *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	type want struct {
+		output []string
+		err    error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    want
+		wantErr bool
+	}{
+		{
+			name: "list repository names by prefix successfully",
+			args: args{
+				ctx:    context.Background(),
+				prefix: aws.String("ecr-public"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeRepositoriesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ecr.DescribeRepositoriesOutput{
+										Repositories: []types.Repository{
+											{
+												RepositoryName: aws.String("ecr-public/nginx/nginx"),
+											},
+											{
+												RepositoryName: aws.String("ecr-public-other/nginx"),
+											},
+											{
+												RepositoryName: aws.String("my-app"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: []string{"ecr-public/nginx/nginx"},
+				err:    nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "list repository names by prefix with next token successfully",
+			args: args{
+				ctx:    context.Background(),
+				prefix: aws.String("ecr-public"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					err := stack.Initialize.Add(
+						middleware.InitializeMiddlewareFunc(
+							"GetNextToken",
+							getNextTokenForEcrInitialize,
+						), middleware.Before,
+					)
+					if err != nil {
+						return err
+					}
+
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeRepositoriesWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, handler middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEcr{}).(*string)
+
+								if token == nil {
+									return middleware.FinalizeOutput{
+										Result: &ecr.DescribeRepositoriesOutput{
+											NextToken: aws.String("NextToken"),
+											Repositories: []types.Repository{
+												{
+													RepositoryName: aws.String("ecr-public/nginx/nginx"),
+												},
+											},
+										},
+									}, middleware.Metadata{}, nil
+								}
+								return middleware.FinalizeOutput{
+									Result: &ecr.DescribeRepositoriesOutput{
+										Repositories: []types.Repository{
+											{
+												RepositoryName: aws.String("ecr-public/docker/library/redis"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: []string{"ecr-public/nginx/nginx", "ecr-public/docker/library/redis"},
+				err:    nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "list repository names by prefix failure",
+			args: args{
+				ctx:    context.Background(),
+				prefix: aws.String("ecr-public"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeRepositoriesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ecr.DescribeRepositoriesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeRepositoriesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: nil,
+				err: &ClientError{
+					ResourceName: aws.String("ecr-public"),
+					Err:          fmt.Errorf("operation error ECR: DescribeRepositories, DescribeRepositoriesError"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ecr.NewFromConfig(cfg)
+			ecrClient := NewEcr(client)
+
+			output, err := ecrClient.ListRepositoryNamesByPrefix(tt.args.ctx, tt.args.prefix)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.err.Error() {
+				t.Errorf("err = %#v, want %#v", err.Error(), tt.want.err.Error())
+				return
+			}
+			if !reflect.DeepEqual(output, tt.want.output) {
+				t.Errorf("output = %#v, want %#v", output, tt.want.output)
+			}
+		})
+	}
+}
+
+func TestEcr_ListImageIds(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		repositoryName     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	type want struct {
+		output []types.ImageIdentifier
+		err    error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    want
+		wantErr bool
+	}{
+		{
+			name: "list image ids with next token successfully",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					err := stack.Initialize.Add(
+						middleware.InitializeMiddlewareFunc(
+							"GetNextToken",
+							func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+								if v, ok := in.Parameters.(*ecr.ListImagesInput); ok {
+									ctx = middleware.WithStackValue(ctx, tokenKeyForEcr{}, v.NextToken)
+								}
+								return next.HandleInitialize(ctx, in)
+							},
+						), middleware.Before,
+					)
+					if err != nil {
+						return err
+					}
+
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListImagesWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, handler middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForEcr{}).(*string)
+
+								if token == nil {
+									return middleware.FinalizeOutput{
+										Result: &ecr.ListImagesOutput{
+											NextToken: aws.String("NextToken"),
+											ImageIds: []types.ImageIdentifier{
+												{
+													ImageDigest: aws.String("sha256:1"),
+													ImageTag:    aws.String("latest"),
+												},
+											},
+										},
+									}, middleware.Metadata{}, nil
+								}
+								return middleware.FinalizeOutput{
+									Result: &ecr.ListImagesOutput{
+										ImageIds: []types.ImageIdentifier{
+											{
+												ImageDigest: aws.String("sha256:2"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: []types.ImageIdentifier{
+					{
+						ImageDigest: aws.String("sha256:1"),
+						ImageTag:    aws.String("latest"),
+					},
+					{
+						ImageDigest: aws.String("sha256:2"),
+					},
+				},
+				err: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "list image ids failure",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListImagesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ecr.ListImagesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListImagesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: want{
+				output: nil,
+				err: &ClientError{
+					ResourceName: aws.String("test"),
+					Err:          fmt.Errorf("operation error ECR: ListImages, ListImagesError"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ecr.NewFromConfig(cfg)
+			ecrClient := NewEcr(client)
+
+			output, err := ecrClient.ListImageIds(tt.args.ctx, tt.args.repositoryName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.err.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want.err)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want.output) {
+				t.Errorf("output = %#v, want %#v", output, tt.want.output)
+			}
+		})
+	}
+}
+
+func TestEcr_BatchDeleteImages(t *testing.T) {
+	imageIds := make([]types.ImageIdentifier, 150)
+	for i := range imageIds {
+		imageIds[i] = types.ImageIdentifier{ImageDigest: aws.String(fmt.Sprintf("sha256:%d", i))}
+	}
+
+	type args struct {
+		ctx                context.Context
+		repositoryName     *string
+		imageIds           []types.ImageIdentifier
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name      string
+		args      args
+		wantCalls int
+		want      error
+		wantErr   bool
+	}{
+		{
+			name: "batch delete images in batches successfully",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+				imageIds:       imageIds,
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"BatchDeleteImageMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ecr.BatchDeleteImageOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantCalls: 2,
+			want:      nil,
+			wantErr:   false,
+		},
+		{
+			name: "batch delete images with failures",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+				imageIds:       imageIds[:2],
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"BatchDeleteImageFailuresMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ecr.BatchDeleteImageOutput{
+										Failures: []types.ImageFailure{
+											{
+												ImageId:       &types.ImageIdentifier{ImageDigest: aws.String("sha256:1")},
+												FailureReason: aws.String("Requested image referenced by manifest list"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantCalls: 1,
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("BatchDeleteImageError: 1 images failed to be deleted, sha256:1: Requested image referenced by manifest list"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "batch delete images failure",
+			args: args{
+				ctx:            context.Background(),
+				repositoryName: aws.String("test"),
+				imageIds:       imageIds[:2],
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"BatchDeleteImageErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &ecr.BatchDeleteImageOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("BatchDeleteImageError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantCalls: 1,
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ECR: BatchDeleteImage, BatchDeleteImageError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{
+					tt.args.withAPIOptionsFunc,
+					func(stack *middleware.Stack) error {
+						return stack.Initialize.Add(
+							middleware.InitializeMiddlewareFunc(
+								"CountCalls",
+								func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+									calls++
+									return next.HandleInitialize(ctx, in)
+								},
+							), middleware.Before,
+						)
+					},
+				}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := ecr.NewFromConfig(cfg)
+			ecrClient := NewEcr(client)
+
+			err = ecrClient.BatchDeleteImages(tt.args.ctx, tt.args.repositoryName, tt.args.imageIds)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
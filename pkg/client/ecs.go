@@ -0,0 +1,43 @@
+//go:generate mockgen -source=$GOFILE -destination=ecs_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+type IECS interface {
+	ScaleServiceToZero(ctx context.Context, clusterName *string, serviceArn *string) error
+}
+
+var _ IECS = (*ECS)(nil)
+
+type ECS struct {
+	client *ecs.Client
+}
+
+func NewECS(client *ecs.Client) *ECS {
+	return &ECS{
+		client,
+	}
+}
+
+// ScaleServiceToZero sets the desired count of the service to 0, so that all the tasks start stopping.
+func (e *ECS) ScaleServiceToZero(ctx context.Context, clusterName *string, serviceArn *string) error {
+	input := &ecs.UpdateServiceInput{
+		Cluster:      clusterName,
+		Service:      serviceArn,
+		DesiredCount: aws.Int32(0),
+	}
+
+	_, err := e.client.UpdateService(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: serviceArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ecs.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ecs.go -destination=ecs_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIECS is a mock of IECS interface.
+type MockIECS struct {
+	ctrl     *gomock.Controller
+	recorder *MockIECSMockRecorder
+	isgomock struct{}
+}
+
+// MockIECSMockRecorder is the mock recorder for MockIECS.
+type MockIECSMockRecorder struct {
+	mock *MockIECS
+}
+
+// NewMockIECS creates a new mock instance.
+func NewMockIECS(ctrl *gomock.Controller) *MockIECS {
+	mock := &MockIECS{ctrl: ctrl}
+	mock.recorder = &MockIECSMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIECS) EXPECT() *MockIECSMockRecorder {
+	return m.recorder
+}
+
+// ScaleServiceToZero mocks base method.
+func (m *MockIECS) ScaleServiceToZero(ctx context.Context, clusterName, serviceArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleServiceToZero", ctx, clusterName, serviceArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleServiceToZero indicates an expected call of ScaleServiceToZero.
+func (mr *MockIECSMockRecorder) ScaleServiceToZero(ctx, clusterName, serviceArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleServiceToZero", reflect.TypeOf((*MockIECS)(nil).ScaleServiceToZero), ctx, clusterName, serviceArn)
+}
@@ -0,0 +1,302 @@
+//go:generate mockgen -source=$GOFILE -destination=elasticache_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+)
+
+var SleepTimeSecForElastiCache = 5
+
+type IElastiCache interface {
+	DescribeReplicationGroup(ctx context.Context, replicationGroupId *string) (*types.ReplicationGroup, error)
+	DescribeGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string) (*types.GlobalReplicationGroup, error)
+	DisassociateGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string, replicationGroupId *string, replicationGroupRegion *string) error
+	DeleteGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string) error
+	RemoveReplicationGroupUserGroups(ctx context.Context, replicationGroupId *string, userGroupIds []string) error
+	DeleteReplicationGroup(ctx context.Context, replicationGroupId *string, finalSnapshotIdentifier *string) error
+	CheckReplicationGroupExists(ctx context.Context, replicationGroupId *string) (bool, error)
+	DescribeServerlessCache(ctx context.Context, serverlessCacheName *string) (*types.ServerlessCache, error)
+	RemoveServerlessCacheUserGroup(ctx context.Context, serverlessCacheName *string) error
+	DeleteServerlessCache(ctx context.Context, serverlessCacheName *string, finalSnapshotName *string) error
+	CheckServerlessCacheExists(ctx context.Context, serverlessCacheName *string) (bool, error)
+}
+
+var _ IElastiCache = (*ElastiCache)(nil)
+
+type ElastiCache struct {
+	client  *elasticache.Client
+	retryer *Retryer
+}
+
+func NewElastiCache(client *elasticache.Client) *ElastiCache {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "Throttling")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForElastiCache)
+
+	return &ElastiCache{
+		client,
+		retryer,
+	}
+}
+
+func (e *ElastiCache) DescribeReplicationGroup(ctx context.Context, replicationGroupId *string) (*types.ReplicationGroup, error) {
+	input := &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: replicationGroupId,
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	output, err := e.client.DescribeReplicationGroups(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: replicationGroupId,
+			Err:          err,
+		}
+	}
+	if len(output.ReplicationGroups) == 0 {
+		return nil, &ClientError{
+			ResourceName: replicationGroupId,
+			Err:          fmt.Errorf("ReplicationGroupNotFoundError: the replication group does not exist"),
+		}
+	}
+
+	return &output.ReplicationGroups[0], nil
+}
+
+func (e *ElastiCache) DescribeGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string) (*types.GlobalReplicationGroup, error) {
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: globalReplicationGroupId,
+		ShowMemberInfo:           aws.Bool(true),
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	output, err := e.client.DescribeGlobalReplicationGroups(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: globalReplicationGroupId,
+			Err:          err,
+		}
+	}
+	if len(output.GlobalReplicationGroups) == 0 {
+		return nil, &ClientError{
+			ResourceName: globalReplicationGroupId,
+			Err:          fmt.Errorf("GlobalReplicationGroupNotFoundError: the global replication group does not exist"),
+		}
+	}
+
+	return &output.GlobalReplicationGroups[0], nil
+}
+
+func (e *ElastiCache) DisassociateGlobalReplicationGroup(
+	ctx context.Context,
+	globalReplicationGroupId *string,
+	replicationGroupId *string,
+	replicationGroupRegion *string,
+) error {
+	input := &elasticache.DisassociateGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: globalReplicationGroupId,
+		ReplicationGroupId:       replicationGroupId,
+		ReplicationGroupRegion:   replicationGroupRegion,
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	_, err := e.client.DisassociateGlobalReplicationGroup(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: replicationGroupId,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+// DeleteGlobalReplicationGroup deletes the global datastore while keeping its primary replication group,
+// which is the only way to detach the primary when it has no secondary members.
+func (e *ElastiCache) DeleteGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string) error {
+	input := &elasticache.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      globalReplicationGroupId,
+		RetainPrimaryReplicationGroup: aws.Bool(true),
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	_, err := e.client.DeleteGlobalReplicationGroup(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: globalReplicationGroupId,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (e *ElastiCache) RemoveReplicationGroupUserGroups(ctx context.Context, replicationGroupId *string, userGroupIds []string) error {
+	input := &elasticache.ModifyReplicationGroupInput{
+		ReplicationGroupId:   replicationGroupId,
+		UserGroupIdsToRemove: userGroupIds,
+		ApplyImmediately:     aws.Bool(true),
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	_, err := e.client.ModifyReplicationGroup(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: replicationGroupId,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (e *ElastiCache) DeleteReplicationGroup(ctx context.Context, replicationGroupId *string, finalSnapshotIdentifier *string) error {
+	input := &elasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId:      replicationGroupId,
+		FinalSnapshotIdentifier: finalSnapshotIdentifier,
+		RetainPrimaryCluster:    aws.Bool(false),
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	_, err := e.client.DeleteReplicationGroup(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: replicationGroupId,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (e *ElastiCache) CheckReplicationGroupExists(ctx context.Context, replicationGroupId *string) (bool, error) {
+	input := &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: replicationGroupId,
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	output, err := e.client.DescribeReplicationGroups(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ReplicationGroupNotFoundFault") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: replicationGroupId,
+			Err:          err,
+		}
+	}
+
+	return len(output.ReplicationGroups) > 0, nil
+}
+
+func (e *ElastiCache) DescribeServerlessCache(ctx context.Context, serverlessCacheName *string) (*types.ServerlessCache, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: serverlessCacheName,
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	output, err := e.client.DescribeServerlessCaches(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: serverlessCacheName,
+			Err:          err,
+		}
+	}
+	if len(output.ServerlessCaches) == 0 {
+		return nil, &ClientError{
+			ResourceName: serverlessCacheName,
+			Err:          fmt.Errorf("ServerlessCacheNotFoundError: the serverless cache does not exist"),
+		}
+	}
+
+	return &output.ServerlessCaches[0], nil
+}
+
+func (e *ElastiCache) RemoveServerlessCacheUserGroup(ctx context.Context, serverlessCacheName *string) error {
+	input := &elasticache.ModifyServerlessCacheInput{
+		ServerlessCacheName: serverlessCacheName,
+		RemoveUserGroup:     aws.Bool(true),
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	_, err := e.client.ModifyServerlessCache(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: serverlessCacheName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (e *ElastiCache) DeleteServerlessCache(ctx context.Context, serverlessCacheName *string, finalSnapshotName *string) error {
+	input := &elasticache.DeleteServerlessCacheInput{
+		ServerlessCacheName: serverlessCacheName,
+		FinalSnapshotName:   finalSnapshotName,
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	_, err := e.client.DeleteServerlessCache(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: serverlessCacheName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (e *ElastiCache) CheckServerlessCacheExists(ctx context.Context, serverlessCacheName *string) (bool, error) {
+	input := &elasticache.DescribeServerlessCachesInput{
+		ServerlessCacheName: serverlessCacheName,
+	}
+
+	optFn := func(o *elasticache.Options) {
+		o.Retryer = e.retryer
+	}
+
+	output, err := e.client.DescribeServerlessCaches(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ServerlessCacheNotFoundFault") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: serverlessCacheName,
+			Err:          err,
+		}
+	}
+
+	return len(output.ServerlessCaches) > 0, nil
+}
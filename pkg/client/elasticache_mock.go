@@ -0,0 +1,200 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: elasticache.go
+//
+// Generated by this command:
+//
+//	mockgen -source=elasticache.go -destination=elasticache_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIElastiCache is a mock of IElastiCache interface.
+type MockIElastiCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockIElastiCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockIElastiCacheMockRecorder is the mock recorder for MockIElastiCache.
+type MockIElastiCacheMockRecorder struct {
+	mock *MockIElastiCache
+}
+
+// NewMockIElastiCache creates a new mock instance.
+func NewMockIElastiCache(ctrl *gomock.Controller) *MockIElastiCache {
+	mock := &MockIElastiCache{ctrl: ctrl}
+	mock.recorder = &MockIElastiCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIElastiCache) EXPECT() *MockIElastiCacheMockRecorder {
+	return m.recorder
+}
+
+// CheckReplicationGroupExists mocks base method.
+func (m *MockIElastiCache) CheckReplicationGroupExists(ctx context.Context, replicationGroupId *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckReplicationGroupExists", ctx, replicationGroupId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckReplicationGroupExists indicates an expected call of CheckReplicationGroupExists.
+func (mr *MockIElastiCacheMockRecorder) CheckReplicationGroupExists(ctx, replicationGroupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckReplicationGroupExists", reflect.TypeOf((*MockIElastiCache)(nil).CheckReplicationGroupExists), ctx, replicationGroupId)
+}
+
+// CheckServerlessCacheExists mocks base method.
+func (m *MockIElastiCache) CheckServerlessCacheExists(ctx context.Context, serverlessCacheName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckServerlessCacheExists", ctx, serverlessCacheName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckServerlessCacheExists indicates an expected call of CheckServerlessCacheExists.
+func (mr *MockIElastiCacheMockRecorder) CheckServerlessCacheExists(ctx, serverlessCacheName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckServerlessCacheExists", reflect.TypeOf((*MockIElastiCache)(nil).CheckServerlessCacheExists), ctx, serverlessCacheName)
+}
+
+// DeleteGlobalReplicationGroup mocks base method.
+func (m *MockIElastiCache) DeleteGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalReplicationGroup", ctx, globalReplicationGroupId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGlobalReplicationGroup indicates an expected call of DeleteGlobalReplicationGroup.
+func (mr *MockIElastiCacheMockRecorder) DeleteGlobalReplicationGroup(ctx, globalReplicationGroupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalReplicationGroup", reflect.TypeOf((*MockIElastiCache)(nil).DeleteGlobalReplicationGroup), ctx, globalReplicationGroupId)
+}
+
+// DeleteReplicationGroup mocks base method.
+func (m *MockIElastiCache) DeleteReplicationGroup(ctx context.Context, replicationGroupId, finalSnapshotIdentifier *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReplicationGroup", ctx, replicationGroupId, finalSnapshotIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReplicationGroup indicates an expected call of DeleteReplicationGroup.
+func (mr *MockIElastiCacheMockRecorder) DeleteReplicationGroup(ctx, replicationGroupId, finalSnapshotIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplicationGroup", reflect.TypeOf((*MockIElastiCache)(nil).DeleteReplicationGroup), ctx, replicationGroupId, finalSnapshotIdentifier)
+}
+
+// DeleteServerlessCache mocks base method.
+func (m *MockIElastiCache) DeleteServerlessCache(ctx context.Context, serverlessCacheName, finalSnapshotName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServerlessCache", ctx, serverlessCacheName, finalSnapshotName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServerlessCache indicates an expected call of DeleteServerlessCache.
+func (mr *MockIElastiCacheMockRecorder) DeleteServerlessCache(ctx, serverlessCacheName, finalSnapshotName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServerlessCache", reflect.TypeOf((*MockIElastiCache)(nil).DeleteServerlessCache), ctx, serverlessCacheName, finalSnapshotName)
+}
+
+// DescribeGlobalReplicationGroup mocks base method.
+func (m *MockIElastiCache) DescribeGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId *string) (*types.GlobalReplicationGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeGlobalReplicationGroup", ctx, globalReplicationGroupId)
+	ret0, _ := ret[0].(*types.GlobalReplicationGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeGlobalReplicationGroup indicates an expected call of DescribeGlobalReplicationGroup.
+func (mr *MockIElastiCacheMockRecorder) DescribeGlobalReplicationGroup(ctx, globalReplicationGroupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalReplicationGroup", reflect.TypeOf((*MockIElastiCache)(nil).DescribeGlobalReplicationGroup), ctx, globalReplicationGroupId)
+}
+
+// DescribeReplicationGroup mocks base method.
+func (m *MockIElastiCache) DescribeReplicationGroup(ctx context.Context, replicationGroupId *string) (*types.ReplicationGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationGroup", ctx, replicationGroupId)
+	ret0, _ := ret[0].(*types.ReplicationGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationGroup indicates an expected call of DescribeReplicationGroup.
+func (mr *MockIElastiCacheMockRecorder) DescribeReplicationGroup(ctx, replicationGroupId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationGroup", reflect.TypeOf((*MockIElastiCache)(nil).DescribeReplicationGroup), ctx, replicationGroupId)
+}
+
+// DescribeServerlessCache mocks base method.
+func (m *MockIElastiCache) DescribeServerlessCache(ctx context.Context, serverlessCacheName *string) (*types.ServerlessCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeServerlessCache", ctx, serverlessCacheName)
+	ret0, _ := ret[0].(*types.ServerlessCache)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeServerlessCache indicates an expected call of DescribeServerlessCache.
+func (mr *MockIElastiCacheMockRecorder) DescribeServerlessCache(ctx, serverlessCacheName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeServerlessCache", reflect.TypeOf((*MockIElastiCache)(nil).DescribeServerlessCache), ctx, serverlessCacheName)
+}
+
+// DisassociateGlobalReplicationGroup mocks base method.
+func (m *MockIElastiCache) DisassociateGlobalReplicationGroup(ctx context.Context, globalReplicationGroupId, replicationGroupId, replicationGroupRegion *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateGlobalReplicationGroup", ctx, globalReplicationGroupId, replicationGroupId, replicationGroupRegion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisassociateGlobalReplicationGroup indicates an expected call of DisassociateGlobalReplicationGroup.
+func (mr *MockIElastiCacheMockRecorder) DisassociateGlobalReplicationGroup(ctx, globalReplicationGroupId, replicationGroupId, replicationGroupRegion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateGlobalReplicationGroup", reflect.TypeOf((*MockIElastiCache)(nil).DisassociateGlobalReplicationGroup), ctx, globalReplicationGroupId, replicationGroupId, replicationGroupRegion)
+}
+
+// RemoveReplicationGroupUserGroups mocks base method.
+func (m *MockIElastiCache) RemoveReplicationGroupUserGroups(ctx context.Context, replicationGroupId *string, userGroupIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReplicationGroupUserGroups", ctx, replicationGroupId, userGroupIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReplicationGroupUserGroups indicates an expected call of RemoveReplicationGroupUserGroups.
+func (mr *MockIElastiCacheMockRecorder) RemoveReplicationGroupUserGroups(ctx, replicationGroupId, userGroupIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReplicationGroupUserGroups", reflect.TypeOf((*MockIElastiCache)(nil).RemoveReplicationGroupUserGroups), ctx, replicationGroupId, userGroupIds)
+}
+
+// RemoveServerlessCacheUserGroup mocks base method.
+func (m *MockIElastiCache) RemoveServerlessCacheUserGroup(ctx context.Context, serverlessCacheName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveServerlessCacheUserGroup", ctx, serverlessCacheName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveServerlessCacheUserGroup indicates an expected call of RemoveServerlessCacheUserGroup.
+func (mr *MockIElastiCacheMockRecorder) RemoveServerlessCacheUserGroup(ctx, serverlessCacheName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServerlessCacheUserGroup", reflect.TypeOf((*MockIElastiCache)(nil).RemoveServerlessCacheUserGroup), ctx, serverlessCacheName)
+}
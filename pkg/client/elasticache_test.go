@@ -0,0 +1,1044 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+/*
+	Test Cases
+*/
+
+func TestElastiCache_DescribeReplicationGroup(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		replicationGroupId *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe replication group successfully",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeReplicationGroupsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeReplicationGroupsOutput{
+										ReplicationGroups: []types.ReplicationGroup{{ReplicationGroupId: aws.String("test")}},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe replication group failure",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeReplicationGroupsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeReplicationGroupsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeReplicationGroupsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: DescribeReplicationGroups, DescribeReplicationGroupsError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			_, err = elastiCacheClient.DescribeReplicationGroup(tt.args.ctx, tt.args.replicationGroupId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_DescribeGlobalReplicationGroup(t *testing.T) {
+	type args struct {
+		ctx                      context.Context
+		globalReplicationGroupId *string
+		withAPIOptionsFunc       func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe global replication group successfully",
+			args: args{
+				ctx:                      context.Background(),
+				globalReplicationGroupId: aws.String("global"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeGlobalReplicationGroupsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeGlobalReplicationGroupsOutput{
+										GlobalReplicationGroups: []types.GlobalReplicationGroup{{GlobalReplicationGroupId: aws.String("global")}},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe global replication group failure",
+			args: args{
+				ctx:                      context.Background(),
+				globalReplicationGroupId: aws.String("global"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeGlobalReplicationGroupsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeGlobalReplicationGroupsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeGlobalReplicationGroupsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("global"),
+				Err:          fmt.Errorf("operation error ElastiCache: DescribeGlobalReplicationGroups, DescribeGlobalReplicationGroupsError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			_, err = elastiCacheClient.DescribeGlobalReplicationGroup(tt.args.ctx, tt.args.globalReplicationGroupId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_DisassociateGlobalReplicationGroup(t *testing.T) {
+	type args struct {
+		ctx                      context.Context
+		globalReplicationGroupId *string
+		replicationGroupId       *string
+		replicationGroupRegion   *string
+		withAPIOptionsFunc       func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "disassociate global replication group successfully",
+			args: args{
+				ctx:                      context.Background(),
+				globalReplicationGroupId: aws.String("global"),
+				replicationGroupId:       aws.String("test"),
+				replicationGroupRegion:   aws.String("us-east-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DisassociateGlobalReplicationGroupMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DisassociateGlobalReplicationGroupOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "disassociate global replication group failure",
+			args: args{
+				ctx:                      context.Background(),
+				globalReplicationGroupId: aws.String("global"),
+				replicationGroupId:       aws.String("test"),
+				replicationGroupRegion:   aws.String("us-east-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DisassociateGlobalReplicationGroupErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DisassociateGlobalReplicationGroupOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DisassociateGlobalReplicationGroupError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: DisassociateGlobalReplicationGroup, DisassociateGlobalReplicationGroupError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			err = elastiCacheClient.DisassociateGlobalReplicationGroup(tt.args.ctx, tt.args.globalReplicationGroupId, tt.args.replicationGroupId, tt.args.replicationGroupRegion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_DeleteGlobalReplicationGroup(t *testing.T) {
+	type args struct {
+		ctx                      context.Context
+		globalReplicationGroupId *string
+		withAPIOptionsFunc       func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete global replication group successfully",
+			args: args{
+				ctx:                      context.Background(),
+				globalReplicationGroupId: aws.String("global"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteGlobalReplicationGroupMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DeleteGlobalReplicationGroupOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete global replication group failure",
+			args: args{
+				ctx:                      context.Background(),
+				globalReplicationGroupId: aws.String("global"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteGlobalReplicationGroupErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DeleteGlobalReplicationGroupOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteGlobalReplicationGroupError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("global"),
+				Err:          fmt.Errorf("operation error ElastiCache: DeleteGlobalReplicationGroup, DeleteGlobalReplicationGroupError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			err = elastiCacheClient.DeleteGlobalReplicationGroup(tt.args.ctx, tt.args.globalReplicationGroupId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_RemoveReplicationGroupUserGroups(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		replicationGroupId *string
+		userGroupIds       []string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "remove replication group user groups successfully",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				userGroupIds:       []string{"user-group"},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ModifyReplicationGroupMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.ModifyReplicationGroupOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "remove replication group user groups failure",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				userGroupIds:       []string{"user-group"},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ModifyReplicationGroupErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.ModifyReplicationGroupOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ModifyReplicationGroupError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: ModifyReplicationGroup, ModifyReplicationGroupError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			err = elastiCacheClient.RemoveReplicationGroupUserGroups(tt.args.ctx, tt.args.replicationGroupId, tt.args.userGroupIds)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_DeleteReplicationGroup(t *testing.T) {
+	type args struct {
+		ctx                     context.Context
+		replicationGroupId      *string
+		finalSnapshotIdentifier *string
+		withAPIOptionsFunc      func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete replication group successfully",
+			args: args{
+				ctx:                     context.Background(),
+				replicationGroupId:      aws.String("test"),
+				finalSnapshotIdentifier: aws.String("test-final"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteReplicationGroupMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DeleteReplicationGroupOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete replication group failure",
+			args: args{
+				ctx:                     context.Background(),
+				replicationGroupId:      aws.String("test"),
+				finalSnapshotIdentifier: aws.String("test-final"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteReplicationGroupErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DeleteReplicationGroupOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteReplicationGroupError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: DeleteReplicationGroup, DeleteReplicationGroupError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			err = elastiCacheClient.DeleteReplicationGroup(tt.args.ctx, tt.args.replicationGroupId, tt.args.finalSnapshotIdentifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_CheckReplicationGroupExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		replicationGroupId *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check replication group exists successfully",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeReplicationGroupsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: []types.ReplicationGroup{{ReplicationGroupId: aws.String("test")}}},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check replication group exists successfully for not found",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeReplicationGroupsNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeReplicationGroupsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ReplicationGroupNotFoundFault")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check replication group exists failure",
+			args: args{
+				ctx:                context.Background(),
+				replicationGroupId: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeReplicationGroupsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeReplicationGroupsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeReplicationGroupsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			output, err := elastiCacheClient.CheckReplicationGroupExists(tt.args.ctx, tt.args.replicationGroupId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_DescribeServerlessCache(t *testing.T) {
+	type args struct {
+		ctx                 context.Context
+		serverlessCacheName *string
+		withAPIOptionsFunc  func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe serverless cache successfully",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeServerlessCachesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeServerlessCachesOutput{
+										ServerlessCaches: []types.ServerlessCache{{ServerlessCacheName: aws.String("test")}},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe serverless cache failure",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeServerlessCachesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeServerlessCachesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeServerlessCachesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: DescribeServerlessCaches, DescribeServerlessCachesError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			_, err = elastiCacheClient.DescribeServerlessCache(tt.args.ctx, tt.args.serverlessCacheName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_RemoveServerlessCacheUserGroup(t *testing.T) {
+	type args struct {
+		ctx                 context.Context
+		serverlessCacheName *string
+		withAPIOptionsFunc  func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "remove serverless cache user group successfully",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ModifyServerlessCacheMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.ModifyServerlessCacheOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "remove serverless cache user group failure",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ModifyServerlessCacheErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.ModifyServerlessCacheOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ModifyServerlessCacheError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: ModifyServerlessCache, ModifyServerlessCacheError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			err = elastiCacheClient.RemoveServerlessCacheUserGroup(tt.args.ctx, tt.args.serverlessCacheName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_DeleteServerlessCache(t *testing.T) {
+	type args struct {
+		ctx                 context.Context
+		serverlessCacheName *string
+		finalSnapshotName   *string
+		withAPIOptionsFunc  func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete serverless cache successfully",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				finalSnapshotName:   aws.String("test-final"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteServerlessCacheMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DeleteServerlessCacheOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete serverless cache failure",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				finalSnapshotName:   aws.String("test-final"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteServerlessCacheErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DeleteServerlessCacheOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteServerlessCacheError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error ElastiCache: DeleteServerlessCache, DeleteServerlessCacheError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			err = elastiCacheClient.DeleteServerlessCache(tt.args.ctx, tt.args.serverlessCacheName, tt.args.finalSnapshotName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestElastiCache_CheckServerlessCacheExists(t *testing.T) {
+	type args struct {
+		ctx                 context.Context
+		serverlessCacheName *string
+		withAPIOptionsFunc  func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check serverless cache exists successfully",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeServerlessCachesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeServerlessCachesOutput{ServerlessCaches: []types.ServerlessCache{{ServerlessCacheName: aws.String("test")}}},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check serverless cache exists successfully for not found",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeServerlessCachesNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeServerlessCachesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ServerlessCacheNotFoundFault")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check serverless cache exists failure",
+			args: args{
+				ctx:                 context.Background(),
+				serverlessCacheName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeServerlessCachesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticache.DescribeServerlessCachesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeServerlessCachesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticache.NewFromConfig(cfg)
+			elastiCacheClient := NewElastiCache(client)
+
+			output, err := elastiCacheClient.CheckServerlessCacheExists(tt.args.ctx, tt.args.serverlessCacheName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
@@ -12,6 +12,15 @@ import (
 type IELBV2 interface {
 	CheckLoadBalancerDeletionProtection(ctx context.Context, loadBalancerArn *string) (bool, error)
 	DisableLoadBalancerDeletionProtection(ctx context.Context, loadBalancerArn *string) error
+	DescribeListeners(ctx context.Context, loadBalancerArn *string) ([]types.Listener, error)
+	DescribeListenerCertificates(ctx context.Context, listenerArn *string) ([]types.Certificate, error)
+	ModifyListenerDefaultCertificate(ctx context.Context, listenerArn *string, certificateArn *string) error
+	RemoveListenerCertificate(ctx context.Context, listenerArn *string, certificateArn *string) error
+	DescribeTargetGroupLoadBalancerArns(ctx context.Context, targetGroupArn *string) ([]string, error)
+	DescribeRules(ctx context.Context, listenerArn *string) ([]types.Rule, error)
+	DeleteRule(ctx context.Context, ruleArn *string) error
+	DescribeTargets(ctx context.Context, targetGroupArn *string) ([]types.TargetDescription, error)
+	DeregisterTargets(ctx context.Context, targetGroupArn *string, targets []types.TargetDescription) error
 }
 
 var _ IELBV2 = (*ELBV2)(nil)
@@ -69,3 +78,238 @@ func (e *ELBV2) DisableLoadBalancerDeletionProtection(ctx context.Context, loadB
 
 	return nil
 }
+
+func (e *ELBV2) DescribeListeners(ctx context.Context, loadBalancerArn *string) ([]types.Listener, error) {
+	var marker *string
+	listeners := []types.Listener{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return listeners, &ClientError{
+				ResourceName: loadBalancerArn,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &elasticloadbalancingv2.DescribeListenersInput{
+			LoadBalancerArn: loadBalancerArn,
+			Marker:          marker,
+		}
+
+		output, err := e.client.DescribeListeners(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: loadBalancerArn,
+				Err:          err,
+			}
+		}
+		listeners = append(listeners, output.Listeners...)
+
+		marker = output.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return listeners, nil
+}
+
+// DescribeListenerCertificates returns both the default certificate (IsDefault is true)
+// and the additional certificates of the listener.
+func (e *ELBV2) DescribeListenerCertificates(ctx context.Context, listenerArn *string) ([]types.Certificate, error) {
+	var marker *string
+	certificates := []types.Certificate{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return certificates, &ClientError{
+				ResourceName: listenerArn,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &elasticloadbalancingv2.DescribeListenerCertificatesInput{
+			ListenerArn: listenerArn,
+			Marker:      marker,
+		}
+
+		output, err := e.client.DescribeListenerCertificates(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: listenerArn,
+				Err:          err,
+			}
+		}
+		certificates = append(certificates, output.Certificates...)
+
+		marker = output.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return certificates, nil
+}
+
+func (e *ELBV2) ModifyListenerDefaultCertificate(ctx context.Context, listenerArn *string, certificateArn *string) error {
+	input := &elasticloadbalancingv2.ModifyListenerInput{
+		ListenerArn: listenerArn,
+		Certificates: []types.Certificate{
+			{
+				CertificateArn: certificateArn,
+			},
+		},
+	}
+
+	_, err := e.client.ModifyListener(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: listenerArn,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (e *ELBV2) RemoveListenerCertificate(ctx context.Context, listenerArn *string, certificateArn *string) error {
+	input := &elasticloadbalancingv2.RemoveListenerCertificatesInput{
+		ListenerArn: listenerArn,
+		Certificates: []types.Certificate{
+			{
+				CertificateArn: certificateArn,
+			},
+		},
+	}
+
+	_, err := e.client.RemoveListenerCertificates(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: listenerArn,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+// DescribeTargetGroupLoadBalancerArns returns the ARNs of the load balancers that route traffic to the target group.
+func (e *ELBV2) DescribeTargetGroupLoadBalancerArns(ctx context.Context, targetGroupArn *string) ([]string, error) {
+	input := &elasticloadbalancingv2.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{aws.ToString(targetGroupArn)},
+	}
+
+	output, err := e.client.DescribeTargetGroups(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: targetGroupArn,
+			Err:          err,
+		}
+	}
+
+	loadBalancerArns := []string{}
+	for _, targetGroup := range output.TargetGroups {
+		loadBalancerArns = append(loadBalancerArns, targetGroup.LoadBalancerArns...)
+	}
+
+	return loadBalancerArns, nil
+}
+
+// DescribeRules returns all rules of the listener, including the default rule (IsDefault is true).
+func (e *ELBV2) DescribeRules(ctx context.Context, listenerArn *string) ([]types.Rule, error) {
+	var marker *string
+	rules := []types.Rule{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return rules, &ClientError{
+				ResourceName: listenerArn,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: listenerArn,
+			Marker:      marker,
+		}
+
+		output, err := e.client.DescribeRules(ctx, input)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: listenerArn,
+				Err:          err,
+			}
+		}
+		rules = append(rules, output.Rules...)
+
+		marker = output.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return rules, nil
+}
+
+func (e *ELBV2) DeleteRule(ctx context.Context, ruleArn *string) error {
+	input := &elasticloadbalancingv2.DeleteRuleInput{
+		RuleArn: ruleArn,
+	}
+
+	_, err := e.client.DeleteRule(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: ruleArn,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+// DescribeTargets returns the targets registered with the target group.
+func (e *ELBV2) DescribeTargets(ctx context.Context, targetGroupArn *string) ([]types.TargetDescription, error) {
+	input := &elasticloadbalancingv2.DescribeTargetHealthInput{
+		TargetGroupArn: targetGroupArn,
+	}
+
+	output, err := e.client.DescribeTargetHealth(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: targetGroupArn,
+			Err:          err,
+		}
+	}
+
+	targets := []types.TargetDescription{}
+	for _, description := range output.TargetHealthDescriptions {
+		if description.Target != nil {
+			targets = append(targets, *description.Target)
+		}
+	}
+
+	return targets, nil
+}
+
+func (e *ELBV2) DeregisterTargets(ctx context.Context, targetGroupArn *string, targets []types.TargetDescription) error {
+	input := &elasticloadbalancingv2.DeregisterTargetsInput{
+		TargetGroupArn: targetGroupArn,
+		Targets:        targets,
+	}
+
+	_, err := e.client.DeregisterTargets(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: targetGroupArn,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
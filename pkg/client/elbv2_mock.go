@@ -12,6 +12,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,6 +55,109 @@ func (mr *MockIELBV2MockRecorder) CheckLoadBalancerDeletionProtection(ctx, loadB
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLoadBalancerDeletionProtection", reflect.TypeOf((*MockIELBV2)(nil).CheckLoadBalancerDeletionProtection), ctx, loadBalancerArn)
 }
 
+// DeleteRule mocks base method.
+func (m *MockIELBV2) DeleteRule(ctx context.Context, ruleArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, ruleArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockIELBV2MockRecorder) DeleteRule(ctx, ruleArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockIELBV2)(nil).DeleteRule), ctx, ruleArn)
+}
+
+// DeregisterTargets mocks base method.
+func (m *MockIELBV2) DeregisterTargets(ctx context.Context, targetGroupArn *string, targets []types.TargetDescription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTargets", ctx, targetGroupArn, targets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTargets indicates an expected call of DeregisterTargets.
+func (mr *MockIELBV2MockRecorder) DeregisterTargets(ctx, targetGroupArn, targets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTargets", reflect.TypeOf((*MockIELBV2)(nil).DeregisterTargets), ctx, targetGroupArn, targets)
+}
+
+// DescribeListenerCertificates mocks base method.
+func (m *MockIELBV2) DescribeListenerCertificates(ctx context.Context, listenerArn *string) ([]types.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeListenerCertificates", ctx, listenerArn)
+	ret0, _ := ret[0].([]types.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeListenerCertificates indicates an expected call of DescribeListenerCertificates.
+func (mr *MockIELBV2MockRecorder) DescribeListenerCertificates(ctx, listenerArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeListenerCertificates", reflect.TypeOf((*MockIELBV2)(nil).DescribeListenerCertificates), ctx, listenerArn)
+}
+
+// DescribeListeners mocks base method.
+func (m *MockIELBV2) DescribeListeners(ctx context.Context, loadBalancerArn *string) ([]types.Listener, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeListeners", ctx, loadBalancerArn)
+	ret0, _ := ret[0].([]types.Listener)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeListeners indicates an expected call of DescribeListeners.
+func (mr *MockIELBV2MockRecorder) DescribeListeners(ctx, loadBalancerArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeListeners", reflect.TypeOf((*MockIELBV2)(nil).DescribeListeners), ctx, loadBalancerArn)
+}
+
+// DescribeRules mocks base method.
+func (m *MockIELBV2) DescribeRules(ctx context.Context, listenerArn *string) ([]types.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRules", ctx, listenerArn)
+	ret0, _ := ret[0].([]types.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRules indicates an expected call of DescribeRules.
+func (mr *MockIELBV2MockRecorder) DescribeRules(ctx, listenerArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRules", reflect.TypeOf((*MockIELBV2)(nil).DescribeRules), ctx, listenerArn)
+}
+
+// DescribeTargetGroupLoadBalancerArns mocks base method.
+func (m *MockIELBV2) DescribeTargetGroupLoadBalancerArns(ctx context.Context, targetGroupArn *string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTargetGroupLoadBalancerArns", ctx, targetGroupArn)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTargetGroupLoadBalancerArns indicates an expected call of DescribeTargetGroupLoadBalancerArns.
+func (mr *MockIELBV2MockRecorder) DescribeTargetGroupLoadBalancerArns(ctx, targetGroupArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTargetGroupLoadBalancerArns", reflect.TypeOf((*MockIELBV2)(nil).DescribeTargetGroupLoadBalancerArns), ctx, targetGroupArn)
+}
+
+// DescribeTargets mocks base method.
+func (m *MockIELBV2) DescribeTargets(ctx context.Context, targetGroupArn *string) ([]types.TargetDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTargets", ctx, targetGroupArn)
+	ret0, _ := ret[0].([]types.TargetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTargets indicates an expected call of DescribeTargets.
+func (mr *MockIELBV2MockRecorder) DescribeTargets(ctx, targetGroupArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTargets", reflect.TypeOf((*MockIELBV2)(nil).DescribeTargets), ctx, targetGroupArn)
+}
+
 // DisableLoadBalancerDeletionProtection mocks base method.
 func (m *MockIELBV2) DisableLoadBalancerDeletionProtection(ctx context.Context, loadBalancerArn *string) error {
 	m.ctrl.T.Helper()
@@ -67,3 +171,31 @@ func (mr *MockIELBV2MockRecorder) DisableLoadBalancerDeletionProtection(ctx, loa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableLoadBalancerDeletionProtection", reflect.TypeOf((*MockIELBV2)(nil).DisableLoadBalancerDeletionProtection), ctx, loadBalancerArn)
 }
+
+// ModifyListenerDefaultCertificate mocks base method.
+func (m *MockIELBV2) ModifyListenerDefaultCertificate(ctx context.Context, listenerArn, certificateArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyListenerDefaultCertificate", ctx, listenerArn, certificateArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyListenerDefaultCertificate indicates an expected call of ModifyListenerDefaultCertificate.
+func (mr *MockIELBV2MockRecorder) ModifyListenerDefaultCertificate(ctx, listenerArn, certificateArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyListenerDefaultCertificate", reflect.TypeOf((*MockIELBV2)(nil).ModifyListenerDefaultCertificate), ctx, listenerArn, certificateArn)
+}
+
+// RemoveListenerCertificate mocks base method.
+func (m *MockIELBV2) RemoveListenerCertificate(ctx context.Context, listenerArn, certificateArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveListenerCertificate", ctx, listenerArn, certificateArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveListenerCertificate indicates an expected call of RemoveListenerCertificate.
+func (mr *MockIELBV2MockRecorder) RemoveListenerCertificate(ctx, listenerArn, certificateArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListenerCertificate", reflect.TypeOf((*MockIELBV2)(nil).RemoveListenerCertificate), ctx, listenerArn, certificateArn)
+}
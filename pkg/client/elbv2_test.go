@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,6 +15,28 @@ import (
 	"go.uber.org/goleak"
 )
 
+type tokenKeyForELBV2 struct{}
+
+func getNextTokenForELBV2Initialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	switch v := in.Parameters.(type) {
+	case *elasticloadbalancingv2.DescribeListenersInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForELBV2{}, v.Marker)
+	case *elasticloadbalancingv2.DescribeListenerCertificatesInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForELBV2{}, v.Marker)
+	case *elasticloadbalancingv2.DescribeRulesInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForELBV2{}, v.Marker)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+/*
+	Test Cases
+*/
+
 func TestELBV2_CheckLoadBalancerDeletionProtection(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
@@ -286,3 +309,1063 @@ func TestELBV2_DisableLoadBalancerDeletionProtection(t *testing.T) {
 		})
 	}
 }
+
+func TestELBV2_ModifyListenerDefaultCertificate(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		listenerArn        *string
+		certificateArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "modify listener default certificate successfully",
+			args: args{
+				ctx:            context.Background(),
+				listenerArn:    aws.String("ListenerArn"),
+				certificateArn: aws.String("CertificateArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ModifyListenerMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.ModifyListenerOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "modify listener default certificate failure",
+			args: args{
+				ctx:            context.Background(),
+				listenerArn:    aws.String("ListenerArn"),
+				certificateArn: aws.String("CertificateArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ModifyListenerErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.ModifyListenerOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ModifyListenerError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("ListenerArn"),
+				Err:          fmt.Errorf("operation error Elastic Load Balancing v2: ModifyListener, ModifyListenerError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg)
+			eLBV2Client := NewELBV2(client)
+
+			err = eLBV2Client.ModifyListenerDefaultCertificate(tt.args.ctx, tt.args.listenerArn, tt.args.certificateArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_RemoveListenerCertificate(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		listenerArn        *string
+		certificateArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "remove listener certificate successfully",
+			args: args{
+				ctx:            context.Background(),
+				listenerArn:    aws.String("ListenerArn"),
+				certificateArn: aws.String("CertificateArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RemoveListenerCertificatesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.RemoveListenerCertificatesOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "remove listener certificate failure",
+			args: args{
+				ctx:            context.Background(),
+				listenerArn:    aws.String("ListenerArn"),
+				certificateArn: aws.String("CertificateArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RemoveListenerCertificatesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.RemoveListenerCertificatesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("RemoveListenerCertificatesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("ListenerArn"),
+				Err:          fmt.Errorf("operation error Elastic Load Balancing v2: RemoveListenerCertificates, RemoveListenerCertificatesError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg)
+			eLBV2Client := NewELBV2(client)
+
+			err = eLBV2Client.RemoveListenerCertificate(tt.args.ctx, tt.args.listenerArn, tt.args.certificateArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DescribeListeners(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		loadBalancerArn    *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.Listener
+		wantErr bool
+	}{
+		{
+			name: "describe listeners successfully",
+			args: args{
+				ctx:             context.Background(),
+				loadBalancerArn: aws.String("LoadBalancerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeListenersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeListenersOutput{
+										Listeners: []types.Listener{
+											{
+												ListenerArn: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Listener{
+				{
+					ListenerArn: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe listeners with next token successfully",
+			args: args{
+				ctx:             context.Background(),
+				loadBalancerArn: aws.String("LoadBalancerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeListenersWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForELBV2{}).(*string)
+
+								var nextToken *string
+								var items []types.Listener
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.Listener{
+										{
+											ListenerArn: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.Listener{
+										{
+											ListenerArn: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeListenersOutput{
+										Listeners:  items,
+										NextMarker: nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Listener{
+				{
+					ListenerArn: aws.String("Item1"),
+				},
+				{
+					ListenerArn: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe listeners failure",
+			args: args{
+				ctx:             context.Background(),
+				loadBalancerArn: aws.String("LoadBalancerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeListenersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeListenersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeListenersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg, func(o *elasticloadbalancingv2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForELBV2Initialize), middleware.Before)
+				})
+			})
+			eLBV2Client := NewELBV2(client)
+
+			output, err := eLBV2Client.DescribeListeners(tt.args.ctx, tt.args.loadBalancerArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DescribeListenerCertificates(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		listenerArn        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.Certificate
+		wantErr bool
+	}{
+		{
+			name: "describe listener certificates successfully",
+			args: args{
+				ctx:         context.Background(),
+				listenerArn: aws.String("ListenerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeListenerCertificatesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeListenerCertificatesOutput{
+										Certificates: []types.Certificate{
+											{
+												CertificateArn: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Certificate{
+				{
+					CertificateArn: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe listener certificates with next token successfully",
+			args: args{
+				ctx:         context.Background(),
+				listenerArn: aws.String("ListenerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeListenerCertificatesWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForELBV2{}).(*string)
+
+								var nextToken *string
+								var items []types.Certificate
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.Certificate{
+										{
+											CertificateArn: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.Certificate{
+										{
+											CertificateArn: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeListenerCertificatesOutput{
+										Certificates: items,
+										NextMarker:   nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Certificate{
+				{
+					CertificateArn: aws.String("Item1"),
+				},
+				{
+					CertificateArn: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe listener certificates failure",
+			args: args{
+				ctx:         context.Background(),
+				listenerArn: aws.String("ListenerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeListenerCertificatesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeListenerCertificatesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeListenerCertificatesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg, func(o *elasticloadbalancingv2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForELBV2Initialize), middleware.Before)
+				})
+			})
+			eLBV2Client := NewELBV2(client)
+
+			output, err := eLBV2Client.DescribeListenerCertificates(tt.args.ctx, tt.args.listenerArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DeleteRule(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		ruleArn            *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete rule successfully",
+			args: args{
+				ctx:     context.Background(),
+				ruleArn: aws.String("RuleArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteRuleMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DeleteRuleOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete rule failure",
+			args: args{
+				ctx:     context.Background(),
+				ruleArn: aws.String("RuleArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteRuleErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DeleteRuleOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteRuleError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("RuleArn"),
+				Err:          fmt.Errorf("operation error Elastic Load Balancing v2: DeleteRule, DeleteRuleError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg)
+			eLBV2Client := NewELBV2(client)
+
+			err = eLBV2Client.DeleteRule(tt.args.ctx, tt.args.ruleArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DeregisterTargets(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		targetGroupArn     *string
+		targets            []types.TargetDescription
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "deregister targets successfully",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				targets:        []types.TargetDescription{{Id: aws.String("i-1234567890")}},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeregisterTargetsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DeregisterTargetsOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "deregister targets failure",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				targets:        []types.TargetDescription{{Id: aws.String("i-1234567890")}},
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeregisterTargetsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DeregisterTargetsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeregisterTargetsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("TargetGroupArn"),
+				Err:          fmt.Errorf("operation error Elastic Load Balancing v2: DeregisterTargets, DeregisterTargetsError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg)
+			eLBV2Client := NewELBV2(client)
+
+			err = eLBV2Client.DeregisterTargets(tt.args.ctx, tt.args.targetGroupArn, tt.args.targets)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DescribeTargetGroupLoadBalancerArns(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		targetGroupArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "describe target group load balancer arns successfully",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTargetGroupsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeTargetGroupsOutput{
+										TargetGroups: []types.TargetGroup{
+											{
+												TargetGroupArn:   aws.String("TargetGroupArn"),
+												LoadBalancerArns: []string{"LoadBalancerArn1", "LoadBalancerArn2"},
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    []string{"LoadBalancerArn1", "LoadBalancerArn2"},
+			wantErr: false,
+		},
+		{
+			name: "describe target group load balancer arns with no load balancer successfully",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTargetGroupsWithNoLoadBalancerMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeTargetGroupsOutput{
+										TargetGroups: []types.TargetGroup{
+											{
+												TargetGroupArn: aws.String("TargetGroupArn"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    []string{},
+			wantErr: false,
+		},
+		{
+			name: "describe target group load balancer arns failure",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTargetGroupsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeTargetGroupsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeTargetGroupsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg)
+			eLBV2Client := NewELBV2(client)
+
+			output, err := eLBV2Client.DescribeTargetGroupLoadBalancerArns(tt.args.ctx, tt.args.targetGroupArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DescribeRules(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		listenerArn        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.Rule
+		wantErr bool
+	}{
+		{
+			name: "describe rules successfully",
+			args: args{
+				ctx:         context.Background(),
+				listenerArn: aws.String("ListenerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeRulesMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeRulesOutput{
+										Rules: []types.Rule{
+											{
+												RuleArn: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Rule{
+				{
+					RuleArn: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe rules with next token successfully",
+			args: args{
+				ctx:         context.Background(),
+				listenerArn: aws.String("ListenerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeRulesWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForELBV2{}).(*string)
+
+								var nextToken *string
+								var items []types.Rule
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.Rule{
+										{
+											RuleArn: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.Rule{
+										{
+											RuleArn: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeRulesOutput{
+										Rules:      items,
+										NextMarker: nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Rule{
+				{
+					RuleArn: aws.String("Item1"),
+				},
+				{
+					RuleArn: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe rules failure",
+			args: args{
+				ctx:         context.Background(),
+				listenerArn: aws.String("ListenerArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeRulesErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeRulesOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeRulesError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg, func(o *elasticloadbalancingv2.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForELBV2Initialize), middleware.Before)
+				})
+			})
+			eLBV2Client := NewELBV2(client)
+
+			output, err := eLBV2Client.DescribeRules(tt.args.ctx, tt.args.listenerArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestELBV2_DescribeTargets(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		targetGroupArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.TargetDescription
+		wantErr bool
+	}{
+		{
+			name: "describe targets successfully",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTargetHealthMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeTargetHealthOutput{
+										TargetHealthDescriptions: []types.TargetHealthDescription{
+											{
+												Target: &types.TargetDescription{
+													Id:   aws.String("i-1234567890"),
+													Port: aws.Int32(80),
+												},
+											},
+											{
+												Target: &types.TargetDescription{
+													Id: aws.String("10.0.0.1"),
+												},
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.TargetDescription{
+				{
+					Id:   aws.String("i-1234567890"),
+					Port: aws.Int32(80),
+				},
+				{
+					Id: aws.String("10.0.0.1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe targets with no target successfully",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTargetHealthWithNoTargetMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeTargetHealthOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    []types.TargetDescription{},
+			wantErr: false,
+		},
+		{
+			name: "describe targets failure",
+			args: args{
+				ctx:            context.Background(),
+				targetGroupArn: aws.String("TargetGroupArn"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeTargetHealthErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &elasticloadbalancingv2.DescribeTargetHealthOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeTargetHealthError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := elasticloadbalancingv2.NewFromConfig(cfg)
+			eLBV2Client := NewELBV2(client)
+
+			output, err := eLBV2Client.DescribeTargets(tt.args.ctx, tt.args.targetGroupArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+//go:generate mockgen -source=$GOFILE -destination=firehose_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+)
+
+var SleepTimeSecForFirehose = 5
+
+type IFirehose interface {
+	DescribeDeliveryStream(ctx context.Context, deliveryStreamName *string) (*types.DeliveryStreamDescription, error)
+	DeleteDeliveryStream(ctx context.Context, deliveryStreamName *string, allowForceDelete bool) error
+	CheckDeliveryStreamExists(ctx context.Context, deliveryStreamName *string) (bool, error)
+}
+
+var _ IFirehose = (*Firehose)(nil)
+
+type Firehose struct {
+	client  *firehose.Client
+	retryer *Retryer
+}
+
+func NewFirehose(client *firehose.Client) *Firehose {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "LimitExceededException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForFirehose)
+
+	return &Firehose{
+		client,
+		retryer,
+	}
+}
+
+func (f *Firehose) DescribeDeliveryStream(ctx context.Context, deliveryStreamName *string) (*types.DeliveryStreamDescription, error) {
+	input := &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: deliveryStreamName,
+	}
+
+	optFn := func(o *firehose.Options) {
+		o.Retryer = f.retryer
+	}
+
+	output, err := f.client.DescribeDeliveryStream(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: deliveryStreamName,
+			Err:          err,
+		}
+	}
+
+	return output.DeliveryStreamDescription, nil
+}
+
+func (f *Firehose) DeleteDeliveryStream(ctx context.Context, deliveryStreamName *string, allowForceDelete bool) error {
+	input := &firehose.DeleteDeliveryStreamInput{
+		DeliveryStreamName: deliveryStreamName,
+		AllowForceDelete:   &allowForceDelete,
+	}
+
+	optFn := func(o *firehose.Options) {
+		o.Retryer = f.retryer
+	}
+
+	_, err := f.client.DeleteDeliveryStream(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: deliveryStreamName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (f *Firehose) CheckDeliveryStreamExists(ctx context.Context, deliveryStreamName *string) (bool, error) {
+	input := &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: deliveryStreamName,
+	}
+
+	optFn := func(o *firehose.Options) {
+		o.Retryer = f.retryer
+	}
+
+	_, err := f.client.DescribeDeliveryStream(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: deliveryStreamName,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: firehose.go
+//
+// Generated by this command:
+//
+//	mockgen -source=firehose.go -destination=firehose_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIFirehose is a mock of IFirehose interface.
+type MockIFirehose struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFirehoseMockRecorder
+	isgomock struct{}
+}
+
+// MockIFirehoseMockRecorder is the mock recorder for MockIFirehose.
+type MockIFirehoseMockRecorder struct {
+	mock *MockIFirehose
+}
+
+// NewMockIFirehose creates a new mock instance.
+func NewMockIFirehose(ctrl *gomock.Controller) *MockIFirehose {
+	mock := &MockIFirehose{ctrl: ctrl}
+	mock.recorder = &MockIFirehoseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFirehose) EXPECT() *MockIFirehoseMockRecorder {
+	return m.recorder
+}
+
+// CheckDeliveryStreamExists mocks base method.
+func (m *MockIFirehose) CheckDeliveryStreamExists(ctx context.Context, deliveryStreamName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDeliveryStreamExists", ctx, deliveryStreamName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDeliveryStreamExists indicates an expected call of CheckDeliveryStreamExists.
+func (mr *MockIFirehoseMockRecorder) CheckDeliveryStreamExists(ctx, deliveryStreamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDeliveryStreamExists", reflect.TypeOf((*MockIFirehose)(nil).CheckDeliveryStreamExists), ctx, deliveryStreamName)
+}
+
+// DeleteDeliveryStream mocks base method.
+func (m *MockIFirehose) DeleteDeliveryStream(ctx context.Context, deliveryStreamName *string, allowForceDelete bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeliveryStream", ctx, deliveryStreamName, allowForceDelete)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeliveryStream indicates an expected call of DeleteDeliveryStream.
+func (mr *MockIFirehoseMockRecorder) DeleteDeliveryStream(ctx, deliveryStreamName, allowForceDelete any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeliveryStream", reflect.TypeOf((*MockIFirehose)(nil).DeleteDeliveryStream), ctx, deliveryStreamName, allowForceDelete)
+}
+
+// DescribeDeliveryStream mocks base method.
+func (m *MockIFirehose) DescribeDeliveryStream(ctx context.Context, deliveryStreamName *string) (*types.DeliveryStreamDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDeliveryStream", ctx, deliveryStreamName)
+	ret0, _ := ret[0].(*types.DeliveryStreamDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDeliveryStream indicates an expected call of DescribeDeliveryStream.
+func (mr *MockIFirehoseMockRecorder) DescribeDeliveryStream(ctx, deliveryStreamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDeliveryStream", reflect.TypeOf((*MockIFirehose)(nil).DescribeDeliveryStream), ctx, deliveryStreamName)
+}
@@ -0,0 +1,163 @@
+//go:generate mockgen -source=$GOFILE -destination=kinesis_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+)
+
+var SleepTimeSecForKinesis = 5
+
+type IKinesis interface {
+	DescribeStreamSummary(ctx context.Context, streamName *string) (*types.StreamDescriptionSummary, error)
+	ListStreamConsumers(ctx context.Context, streamArn *string) ([]types.Consumer, error)
+	DeregisterStreamConsumer(ctx context.Context, streamArn *string, consumerArn *string) error
+	DeleteStream(ctx context.Context, streamName *string, enforceConsumerDeletion bool) error
+	CheckStreamExists(ctx context.Context, streamName *string) (bool, error)
+}
+
+var _ IKinesis = (*Kinesis)(nil)
+
+type Kinesis struct {
+	client  *kinesis.Client
+	retryer *Retryer
+}
+
+func NewKinesis(client *kinesis.Client) *Kinesis {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "LimitExceededException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForKinesis)
+
+	return &Kinesis{
+		client,
+		retryer,
+	}
+}
+
+func (k *Kinesis) DescribeStreamSummary(ctx context.Context, streamName *string) (*types.StreamDescriptionSummary, error) {
+	input := &kinesis.DescribeStreamSummaryInput{
+		StreamName: streamName,
+	}
+
+	optFn := func(o *kinesis.Options) {
+		o.Retryer = k.retryer
+	}
+
+	output, err := k.client.DescribeStreamSummary(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: streamName,
+			Err:          err,
+		}
+	}
+
+	return output.StreamDescriptionSummary, nil
+}
+
+func (k *Kinesis) ListStreamConsumers(ctx context.Context, streamArn *string) ([]types.Consumer, error) {
+	var nextToken *string
+	consumers := []types.Consumer{}
+
+	optFn := func(o *kinesis.Options) {
+		o.Retryer = k.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return consumers, &ClientError{
+				ResourceName: streamArn,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &kinesis.ListStreamConsumersInput{
+			StreamARN: streamArn,
+			NextToken: nextToken,
+		}
+
+		output, err := k.client.ListStreamConsumers(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: streamArn,
+				Err:          err,
+			}
+		}
+		consumers = append(consumers, output.Consumers...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return consumers, nil
+}
+
+func (k *Kinesis) DeregisterStreamConsumer(ctx context.Context, streamArn *string, consumerArn *string) error {
+	input := &kinesis.DeregisterStreamConsumerInput{
+		StreamARN:   streamArn,
+		ConsumerARN: consumerArn,
+	}
+
+	optFn := func(o *kinesis.Options) {
+		o.Retryer = k.retryer
+	}
+
+	_, err := k.client.DeregisterStreamConsumer(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: consumerArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (k *Kinesis) DeleteStream(ctx context.Context, streamName *string, enforceConsumerDeletion bool) error {
+	input := &kinesis.DeleteStreamInput{
+		StreamName:              streamName,
+		EnforceConsumerDeletion: &enforceConsumerDeletion,
+	}
+
+	optFn := func(o *kinesis.Options) {
+		o.Retryer = k.retryer
+	}
+
+	_, err := k.client.DeleteStream(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: streamName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (k *Kinesis) CheckStreamExists(ctx context.Context, streamName *string) (bool, error) {
+	input := &kinesis.DescribeStreamSummaryInput{
+		StreamName: streamName,
+	}
+
+	optFn := func(o *kinesis.Options) {
+		o.Retryer = k.retryer
+	}
+
+	_, err := k.client.DescribeStreamSummary(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: streamName,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: kinesis.go
+//
+// Generated by this command:
+//
+//	mockgen -source=kinesis.go -destination=kinesis_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIKinesis is a mock of IKinesis interface.
+type MockIKinesis struct {
+	ctrl     *gomock.Controller
+	recorder *MockIKinesisMockRecorder
+	isgomock struct{}
+}
+
+// MockIKinesisMockRecorder is the mock recorder for MockIKinesis.
+type MockIKinesisMockRecorder struct {
+	mock *MockIKinesis
+}
+
+// NewMockIKinesis creates a new mock instance.
+func NewMockIKinesis(ctrl *gomock.Controller) *MockIKinesis {
+	mock := &MockIKinesis{ctrl: ctrl}
+	mock.recorder = &MockIKinesisMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIKinesis) EXPECT() *MockIKinesisMockRecorder {
+	return m.recorder
+}
+
+// CheckStreamExists mocks base method.
+func (m *MockIKinesis) CheckStreamExists(ctx context.Context, streamName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStreamExists", ctx, streamName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckStreamExists indicates an expected call of CheckStreamExists.
+func (mr *MockIKinesisMockRecorder) CheckStreamExists(ctx, streamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStreamExists", reflect.TypeOf((*MockIKinesis)(nil).CheckStreamExists), ctx, streamName)
+}
+
+// DeleteStream mocks base method.
+func (m *MockIKinesis) DeleteStream(ctx context.Context, streamName *string, enforceConsumerDeletion bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStream", ctx, streamName, enforceConsumerDeletion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStream indicates an expected call of DeleteStream.
+func (mr *MockIKinesisMockRecorder) DeleteStream(ctx, streamName, enforceConsumerDeletion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStream", reflect.TypeOf((*MockIKinesis)(nil).DeleteStream), ctx, streamName, enforceConsumerDeletion)
+}
+
+// DeregisterStreamConsumer mocks base method.
+func (m *MockIKinesis) DeregisterStreamConsumer(ctx context.Context, streamArn, consumerArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterStreamConsumer", ctx, streamArn, consumerArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterStreamConsumer indicates an expected call of DeregisterStreamConsumer.
+func (mr *MockIKinesisMockRecorder) DeregisterStreamConsumer(ctx, streamArn, consumerArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterStreamConsumer", reflect.TypeOf((*MockIKinesis)(nil).DeregisterStreamConsumer), ctx, streamArn, consumerArn)
+}
+
+// DescribeStreamSummary mocks base method.
+func (m *MockIKinesis) DescribeStreamSummary(ctx context.Context, streamName *string) (*types.StreamDescriptionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeStreamSummary", ctx, streamName)
+	ret0, _ := ret[0].(*types.StreamDescriptionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStreamSummary indicates an expected call of DescribeStreamSummary.
+func (mr *MockIKinesisMockRecorder) DescribeStreamSummary(ctx, streamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamSummary", reflect.TypeOf((*MockIKinesis)(nil).DescribeStreamSummary), ctx, streamName)
+}
+
+// ListStreamConsumers mocks base method.
+func (m *MockIKinesis) ListStreamConsumers(ctx context.Context, streamArn *string) ([]types.Consumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStreamConsumers", ctx, streamArn)
+	ret0, _ := ret[0].([]types.Consumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStreamConsumers indicates an expected call of ListStreamConsumers.
+func (mr *MockIKinesisMockRecorder) ListStreamConsumers(ctx, streamArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumers", reflect.TypeOf((*MockIKinesis)(nil).ListStreamConsumers), ctx, streamArn)
+}
@@ -0,0 +1,559 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type tokenKeyForKinesis struct{}
+
+func getNextTokenForKinesisInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	switch v := in.Parameters.(type) {
+	case *kinesis.ListStreamConsumersInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForKinesis{}, v.NextToken)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+/*
+	Test Cases
+*/
+
+func TestKinesis_ListStreamConsumers(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		streamArn          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.Consumer
+		wantErr bool
+	}{
+		{
+			name: "list stream consumers successfully",
+			args: args{
+				ctx:       context.Background(),
+				streamArn: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListStreamConsumersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.ListStreamConsumersOutput{
+										Consumers: []types.Consumer{
+											{
+												ConsumerName: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Consumer{
+				{
+					ConsumerName: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list stream consumers with next token successfully",
+			args: args{
+				ctx:       context.Background(),
+				streamArn: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListStreamConsumersWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForKinesis{}).(*string)
+
+								var nextToken *string
+								var items []types.Consumer
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.Consumer{
+										{
+											ConsumerName: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.Consumer{
+										{
+											ConsumerName: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &kinesis.ListStreamConsumersOutput{
+										Consumers: items,
+										NextToken: nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.Consumer{
+				{
+					ConsumerName: aws.String("Item1"),
+				},
+				{
+					ConsumerName: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list stream consumers failure",
+			args: args{
+				ctx:       context.Background(),
+				streamArn: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListStreamConsumersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.ListStreamConsumersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListStreamConsumersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := kinesis.NewFromConfig(cfg, func(o *kinesis.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForKinesisInitialize), middleware.Before)
+				})
+			})
+			kinesisClient := NewKinesis(client)
+
+			output, err := kinesisClient.ListStreamConsumers(tt.args.ctx, tt.args.streamArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestKinesis_DescribeStreamSummary(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		streamName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe stream summary successfully",
+			args: args{
+				ctx:        context.Background(),
+				streamName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStreamSummaryMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DescribeStreamSummaryOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe stream summary failure",
+			args: args{
+				ctx:        context.Background(),
+				streamName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStreamSummaryErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DescribeStreamSummaryOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeStreamSummaryError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error Kinesis: DescribeStreamSummary, DescribeStreamSummaryError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := kinesis.NewFromConfig(cfg)
+			kinesisClient := NewKinesis(client)
+
+			_, err = kinesisClient.DescribeStreamSummary(tt.args.ctx, tt.args.streamName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKinesis_DeregisterStreamConsumer(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		streamArn          *string
+		consumerArn        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "deregister stream consumer successfully",
+			args: args{
+				ctx:         context.Background(),
+				streamArn:   aws.String("test"),
+				consumerArn: aws.String("consumer"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeregisterStreamConsumerMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DeregisterStreamConsumerOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "deregister stream consumer failure",
+			args: args{
+				ctx:         context.Background(),
+				streamArn:   aws.String("test"),
+				consumerArn: aws.String("consumer"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeregisterStreamConsumerErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DeregisterStreamConsumerOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeregisterStreamConsumerError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("consumer"),
+				Err:          fmt.Errorf("operation error Kinesis: DeregisterStreamConsumer, DeregisterStreamConsumerError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := kinesis.NewFromConfig(cfg)
+			kinesisClient := NewKinesis(client)
+
+			err = kinesisClient.DeregisterStreamConsumer(tt.args.ctx, tt.args.streamArn, tt.args.consumerArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKinesis_DeleteStream(t *testing.T) {
+	type args struct {
+		ctx                     context.Context
+		streamName              *string
+		enforceConsumerDeletion bool
+		withAPIOptionsFunc      func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete stream successfully",
+			args: args{
+				ctx:                     context.Background(),
+				streamName:              aws.String("test"),
+				enforceConsumerDeletion: true,
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteStreamMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DeleteStreamOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete stream failure",
+			args: args{
+				ctx:                     context.Background(),
+				streamName:              aws.String("test"),
+				enforceConsumerDeletion: true,
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteStreamErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DeleteStreamOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteStreamError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error Kinesis: DeleteStream, DeleteStreamError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := kinesis.NewFromConfig(cfg)
+			kinesisClient := NewKinesis(client)
+
+			err = kinesisClient.DeleteStream(tt.args.ctx, tt.args.streamName, tt.args.enforceConsumerDeletion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKinesis_CheckStreamExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		streamName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check stream exists successfully",
+			args: args{
+				ctx:        context.Background(),
+				streamName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStreamSummaryMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DescribeStreamSummaryOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check stream exists successfully for not found",
+			args: args{
+				ctx:        context.Background(),
+				streamName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStreamSummaryNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DescribeStreamSummaryOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check stream exists failure",
+			args: args{
+				ctx:        context.Background(),
+				streamName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeStreamSummaryErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &kinesis.DescribeStreamSummaryOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeStreamSummaryError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := kinesis.NewFromConfig(cfg)
+			kinesisClient := NewKinesis(client)
+
+			output, err := kinesisClient.CheckStreamExists(tt.args.ctx, tt.args.streamName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
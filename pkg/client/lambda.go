@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 )
 
 const LambdaFunctionUpdatedWaitNanoSecTime = time.Duration(300000000000) // 5 minutes
@@ -16,6 +17,12 @@ type ILambda interface {
 	UpdateFunctionConfiguration(ctx context.Context, input *lambda.UpdateFunctionConfigurationInput) error
 	DeleteFunction(ctx context.Context, functionName *string) error
 	CheckLambdaFunctionExists(ctx context.Context, functionName *string) (bool, error)
+	ListEventSourceMappings(ctx context.Context, functionName *string, eventSourceArn *string) ([]types.EventSourceMappingConfiguration, error)
+	DeleteEventSourceMapping(ctx context.Context, uuid *string) error
+	ListProvisionedConcurrencyConfigs(ctx context.Context, functionName *string) ([]types.ProvisionedConcurrencyConfigListItem, error)
+	DeleteProvisionedConcurrencyConfig(ctx context.Context, functionName *string, qualifier *string) error
+	ListLayerVersions(ctx context.Context, layerName *string) ([]types.LayerVersionsListItem, error)
+	DeleteLayerVersion(ctx context.Context, layerName *string, versionNumber int64) error
 }
 
 var _ ILambda = (*LambdaClient)(nil)
@@ -93,6 +100,160 @@ func (c *LambdaClient) CheckLambdaFunctionExists(ctx context.Context, functionNa
 	return true, nil
 }
 
+// ListEventSourceMappings lists the event source mappings filtered by the function name and/or the
+// event source ARN. Either filter can be nil.
+func (c *LambdaClient) ListEventSourceMappings(ctx context.Context, functionName *string, eventSourceArn *string) ([]types.EventSourceMappingConfiguration, error) {
+	resourceName := functionName
+	if resourceName == nil {
+		resourceName = eventSourceArn
+	}
+
+	var marker *string
+	mappings := []types.EventSourceMappingConfiguration{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return mappings, &ClientError{
+				ResourceName: resourceName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		output, err := c.client.ListEventSourceMappings(ctx, &lambda.ListEventSourceMappingsInput{
+			FunctionName:   functionName,
+			EventSourceArn: eventSourceArn,
+			Marker:         marker,
+		})
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: resourceName,
+				Err:          err,
+			}
+		}
+		mappings = append(mappings, output.EventSourceMappings...)
+
+		marker = output.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return mappings, nil
+}
+
+func (c *LambdaClient) DeleteEventSourceMapping(ctx context.Context, uuid *string) error {
+	_, err := c.client.DeleteEventSourceMapping(ctx, &lambda.DeleteEventSourceMappingInput{
+		UUID: uuid,
+	})
+	if err != nil {
+		return &ClientError{
+			ResourceName: uuid,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (c *LambdaClient) ListProvisionedConcurrencyConfigs(ctx context.Context, functionName *string) ([]types.ProvisionedConcurrencyConfigListItem, error) {
+	var marker *string
+	configs := []types.ProvisionedConcurrencyConfigListItem{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return configs, &ClientError{
+				ResourceName: functionName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		output, err := c.client.ListProvisionedConcurrencyConfigs(ctx, &lambda.ListProvisionedConcurrencyConfigsInput{
+			FunctionName: functionName,
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: functionName,
+				Err:          err,
+			}
+		}
+		configs = append(configs, output.ProvisionedConcurrencyConfigs...)
+
+		marker = output.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return configs, nil
+}
+
+func (c *LambdaClient) DeleteProvisionedConcurrencyConfig(ctx context.Context, functionName *string, qualifier *string) error {
+	_, err := c.client.DeleteProvisionedConcurrencyConfig(ctx, &lambda.DeleteProvisionedConcurrencyConfigInput{
+		FunctionName: functionName,
+		Qualifier:    qualifier,
+	})
+	if err != nil {
+		return &ClientError{
+			ResourceName: functionName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (c *LambdaClient) ListLayerVersions(ctx context.Context, layerName *string) ([]types.LayerVersionsListItem, error) {
+	var marker *string
+	layerVersions := []types.LayerVersionsListItem{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return layerVersions, &ClientError{
+				ResourceName: layerName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		output, err := c.client.ListLayerVersions(ctx, &lambda.ListLayerVersionsInput{
+			LayerName: layerName,
+			Marker:    marker,
+		})
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: layerName,
+				Err:          err,
+			}
+		}
+		layerVersions = append(layerVersions, output.LayerVersions...)
+
+		marker = output.NextMarker
+		if marker == nil {
+			break
+		}
+	}
+
+	return layerVersions, nil
+}
+
+func (c *LambdaClient) DeleteLayerVersion(ctx context.Context, layerName *string, versionNumber int64) error {
+	_, err := c.client.DeleteLayerVersion(ctx, &lambda.DeleteLayerVersionInput{
+		LayerName:     layerName,
+		VersionNumber: &versionNumber,
+	})
+	if err != nil {
+		return &ClientError{
+			ResourceName: layerName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
 func (c *LambdaClient) waitForFunctionUpdated(ctx context.Context, functionName *string) error {
 	input := &lambda.GetFunctionInput{
 		FunctionName: functionName,
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	lambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	types "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +56,20 @@ func (mr *MockILambdaMockRecorder) CheckLambdaFunctionExists(ctx, functionName a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLambdaFunctionExists", reflect.TypeOf((*MockILambda)(nil).CheckLambdaFunctionExists), ctx, functionName)
 }
 
+// DeleteEventSourceMapping mocks base method.
+func (m *MockILambda) DeleteEventSourceMapping(ctx context.Context, uuid *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventSourceMapping", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventSourceMapping indicates an expected call of DeleteEventSourceMapping.
+func (mr *MockILambdaMockRecorder) DeleteEventSourceMapping(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSourceMapping", reflect.TypeOf((*MockILambda)(nil).DeleteEventSourceMapping), ctx, uuid)
+}
+
 // DeleteFunction mocks base method.
 func (m *MockILambda) DeleteFunction(ctx context.Context, functionName *string) error {
 	m.ctrl.T.Helper()
@@ -69,6 +84,34 @@ func (mr *MockILambdaMockRecorder) DeleteFunction(ctx, functionName any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunction", reflect.TypeOf((*MockILambda)(nil).DeleteFunction), ctx, functionName)
 }
 
+// DeleteLayerVersion mocks base method.
+func (m *MockILambda) DeleteLayerVersion(ctx context.Context, layerName *string, versionNumber int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLayerVersion", ctx, layerName, versionNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLayerVersion indicates an expected call of DeleteLayerVersion.
+func (mr *MockILambdaMockRecorder) DeleteLayerVersion(ctx, layerName, versionNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLayerVersion", reflect.TypeOf((*MockILambda)(nil).DeleteLayerVersion), ctx, layerName, versionNumber)
+}
+
+// DeleteProvisionedConcurrencyConfig mocks base method.
+func (m *MockILambda) DeleteProvisionedConcurrencyConfig(ctx context.Context, functionName, qualifier *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProvisionedConcurrencyConfig", ctx, functionName, qualifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProvisionedConcurrencyConfig indicates an expected call of DeleteProvisionedConcurrencyConfig.
+func (mr *MockILambdaMockRecorder) DeleteProvisionedConcurrencyConfig(ctx, functionName, qualifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvisionedConcurrencyConfig", reflect.TypeOf((*MockILambda)(nil).DeleteProvisionedConcurrencyConfig), ctx, functionName, qualifier)
+}
+
 // GetFunction mocks base method.
 func (m *MockILambda) GetFunction(ctx context.Context, functionName *string) (*lambda.GetFunctionOutput, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +127,51 @@ func (mr *MockILambdaMockRecorder) GetFunction(ctx, functionName any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunction", reflect.TypeOf((*MockILambda)(nil).GetFunction), ctx, functionName)
 }
 
+// ListEventSourceMappings mocks base method.
+func (m *MockILambda) ListEventSourceMappings(ctx context.Context, functionName, eventSourceArn *string) ([]types.EventSourceMappingConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventSourceMappings", ctx, functionName, eventSourceArn)
+	ret0, _ := ret[0].([]types.EventSourceMappingConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSourceMappings indicates an expected call of ListEventSourceMappings.
+func (mr *MockILambdaMockRecorder) ListEventSourceMappings(ctx, functionName, eventSourceArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappings", reflect.TypeOf((*MockILambda)(nil).ListEventSourceMappings), ctx, functionName, eventSourceArn)
+}
+
+// ListLayerVersions mocks base method.
+func (m *MockILambda) ListLayerVersions(ctx context.Context, layerName *string) ([]types.LayerVersionsListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLayerVersions", ctx, layerName)
+	ret0, _ := ret[0].([]types.LayerVersionsListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayerVersions indicates an expected call of ListLayerVersions.
+func (mr *MockILambdaMockRecorder) ListLayerVersions(ctx, layerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLayerVersions", reflect.TypeOf((*MockILambda)(nil).ListLayerVersions), ctx, layerName)
+}
+
+// ListProvisionedConcurrencyConfigs mocks base method.
+func (m *MockILambda) ListProvisionedConcurrencyConfigs(ctx context.Context, functionName *string) ([]types.ProvisionedConcurrencyConfigListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProvisionedConcurrencyConfigs", ctx, functionName)
+	ret0, _ := ret[0].([]types.ProvisionedConcurrencyConfigListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProvisionedConcurrencyConfigs indicates an expected call of ListProvisionedConcurrencyConfigs.
+func (mr *MockILambdaMockRecorder) ListProvisionedConcurrencyConfigs(ctx, functionName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvisionedConcurrencyConfigs", reflect.TypeOf((*MockILambda)(nil).ListProvisionedConcurrencyConfigs), ctx, functionName)
+}
+
 // UpdateFunctionConfiguration mocks base method.
 func (m *MockILambda) UpdateFunctionConfiguration(ctx context.Context, input *lambda.UpdateFunctionConfigurationInput) error {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -464,3 +465,598 @@ func TestLambdaClient_CheckLambdaFunctionExists(t *testing.T) {
 		})
 	}
 }
+
+func TestLambdaClient_ListEventSourceMappings(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		functionName       *string
+		eventSourceArn     *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.EventSourceMappingConfiguration
+		wantErr bool
+	}{
+		{
+			name: "list event source mappings successfully",
+			args: args{
+				ctx:            context.Background(),
+				eventSourceArn: aws.String("arn:aws:sqs:us-east-1:123456789012:test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListEventSourceMappingsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.ListEventSourceMappingsOutput{
+										EventSourceMappings: []types.EventSourceMappingConfiguration{
+											{
+												UUID: aws.String("uuid1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.EventSourceMappingConfiguration{
+				{
+					UUID: aws.String("uuid1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list event source mappings failure",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListEventSourceMappingsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.ListEventSourceMappingsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListEventSourceMappingsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := lambda.NewFromConfig(cfg)
+			waiter := lambda.NewFunctionUpdatedV2Waiter(client)
+			lambdaClient := NewLambdaClient(client, waiter)
+
+			got, err := lambdaClient.ListEventSourceMappings(tt.args.ctx, tt.args.functionName, tt.args.eventSourceArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+				return
+			}
+			if len(got) != len(tt.want) || aws.ToString(got[0].UUID) != aws.ToString(tt.want[0].UUID) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLambdaClient_DeleteEventSourceMapping(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		uuid               *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete event source mapping successfully",
+			args: args{
+				ctx:  context.Background(),
+				uuid: aws.String("uuid1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteEventSourceMappingMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.DeleteEventSourceMappingOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete event source mapping failure",
+			args: args{
+				ctx:  context.Background(),
+				uuid: aws.String("uuid1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteEventSourceMappingErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.DeleteEventSourceMappingOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteEventSourceMappingError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := lambda.NewFromConfig(cfg)
+			waiter := lambda.NewFunctionUpdatedV2Waiter(client)
+			lambdaClient := NewLambdaClient(client, waiter)
+
+			err = lambdaClient.DeleteEventSourceMapping(tt.args.ctx, tt.args.uuid)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+				if clientErr == nil || *clientErr.ResourceName != *tt.args.uuid {
+					t.Errorf("ClientError ResourceName = %#v, want %#v", clientErr, tt.args.uuid)
+				}
+			}
+		})
+	}
+}
+
+func TestLambdaClient_ListProvisionedConcurrencyConfigs(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		functionName       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.ProvisionedConcurrencyConfigListItem
+		wantErr bool
+	}{
+		{
+			name: "list provisioned concurrency configs successfully",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListProvisionedConcurrencyConfigsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.ListProvisionedConcurrencyConfigsOutput{
+										ProvisionedConcurrencyConfigs: []types.ProvisionedConcurrencyConfigListItem{
+											{
+												FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:test-function:live"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.ProvisionedConcurrencyConfigListItem{
+				{
+					FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:test-function:live"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list provisioned concurrency configs failure",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListProvisionedConcurrencyConfigsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.ListProvisionedConcurrencyConfigsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListProvisionedConcurrencyConfigsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := lambda.NewFromConfig(cfg)
+			waiter := lambda.NewFunctionUpdatedV2Waiter(client)
+			lambdaClient := NewLambdaClient(client, waiter)
+
+			got, err := lambdaClient.ListProvisionedConcurrencyConfigs(tt.args.ctx, tt.args.functionName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLambdaClient_DeleteProvisionedConcurrencyConfig(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		functionName       *string
+		qualifier          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete provisioned concurrency config successfully",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+				qualifier:    aws.String("live"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteProvisionedConcurrencyConfigMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.DeleteProvisionedConcurrencyConfigOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete provisioned concurrency config failure",
+			args: args{
+				ctx:          context.Background(),
+				functionName: aws.String("test-function"),
+				qualifier:    aws.String("live"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteProvisionedConcurrencyConfigErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.DeleteProvisionedConcurrencyConfigOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteProvisionedConcurrencyConfigError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := lambda.NewFromConfig(cfg)
+			waiter := lambda.NewFunctionUpdatedV2Waiter(client)
+			lambdaClient := NewLambdaClient(client, waiter)
+
+			err = lambdaClient.DeleteProvisionedConcurrencyConfig(tt.args.ctx, tt.args.functionName, tt.args.qualifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+				if clientErr == nil || *clientErr.ResourceName != *tt.args.functionName {
+					t.Errorf("ClientError ResourceName = %#v, want %#v", clientErr, tt.args.functionName)
+				}
+			}
+		})
+	}
+}
+
+func TestLambdaClient_ListLayerVersions(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		layerName          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.LayerVersionsListItem
+		wantErr bool
+	}{
+		{
+			name: "list layer versions successfully",
+			args: args{
+				ctx:       context.Background(),
+				layerName: aws.String("test-layer"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListLayerVersionsMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.ListLayerVersionsOutput{
+										LayerVersions: []types.LayerVersionsListItem{
+											{
+												Version: 1,
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.LayerVersionsListItem{
+				{
+					Version: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list layer versions failure",
+			args: args{
+				ctx:       context.Background(),
+				layerName: aws.String("test-layer"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListLayerVersionsErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.ListLayerVersionsOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListLayerVersionsError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := lambda.NewFromConfig(cfg)
+			waiter := lambda.NewFunctionUpdatedV2Waiter(client)
+			lambdaClient := NewLambdaClient(client, waiter)
+
+			got, err := lambdaClient.ListLayerVersions(tt.args.ctx, tt.args.layerName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLambdaClient_DeleteLayerVersion(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		layerName          *string
+		versionNumber      int64
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "delete layer version successfully",
+			args: args{
+				ctx:           context.Background(),
+				layerName:     aws.String("test-layer"),
+				versionNumber: 1,
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteLayerVersionMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.DeleteLayerVersionOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "delete layer version failure",
+			args: args{
+				ctx:           context.Background(),
+				layerName:     aws.String("test-layer"),
+				versionNumber: 1,
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteLayerVersionErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &lambda.DeleteLayerVersionOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteLayerVersionError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := lambda.NewFromConfig(cfg)
+			waiter := lambda.NewFunctionUpdatedV2Waiter(client)
+			lambdaClient := NewLambdaClient(client, waiter)
+
+			err = lambdaClient.DeleteLayerVersion(tt.args.ctx, tt.args.layerName, tt.args.versionNumber)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var clientErr *ClientError
+				if !errors.As(err, &clientErr) {
+					t.Errorf("expected ClientError, got = %#v", err)
+				}
+				if clientErr == nil || *clientErr.ResourceName != *tt.args.layerName {
+					t.Errorf("ClientError ResourceName = %#v, want %#v", clientErr, tt.args.layerName)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+//go:generate mockgen -source=$GOFILE -destination=neptune_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptune"
+)
+
+type INeptune interface {
+	CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error)
+	DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error
+}
+
+var _ INeptune = (*Neptune)(nil)
+
+type Neptune struct {
+	client *neptune.Client
+}
+
+func NewNeptune(client *neptune.Client) *Neptune {
+	return &Neptune{
+		client: client,
+	}
+}
+
+func (n *Neptune) CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error) {
+	input := &neptune.DescribeDBClustersInput{
+		DBClusterIdentifier: dbClusterId,
+	}
+
+	output, err := n.client.DescribeDBClusters(ctx, input)
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	if len(output.DBClusters) == 0 {
+		return false, nil
+	}
+
+	return aws.ToBool(output.DBClusters[0].DeletionProtection), nil
+}
+
+func (n *Neptune) DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error {
+	input := &neptune.ModifyDBClusterInput{
+		DBClusterIdentifier: dbClusterId,
+		DeletionProtection:  aws.Bool(false),
+	}
+
+	_, err := n.client.ModifyDBCluster(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
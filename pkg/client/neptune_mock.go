@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: neptune.go
+//
+// Generated by this command:
+//
+//	mockgen -source=neptune.go -destination=neptune_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINeptune is a mock of INeptune interface.
+type MockINeptune struct {
+	ctrl     *gomock.Controller
+	recorder *MockINeptuneMockRecorder
+	isgomock struct{}
+}
+
+// MockINeptuneMockRecorder is the mock recorder for MockINeptune.
+type MockINeptuneMockRecorder struct {
+	mock *MockINeptune
+}
+
+// NewMockINeptune creates a new mock instance.
+func NewMockINeptune(ctrl *gomock.Controller) *MockINeptune {
+	mock := &MockINeptune{ctrl: ctrl}
+	mock.recorder = &MockINeptuneMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINeptune) EXPECT() *MockINeptuneMockRecorder {
+	return m.recorder
+}
+
+// CheckDBClusterDeletionProtection mocks base method.
+func (m *MockINeptune) CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDBClusterDeletionProtection", ctx, dbClusterId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDBClusterDeletionProtection indicates an expected call of CheckDBClusterDeletionProtection.
+func (mr *MockINeptuneMockRecorder) CheckDBClusterDeletionProtection(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDBClusterDeletionProtection", reflect.TypeOf((*MockINeptune)(nil).CheckDBClusterDeletionProtection), ctx, dbClusterId)
+}
+
+// DisableDBClusterDeletionProtection mocks base method.
+func (m *MockINeptune) DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableDBClusterDeletionProtection", ctx, dbClusterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableDBClusterDeletionProtection indicates an expected call of DisableDBClusterDeletionProtection.
+func (mr *MockINeptuneMockRecorder) DisableDBClusterDeletionProtection(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableDBClusterDeletionProtection", reflect.TypeOf((*MockINeptune)(nil).DisableDBClusterDeletionProtection), ctx, dbClusterId)
+}
@@ -0,0 +1,115 @@
+//go:generate mockgen -source=$GOFILE -destination=networkfirewall_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+)
+
+type INetworkFirewall interface {
+	DescribeFirewall(ctx context.Context, firewallArn *string) (*types.Firewall, error)
+	CheckFirewallProtection(ctx context.Context, firewallArn *string) (bool, error)
+	DisableFirewallProtection(ctx context.Context, firewallArn *string) error
+}
+
+var _ INetworkFirewall = (*NetworkFirewall)(nil)
+
+type NetworkFirewall struct {
+	client *networkfirewall.Client
+}
+
+func NewNetworkFirewall(client *networkfirewall.Client) *NetworkFirewall {
+	return &NetworkFirewall{
+		client: client,
+	}
+}
+
+// DescribeFirewall returns nil if the firewall does not exist.
+func (n *NetworkFirewall) DescribeFirewall(ctx context.Context, firewallArn *string) (*types.Firewall, error) {
+	input := &networkfirewall.DescribeFirewallInput{
+		FirewallArn: firewallArn,
+	}
+
+	output, err := n.client.DescribeFirewall(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: firewallArn,
+			Err:          err,
+		}
+	}
+
+	return output.Firewall, nil
+}
+
+// CheckFirewallProtection reports whether any of the delete protection, the subnet change protection
+// or the firewall policy change protection is enabled.
+func (n *NetworkFirewall) CheckFirewallProtection(ctx context.Context, firewallArn *string) (bool, error) {
+	firewall, err := n.DescribeFirewall(ctx, firewallArn)
+	if err != nil {
+		return false, err
+	}
+	if firewall == nil {
+		return false, nil
+	}
+
+	return firewall.DeleteProtection || firewall.SubnetChangeProtection || firewall.FirewallPolicyChangeProtection, nil
+}
+
+// DisableFirewallProtection disables each of the delete protection, the subnet change protection and
+// the firewall policy change protection that is enabled.
+func (n *NetworkFirewall) DisableFirewallProtection(ctx context.Context, firewallArn *string) error {
+	firewall, err := n.DescribeFirewall(ctx, firewallArn)
+	if err != nil {
+		return err
+	}
+	if firewall == nil {
+		return nil
+	}
+
+	if firewall.DeleteProtection {
+		input := &networkfirewall.UpdateFirewallDeleteProtectionInput{
+			FirewallArn:      firewallArn,
+			DeleteProtection: false,
+		}
+		if _, err := n.client.UpdateFirewallDeleteProtection(ctx, input); err != nil {
+			return &ClientError{
+				ResourceName: firewallArn,
+				Err:          err,
+			}
+		}
+	}
+
+	if firewall.SubnetChangeProtection {
+		input := &networkfirewall.UpdateSubnetChangeProtectionInput{
+			FirewallArn:            firewallArn,
+			SubnetChangeProtection: false,
+		}
+		if _, err := n.client.UpdateSubnetChangeProtection(ctx, input); err != nil {
+			return &ClientError{
+				ResourceName: firewallArn,
+				Err:          err,
+			}
+		}
+	}
+
+	if firewall.FirewallPolicyChangeProtection {
+		input := &networkfirewall.UpdateFirewallPolicyChangeProtectionInput{
+			FirewallArn:                    firewallArn,
+			FirewallPolicyChangeProtection: false,
+		}
+		if _, err := n.client.UpdateFirewallPolicyChangeProtection(ctx, input); err != nil {
+			return &ClientError{
+				ResourceName: firewallArn,
+				Err:          err,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: networkfirewall.go
+//
+// Generated by this command:
+//
+//	mockgen -source=networkfirewall.go -destination=networkfirewall_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINetworkFirewall is a mock of INetworkFirewall interface.
+type MockINetworkFirewall struct {
+	ctrl     *gomock.Controller
+	recorder *MockINetworkFirewallMockRecorder
+	isgomock struct{}
+}
+
+// MockINetworkFirewallMockRecorder is the mock recorder for MockINetworkFirewall.
+type MockINetworkFirewallMockRecorder struct {
+	mock *MockINetworkFirewall
+}
+
+// NewMockINetworkFirewall creates a new mock instance.
+func NewMockINetworkFirewall(ctrl *gomock.Controller) *MockINetworkFirewall {
+	mock := &MockINetworkFirewall{ctrl: ctrl}
+	mock.recorder = &MockINetworkFirewallMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINetworkFirewall) EXPECT() *MockINetworkFirewallMockRecorder {
+	return m.recorder
+}
+
+// CheckFirewallProtection mocks base method.
+func (m *MockINetworkFirewall) CheckFirewallProtection(ctx context.Context, firewallArn *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckFirewallProtection", ctx, firewallArn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckFirewallProtection indicates an expected call of CheckFirewallProtection.
+func (mr *MockINetworkFirewallMockRecorder) CheckFirewallProtection(ctx, firewallArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckFirewallProtection", reflect.TypeOf((*MockINetworkFirewall)(nil).CheckFirewallProtection), ctx, firewallArn)
+}
+
+// DescribeFirewall mocks base method.
+func (m *MockINetworkFirewall) DescribeFirewall(ctx context.Context, firewallArn *string) (*types.Firewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeFirewall", ctx, firewallArn)
+	ret0, _ := ret[0].(*types.Firewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFirewall indicates an expected call of DescribeFirewall.
+func (mr *MockINetworkFirewallMockRecorder) DescribeFirewall(ctx, firewallArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFirewall", reflect.TypeOf((*MockINetworkFirewall)(nil).DescribeFirewall), ctx, firewallArn)
+}
+
+// DisableFirewallProtection mocks base method.
+func (m *MockINetworkFirewall) DisableFirewallProtection(ctx context.Context, firewallArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableFirewallProtection", ctx, firewallArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableFirewallProtection indicates an expected call of DisableFirewallProtection.
+func (mr *MockINetworkFirewallMockRecorder) DisableFirewallProtection(ctx, firewallArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableFirewallProtection", reflect.TypeOf((*MockINetworkFirewall)(nil).DisableFirewallProtection), ctx, firewallArn)
+}
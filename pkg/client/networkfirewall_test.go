@@ -0,0 +1,529 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsMiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+/*
+	Test Cases
+*/
+
+func TestNetworkFirewall_DescribeFirewall(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		firewallArn        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    *types.Firewall
+		wantErr bool
+	}{
+		{
+			name: "describe firewall successfully",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{
+										Firewall: &types.Firewall{
+											FirewallName:                   aws.String("test"),
+											DeleteProtection:               true,
+											SubnetChangeProtection:         false,
+											FirewallPolicyChangeProtection: false,
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &types.Firewall{
+				FirewallName:                   aws.String("test"),
+				DeleteProtection:               true,
+				SubnetChangeProtection:         false,
+				FirewallPolicyChangeProtection: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "describe firewall successfully for not found",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe firewall failure",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeFirewallError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := networkfirewall.NewFromConfig(cfg)
+			networkFirewallClient := NewNetworkFirewall(client)
+
+			output, err := networkFirewallClient.DescribeFirewall(tt.args.ctx, tt.args.firewallArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkFirewall_CheckFirewallProtection(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		firewallArn        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check firewall protection successfully for delete protection",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallDeleteProtectionMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{
+										Firewall: &types.Firewall{
+											FirewallName:                   aws.String("test"),
+											DeleteProtection:               true,
+											SubnetChangeProtection:         false,
+											FirewallPolicyChangeProtection: false,
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check firewall protection successfully for subnet change protection",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallSubnetChangeProtectionMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{
+										Firewall: &types.Firewall{
+											FirewallName:                   aws.String("test"),
+											DeleteProtection:               false,
+											SubnetChangeProtection:         true,
+											FirewallPolicyChangeProtection: false,
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check firewall protection successfully for firewall policy change protection",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallPolicyChangeProtectionMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{
+										Firewall: &types.Firewall{
+											FirewallName:                   aws.String("test"),
+											DeleteProtection:               false,
+											SubnetChangeProtection:         false,
+											FirewallPolicyChangeProtection: true,
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check firewall protection successfully for no protection",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallNoProtectionMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{
+										Firewall: &types.Firewall{
+											FirewallName:                   aws.String("test"),
+											DeleteProtection:               false,
+											SubnetChangeProtection:         false,
+											FirewallPolicyChangeProtection: false,
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check firewall protection successfully for not found",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check firewall protection failure",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeFirewallError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := networkfirewall.NewFromConfig(cfg)
+			networkFirewallClient := NewNetworkFirewall(client)
+
+			output, err := networkFirewallClient.CheckFirewallProtection(tt.args.ctx, tt.args.firewallArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkFirewall_DisableFirewallProtection(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		firewallArn        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "disable firewall protection successfully",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallOrUpdateProtectionMock",
+							func(ctx context.Context, input middleware.FinalizeInput, handler middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								operationName := awsMiddleware.GetOperationName(ctx)
+								if operationName == "DescribeFirewall" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.DescribeFirewallOutput{
+											Firewall: &types.Firewall{
+												FirewallName:                   aws.String("test"),
+												DeleteProtection:               true,
+												SubnetChangeProtection:         true,
+												FirewallPolicyChangeProtection: true,
+											},
+										},
+									}, middleware.Metadata{}, nil
+								}
+								if operationName == "UpdateFirewallDeleteProtection" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.UpdateFirewallDeleteProtectionOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								if operationName == "UpdateSubnetChangeProtection" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.UpdateSubnetChangeProtectionOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								if operationName == "UpdateFirewallPolicyChangeProtection" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.UpdateFirewallPolicyChangeProtectionOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected operation: %s", operationName)
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "disable firewall protection successfully for not found",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "disable firewall protection failure for describe firewall errors",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &networkfirewall.DescribeFirewallOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeFirewallError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				Err:          fmt.Errorf("operation error Network Firewall: DescribeFirewall, DescribeFirewallError"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "disable firewall protection failure for update subnet change protection errors",
+			args: args{
+				ctx:         context.Background(),
+				firewallArn: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeFirewallOrUpdateProtectionErrorMock",
+							func(ctx context.Context, input middleware.FinalizeInput, handler middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								operationName := awsMiddleware.GetOperationName(ctx)
+								if operationName == "DescribeFirewall" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.DescribeFirewallOutput{
+											Firewall: &types.Firewall{
+												FirewallName:                   aws.String("test"),
+												DeleteProtection:               false,
+												SubnetChangeProtection:         true,
+												FirewallPolicyChangeProtection: true,
+											},
+										},
+									}, middleware.Metadata{}, nil
+								}
+								if operationName == "UpdateSubnetChangeProtection" {
+									return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("UpdateSubnetChangeProtectionError")
+								}
+								if operationName == "UpdateFirewallDeleteProtection" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.UpdateFirewallDeleteProtectionOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								if operationName == "UpdateSubnetChangeProtection" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.UpdateSubnetChangeProtectionOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								if operationName == "UpdateFirewallPolicyChangeProtection" {
+									return middleware.FinalizeOutput{
+										Result: &networkfirewall.UpdateFirewallPolicyChangeProtectionOutput{},
+									}, middleware.Metadata{}, nil
+								}
+								return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected operation: %s", operationName)
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("arn:aws:network-firewall:ap-northeast-1:123456789012:firewall/test"),
+				Err:          fmt.Errorf("operation error Network Firewall: UpdateSubnetChangeProtection, UpdateSubnetChangeProtectionError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := networkfirewall.NewFromConfig(cfg)
+			networkFirewallClient := NewNetworkFirewall(client)
+
+			err = networkFirewallClient.DisableFirewallProtection(tt.args.ctx, tt.args.firewallArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+//go:generate mockgen -source=$GOFILE -destination=opensearch_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+)
+
+var SleepTimeSecForOpenSearch = 5
+
+type IOpenSearch interface {
+	DescribeDomain(ctx context.Context, domainName *string) (*types.DomainStatus, error)
+	ListPackagesForDomain(ctx context.Context, domainName *string) ([]types.DomainPackageDetails, error)
+	DissociatePackage(ctx context.Context, domainName *string, packageId *string) error
+	ListVpcEndpointsForDomain(ctx context.Context, domainName *string) ([]types.VpcEndpointSummary, error)
+	DeleteVpcEndpoint(ctx context.Context, vpcEndpointId *string) error
+	DeleteDomain(ctx context.Context, domainName *string) error
+	CheckDomainExists(ctx context.Context, domainName *string) (bool, error)
+}
+
+var _ IOpenSearch = (*OpenSearch)(nil)
+
+type OpenSearch struct {
+	client  *opensearch.Client
+	retryer *Retryer
+}
+
+func NewOpenSearch(client *opensearch.Client) *OpenSearch {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "Rate exceeded") ||
+			strings.Contains(err.Error(), "LimitExceededException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForOpenSearch)
+
+	return &OpenSearch{
+		client,
+		retryer,
+	}
+}
+
+func (o *OpenSearch) DescribeDomain(ctx context.Context, domainName *string) (*types.DomainStatus, error) {
+	input := &opensearch.DescribeDomainInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	output, err := o.client.DescribeDomain(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+
+	return output.DomainStatus, nil
+}
+
+func (o *OpenSearch) ListPackagesForDomain(ctx context.Context, domainName *string) ([]types.DomainPackageDetails, error) {
+	var nextToken *string
+	packages := []types.DomainPackageDetails{}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return packages, &ClientError{
+				ResourceName: domainName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &opensearch.ListPackagesForDomainInput{
+			DomainName: domainName,
+			NextToken:  nextToken,
+		}
+
+		output, err := o.client.ListPackagesForDomain(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: domainName,
+				Err:          err,
+			}
+		}
+		packages = append(packages, output.DomainPackageDetailsList...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return packages, nil
+}
+
+func (o *OpenSearch) DissociatePackage(ctx context.Context, domainName *string, packageId *string) error {
+	input := &opensearch.DissociatePackageInput{
+		DomainName: domainName,
+		PackageID:  packageId,
+	}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	_, err := o.client.DissociatePackage(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (o *OpenSearch) ListVpcEndpointsForDomain(ctx context.Context, domainName *string) ([]types.VpcEndpointSummary, error) {
+	var nextToken *string
+	vpcEndpoints := []types.VpcEndpointSummary{}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return vpcEndpoints, &ClientError{
+				ResourceName: domainName,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &opensearch.ListVpcEndpointsForDomainInput{
+			DomainName: domainName,
+			NextToken:  nextToken,
+		}
+
+		output, err := o.client.ListVpcEndpointsForDomain(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: domainName,
+				Err:          err,
+			}
+		}
+		vpcEndpoints = append(vpcEndpoints, output.VpcEndpointSummaryList...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return vpcEndpoints, nil
+}
+
+func (o *OpenSearch) DeleteVpcEndpoint(ctx context.Context, vpcEndpointId *string) error {
+	input := &opensearch.DeleteVpcEndpointInput{
+		VpcEndpointId: vpcEndpointId,
+	}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	_, err := o.client.DeleteVpcEndpoint(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: vpcEndpointId,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (o *OpenSearch) DeleteDomain(ctx context.Context, domainName *string) error {
+	input := &opensearch.DeleteDomainInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	_, err := o.client.DeleteDomain(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (o *OpenSearch) CheckDomainExists(ctx context.Context, domainName *string) (bool, error) {
+	input := &opensearch.DescribeDomainInput{
+		DomainName: domainName,
+	}
+
+	optFn := func(opts *opensearch.Options) {
+		opts.Retryer = o.retryer
+	}
+
+	_, err := o.client.DescribeDomain(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: domainName,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
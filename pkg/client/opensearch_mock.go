@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: opensearch.go
+//
+// Generated by this command:
+//
+//	mockgen -source=opensearch.go -destination=opensearch_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOpenSearch is a mock of IOpenSearch interface.
+type MockIOpenSearch struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOpenSearchMockRecorder
+	isgomock struct{}
+}
+
+// MockIOpenSearchMockRecorder is the mock recorder for MockIOpenSearch.
+type MockIOpenSearchMockRecorder struct {
+	mock *MockIOpenSearch
+}
+
+// NewMockIOpenSearch creates a new mock instance.
+func NewMockIOpenSearch(ctrl *gomock.Controller) *MockIOpenSearch {
+	mock := &MockIOpenSearch{ctrl: ctrl}
+	mock.recorder = &MockIOpenSearchMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOpenSearch) EXPECT() *MockIOpenSearchMockRecorder {
+	return m.recorder
+}
+
+// CheckDomainExists mocks base method.
+func (m *MockIOpenSearch) CheckDomainExists(ctx context.Context, domainName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDomainExists", ctx, domainName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDomainExists indicates an expected call of CheckDomainExists.
+func (mr *MockIOpenSearchMockRecorder) CheckDomainExists(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDomainExists", reflect.TypeOf((*MockIOpenSearch)(nil).CheckDomainExists), ctx, domainName)
+}
+
+// DeleteDomain mocks base method.
+func (m *MockIOpenSearch) DeleteDomain(ctx context.Context, domainName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomain", ctx, domainName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain.
+func (mr *MockIOpenSearchMockRecorder) DeleteDomain(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockIOpenSearch)(nil).DeleteDomain), ctx, domainName)
+}
+
+// DeleteVpcEndpoint mocks base method.
+func (m *MockIOpenSearch) DeleteVpcEndpoint(ctx context.Context, vpcEndpointId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpoint", ctx, vpcEndpointId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpcEndpoint indicates an expected call of DeleteVpcEndpoint.
+func (mr *MockIOpenSearchMockRecorder) DeleteVpcEndpoint(ctx, vpcEndpointId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpoint", reflect.TypeOf((*MockIOpenSearch)(nil).DeleteVpcEndpoint), ctx, vpcEndpointId)
+}
+
+// DescribeDomain mocks base method.
+func (m *MockIOpenSearch) DescribeDomain(ctx context.Context, domainName *string) (*types.DomainStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDomain", ctx, domainName)
+	ret0, _ := ret[0].(*types.DomainStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomain indicates an expected call of DescribeDomain.
+func (mr *MockIOpenSearchMockRecorder) DescribeDomain(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomain", reflect.TypeOf((*MockIOpenSearch)(nil).DescribeDomain), ctx, domainName)
+}
+
+// DissociatePackage mocks base method.
+func (m *MockIOpenSearch) DissociatePackage(ctx context.Context, domainName, packageId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DissociatePackage", ctx, domainName, packageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DissociatePackage indicates an expected call of DissociatePackage.
+func (mr *MockIOpenSearchMockRecorder) DissociatePackage(ctx, domainName, packageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DissociatePackage", reflect.TypeOf((*MockIOpenSearch)(nil).DissociatePackage), ctx, domainName, packageId)
+}
+
+// ListPackagesForDomain mocks base method.
+func (m *MockIOpenSearch) ListPackagesForDomain(ctx context.Context, domainName *string) ([]types.DomainPackageDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackagesForDomain", ctx, domainName)
+	ret0, _ := ret[0].([]types.DomainPackageDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackagesForDomain indicates an expected call of ListPackagesForDomain.
+func (mr *MockIOpenSearchMockRecorder) ListPackagesForDomain(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackagesForDomain", reflect.TypeOf((*MockIOpenSearch)(nil).ListPackagesForDomain), ctx, domainName)
+}
+
+// ListVpcEndpointsForDomain mocks base method.
+func (m *MockIOpenSearch) ListVpcEndpointsForDomain(ctx context.Context, domainName *string) ([]types.VpcEndpointSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcEndpointsForDomain", ctx, domainName)
+	ret0, _ := ret[0].([]types.VpcEndpointSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcEndpointsForDomain indicates an expected call of ListVpcEndpointsForDomain.
+func (mr *MockIOpenSearchMockRecorder) ListVpcEndpointsForDomain(ctx, domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcEndpointsForDomain", reflect.TypeOf((*MockIOpenSearch)(nil).ListVpcEndpointsForDomain), ctx, domainName)
+}
@@ -0,0 +1,796 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/aws/smithy-go/middleware"
+)
+
+type tokenKeyForOpenSearch struct{}
+
+func getNextTokenForOpenSearchInitialize(
+	ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	switch v := in.Parameters.(type) {
+	case *opensearch.ListPackagesForDomainInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForOpenSearch{}, v.NextToken)
+	case *opensearch.ListVpcEndpointsForDomainInput:
+		ctx = middleware.WithStackValue(ctx, tokenKeyForOpenSearch{}, v.NextToken)
+	}
+	return next.HandleInitialize(ctx, in)
+}
+
+/*
+	Test Cases
+*/
+
+func TestOpenSearch_DescribeDomain(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe domain successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DescribeDomainOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe domain failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DescribeDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error OpenSearch: DescribeDomain, DescribeDomainError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg)
+			openSearchClient := NewOpenSearch(client)
+
+			_, err = openSearchClient.DescribeDomain(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSearch_ListPackagesForDomain(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.DomainPackageDetails
+		wantErr bool
+	}{
+		{
+			name: "list packages for domain successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListPackagesForDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.ListPackagesForDomainOutput{
+										DomainPackageDetailsList: []types.DomainPackageDetails{
+											{
+												PackageID: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.DomainPackageDetails{
+				{
+					PackageID: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list packages for domain with next token successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListPackagesForDomainWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForOpenSearch{}).(*string)
+
+								var nextToken *string
+								var items []types.DomainPackageDetails
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.DomainPackageDetails{
+										{
+											PackageID: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.DomainPackageDetails{
+										{
+											PackageID: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &opensearch.ListPackagesForDomainOutput{
+										DomainPackageDetailsList: items,
+										NextToken:                nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.DomainPackageDetails{
+				{
+					PackageID: aws.String("Item1"),
+				},
+				{
+					PackageID: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list packages for domain failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListPackagesForDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.ListPackagesForDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListPackagesForDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg, func(o *opensearch.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForOpenSearchInitialize), middleware.Before)
+				})
+			})
+			openSearchClient := NewOpenSearch(client)
+
+			output, err := openSearchClient.ListPackagesForDomain(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSearch_DissociatePackage(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		packageId          *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "dissociate package successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				packageId:  aws.String("package"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DissociatePackageMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DissociatePackageOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "dissociate package failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				packageId:  aws.String("package"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DissociatePackageErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DissociatePackageOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DissociatePackageError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error OpenSearch: DissociatePackage, DissociatePackageError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg)
+			openSearchClient := NewOpenSearch(client)
+
+			err = openSearchClient.DissociatePackage(tt.args.ctx, tt.args.domainName, tt.args.packageId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSearch_ListVpcEndpointsForDomain(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    []types.VpcEndpointSummary
+		wantErr bool
+	}{
+		{
+			name: "list vpc endpoints for domain successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListVpcEndpointsForDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.ListVpcEndpointsForDomainOutput{
+										VpcEndpointSummaryList: []types.VpcEndpointSummary{
+											{
+												VpcEndpointId: aws.String("Item1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.VpcEndpointSummary{
+				{
+					VpcEndpointId: aws.String("Item1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list vpc endpoints for domain with next token successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListVpcEndpointsForDomainWithNextTokenMock",
+							func(ctx context.Context, input middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								token := middleware.GetStackValue(ctx, tokenKeyForOpenSearch{}).(*string)
+
+								var nextToken *string
+								var items []types.VpcEndpointSummary
+								if token == nil {
+									nextToken = aws.String("NextToken")
+									items = []types.VpcEndpointSummary{
+										{
+											VpcEndpointId: aws.String("Item1"),
+										},
+									}
+								} else {
+									items = []types.VpcEndpointSummary{
+										{
+											VpcEndpointId: aws.String("Item2"),
+										},
+									}
+								}
+
+								return middleware.FinalizeOutput{
+									Result: &opensearch.ListVpcEndpointsForDomainOutput{
+										VpcEndpointSummaryList: items,
+										NextToken:              nextToken,
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: []types.VpcEndpointSummary{
+				{
+					VpcEndpointId: aws.String("Item1"),
+				},
+				{
+					VpcEndpointId: aws.String("Item2"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "list vpc endpoints for domain failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"ListVpcEndpointsForDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.ListVpcEndpointsForDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ListVpcEndpointsForDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg, func(o *opensearch.Options) {
+				o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+					return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("GetNextToken", getNextTokenForOpenSearchInitialize), middleware.Before)
+				})
+			})
+			openSearchClient := NewOpenSearch(client)
+
+			output, err := openSearchClient.ListVpcEndpointsForDomain(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(output, tt.want) {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSearch_DeleteVpcEndpoint(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		vpcEndpointId      *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete vpc endpoint successfully",
+			args: args{
+				ctx:           context.Background(),
+				vpcEndpointId: aws.String("endpoint"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteVpcEndpointMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DeleteVpcEndpointOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete vpc endpoint failure",
+			args: args{
+				ctx:           context.Background(),
+				vpcEndpointId: aws.String("endpoint"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteVpcEndpointErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DeleteVpcEndpointOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteVpcEndpointError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("endpoint"),
+				Err:          fmt.Errorf("operation error OpenSearch: DeleteVpcEndpoint, DeleteVpcEndpointError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg)
+			openSearchClient := NewOpenSearch(client)
+
+			err = openSearchClient.DeleteVpcEndpoint(tt.args.ctx, tt.args.vpcEndpointId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSearch_DeleteDomain(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete domain successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DeleteDomainOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete domain failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DeleteDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("test"),
+				Err:          fmt.Errorf("operation error OpenSearch: DeleteDomain, DeleteDomainError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg)
+			openSearchClient := NewOpenSearch(client)
+
+			err = openSearchClient.DeleteDomain(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenSearch_CheckDomainExists(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		domainName         *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check domain exists successfully",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDomainMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DescribeDomainOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check domain exists successfully for not found",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDomainNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DescribeDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("ResourceNotFoundException")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check domain exists failure",
+			args: args{
+				ctx:        context.Background(),
+				domainName: aws.String("test"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDomainErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &opensearch.DescribeDomainOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeDomainError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("ap-northeast-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := opensearch.NewFromConfig(cfg)
+			openSearchClient := NewOpenSearch(client)
+
+			output, err := openSearchClient.CheckDomainExists(tt.args.ctx, tt.args.domainName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
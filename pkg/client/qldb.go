@@ -0,0 +1,63 @@
+//go:generate mockgen -source=$GOFILE -destination=qldb_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+)
+
+type IQLDB interface {
+	CheckLedgerDeletionProtection(ctx context.Context, ledgerName *string) (bool, error)
+	DisableLedgerDeletionProtection(ctx context.Context, ledgerName *string) error
+}
+
+var _ IQLDB = (*QLDB)(nil)
+
+type QLDB struct {
+	client *qldb.Client
+}
+
+func NewQLDB(client *qldb.Client) *QLDB {
+	return &QLDB{
+		client: client,
+	}
+}
+
+func (q *QLDB) CheckLedgerDeletionProtection(ctx context.Context, ledgerName *string) (bool, error) {
+	input := &qldb.DescribeLedgerInput{
+		Name: ledgerName,
+	}
+
+	output, err := q.client.DescribeLedger(ctx, input)
+	if err != nil && strings.Contains(err.Error(), "ResourceNotFoundException") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: ledgerName,
+			Err:          err,
+		}
+	}
+
+	return aws.ToBool(output.DeletionProtection), nil
+}
+
+func (q *QLDB) DisableLedgerDeletionProtection(ctx context.Context, ledgerName *string) error {
+	input := &qldb.UpdateLedgerInput{
+		Name:               ledgerName,
+		DeletionProtection: aws.Bool(false),
+	}
+
+	_, err := q.client.UpdateLedger(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: ledgerName,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
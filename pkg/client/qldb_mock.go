@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: qldb.go
+//
+// Generated by this command:
+//
+//	mockgen -source=qldb.go -destination=qldb_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIQLDB is a mock of IQLDB interface.
+type MockIQLDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQLDBMockRecorder
+	isgomock struct{}
+}
+
+// MockIQLDBMockRecorder is the mock recorder for MockIQLDB.
+type MockIQLDBMockRecorder struct {
+	mock *MockIQLDB
+}
+
+// NewMockIQLDB creates a new mock instance.
+func NewMockIQLDB(ctrl *gomock.Controller) *MockIQLDB {
+	mock := &MockIQLDB{ctrl: ctrl}
+	mock.recorder = &MockIQLDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQLDB) EXPECT() *MockIQLDBMockRecorder {
+	return m.recorder
+}
+
+// CheckLedgerDeletionProtection mocks base method.
+func (m *MockIQLDB) CheckLedgerDeletionProtection(ctx context.Context, ledgerName *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLedgerDeletionProtection", ctx, ledgerName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLedgerDeletionProtection indicates an expected call of CheckLedgerDeletionProtection.
+func (mr *MockIQLDBMockRecorder) CheckLedgerDeletionProtection(ctx, ledgerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLedgerDeletionProtection", reflect.TypeOf((*MockIQLDB)(nil).CheckLedgerDeletionProtection), ctx, ledgerName)
+}
+
+// DisableLedgerDeletionProtection mocks base method.
+func (m *MockIQLDB) DisableLedgerDeletionProtection(ctx context.Context, ledgerName *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableLedgerDeletionProtection", ctx, ledgerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableLedgerDeletionProtection indicates an expected call of DisableLedgerDeletionProtection.
+func (mr *MockIQLDBMockRecorder) DisableLedgerDeletionProtection(ctx, ledgerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableLedgerDeletionProtection", reflect.TypeOf((*MockIQLDB)(nil).DisableLedgerDeletionProtection), ctx, ledgerName)
+}
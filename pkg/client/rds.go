@@ -3,9 +3,12 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 )
 
 type IRDS interface {
@@ -13,6 +16,13 @@ type IRDS interface {
 	DisableDBInstanceDeletionProtection(ctx context.Context, dbInstanceId *string) error
 	CheckDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) (bool, error)
 	DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error
+	DescribeDBCluster(ctx context.Context, dbClusterId *string) (*types.DBCluster, error)
+	CheckDBClusterExists(ctx context.Context, dbClusterId *string) (bool, error)
+	DescribeGlobalCluster(ctx context.Context, globalClusterId *string) (*types.GlobalCluster, error)
+	RemoveFromGlobalCluster(ctx context.Context, globalClusterId *string, dbClusterArn *string) error
+	PromoteReadReplicaDBCluster(ctx context.Context, dbClusterId *string) error
+	DeleteDBInstance(ctx context.Context, dbInstanceId *string) error
+	DeleteDBCluster(ctx context.Context, dbClusterId *string, finalSnapshotIdentifier *string) error
 }
 
 var _ IRDS = (*RDS)(nil)
@@ -100,3 +110,141 @@ func (r *RDS) DisableDBClusterDeletionProtection(ctx context.Context, dbClusterI
 
 	return nil
 }
+
+func (r *RDS) DescribeDBCluster(ctx context.Context, dbClusterId *string) (*types.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: dbClusterId,
+	}
+
+	output, err := r.client.DescribeDBClusters(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	if len(output.DBClusters) == 0 {
+		return nil, &ClientError{
+			ResourceName: dbClusterId,
+			Err:          fmt.Errorf("DBClusterNotFoundError: the DB cluster does not exist"),
+		}
+	}
+
+	return &output.DBClusters[0], nil
+}
+
+func (r *RDS) CheckDBClusterExists(ctx context.Context, dbClusterId *string) (bool, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: dbClusterId,
+	}
+
+	output, err := r.client.DescribeDBClusters(ctx, input)
+	if err != nil {
+		if strings.Contains(err.Error(), "DBClusterNotFoundFault") {
+			return false, nil
+		}
+		return false, &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	return len(output.DBClusters) > 0, nil
+}
+
+func (r *RDS) DescribeGlobalCluster(ctx context.Context, globalClusterId *string) (*types.GlobalCluster, error) {
+	input := &rds.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: globalClusterId,
+	}
+
+	output, err := r.client.DescribeGlobalClusters(ctx, input)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: globalClusterId,
+			Err:          err,
+		}
+	}
+
+	if len(output.GlobalClusters) == 0 {
+		return nil, &ClientError{
+			ResourceName: globalClusterId,
+			Err:          fmt.Errorf("GlobalClusterNotFoundError: the global cluster does not exist"),
+		}
+	}
+
+	return &output.GlobalClusters[0], nil
+}
+
+// RemoveFromGlobalCluster detaches the DB cluster from the global cluster. A detached secondary
+// cluster is promoted to a standalone cluster.
+func (r *RDS) RemoveFromGlobalCluster(ctx context.Context, globalClusterId *string, dbClusterArn *string) error {
+	input := &rds.RemoveFromGlobalClusterInput{
+		GlobalClusterIdentifier: globalClusterId,
+		DbClusterIdentifier:     dbClusterArn,
+	}
+
+	_, err := r.client.RemoveFromGlobalCluster(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: dbClusterArn,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+func (r *RDS) PromoteReadReplicaDBCluster(ctx context.Context, dbClusterId *string) error {
+	input := &rds.PromoteReadReplicaDBClusterInput{
+		DBClusterIdentifier: dbClusterId,
+	}
+
+	_, err := r.client.PromoteReadReplicaDBCluster(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+// DeleteDBInstance deletes a DB instance in a DB cluster. Such instances have no snapshots of
+// their own, so no final snapshot is taken.
+func (r *RDS) DeleteDBInstance(ctx context.Context, dbInstanceId *string) error {
+	input := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: dbInstanceId,
+		SkipFinalSnapshot:    aws.Bool(true),
+	}
+
+	_, err := r.client.DeleteDBInstance(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: dbInstanceId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
+
+// DeleteDBCluster deletes the DB cluster. The final snapshot is skipped if finalSnapshotIdentifier is nil.
+func (r *RDS) DeleteDBCluster(ctx context.Context, dbClusterId *string, finalSnapshotIdentifier *string) error {
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier:       dbClusterId,
+		FinalDBSnapshotIdentifier: finalSnapshotIdentifier,
+		SkipFinalSnapshot:         aws.Bool(finalSnapshotIdentifier == nil),
+	}
+
+	_, err := r.client.DeleteDBCluster(ctx, input)
+	if err != nil {
+		return &ClientError{
+			ResourceName: dbClusterId,
+			Err:          err,
+		}
+	}
+
+	return nil
+}
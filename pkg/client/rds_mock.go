@@ -12,6 +12,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,6 +55,21 @@ func (mr *MockIRDSMockRecorder) CheckDBClusterDeletionProtection(ctx, dbClusterI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDBClusterDeletionProtection", reflect.TypeOf((*MockIRDS)(nil).CheckDBClusterDeletionProtection), ctx, dbClusterId)
 }
 
+// CheckDBClusterExists mocks base method.
+func (m *MockIRDS) CheckDBClusterExists(ctx context.Context, dbClusterId *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDBClusterExists", ctx, dbClusterId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckDBClusterExists indicates an expected call of CheckDBClusterExists.
+func (mr *MockIRDSMockRecorder) CheckDBClusterExists(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDBClusterExists", reflect.TypeOf((*MockIRDS)(nil).CheckDBClusterExists), ctx, dbClusterId)
+}
+
 // CheckDBInstanceDeletionProtection mocks base method.
 func (m *MockIRDS) CheckDBInstanceDeletionProtection(ctx context.Context, dbInstanceId *string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +85,64 @@ func (mr *MockIRDSMockRecorder) CheckDBInstanceDeletionProtection(ctx, dbInstanc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDBInstanceDeletionProtection", reflect.TypeOf((*MockIRDS)(nil).CheckDBInstanceDeletionProtection), ctx, dbInstanceId)
 }
 
+// DeleteDBCluster mocks base method.
+func (m *MockIRDS) DeleteDBCluster(ctx context.Context, dbClusterId, finalSnapshotIdentifier *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBCluster", ctx, dbClusterId, finalSnapshotIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDBCluster indicates an expected call of DeleteDBCluster.
+func (mr *MockIRDSMockRecorder) DeleteDBCluster(ctx, dbClusterId, finalSnapshotIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBCluster", reflect.TypeOf((*MockIRDS)(nil).DeleteDBCluster), ctx, dbClusterId, finalSnapshotIdentifier)
+}
+
+// DeleteDBInstance mocks base method.
+func (m *MockIRDS) DeleteDBInstance(ctx context.Context, dbInstanceId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDBInstance", ctx, dbInstanceId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDBInstance indicates an expected call of DeleteDBInstance.
+func (mr *MockIRDSMockRecorder) DeleteDBInstance(ctx, dbInstanceId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDBInstance", reflect.TypeOf((*MockIRDS)(nil).DeleteDBInstance), ctx, dbInstanceId)
+}
+
+// DescribeDBCluster mocks base method.
+func (m *MockIRDS) DescribeDBCluster(ctx context.Context, dbClusterId *string) (*types.DBCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDBCluster", ctx, dbClusterId)
+	ret0, _ := ret[0].(*types.DBCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDBCluster indicates an expected call of DescribeDBCluster.
+func (mr *MockIRDSMockRecorder) DescribeDBCluster(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDBCluster", reflect.TypeOf((*MockIRDS)(nil).DescribeDBCluster), ctx, dbClusterId)
+}
+
+// DescribeGlobalCluster mocks base method.
+func (m *MockIRDS) DescribeGlobalCluster(ctx context.Context, globalClusterId *string) (*types.GlobalCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeGlobalCluster", ctx, globalClusterId)
+	ret0, _ := ret[0].(*types.GlobalCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeGlobalCluster indicates an expected call of DescribeGlobalCluster.
+func (mr *MockIRDSMockRecorder) DescribeGlobalCluster(ctx, globalClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeGlobalCluster", reflect.TypeOf((*MockIRDS)(nil).DescribeGlobalCluster), ctx, globalClusterId)
+}
+
 // DisableDBClusterDeletionProtection mocks base method.
 func (m *MockIRDS) DisableDBClusterDeletionProtection(ctx context.Context, dbClusterId *string) error {
 	m.ctrl.T.Helper()
@@ -96,3 +170,31 @@ func (mr *MockIRDSMockRecorder) DisableDBInstanceDeletionProtection(ctx, dbInsta
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableDBInstanceDeletionProtection", reflect.TypeOf((*MockIRDS)(nil).DisableDBInstanceDeletionProtection), ctx, dbInstanceId)
 }
+
+// PromoteReadReplicaDBCluster mocks base method.
+func (m *MockIRDS) PromoteReadReplicaDBCluster(ctx context.Context, dbClusterId *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteReadReplicaDBCluster", ctx, dbClusterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PromoteReadReplicaDBCluster indicates an expected call of PromoteReadReplicaDBCluster.
+func (mr *MockIRDSMockRecorder) PromoteReadReplicaDBCluster(ctx, dbClusterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteReadReplicaDBCluster", reflect.TypeOf((*MockIRDS)(nil).PromoteReadReplicaDBCluster), ctx, dbClusterId)
+}
+
+// RemoveFromGlobalCluster mocks base method.
+func (m *MockIRDS) RemoveFromGlobalCluster(ctx context.Context, globalClusterId, dbClusterArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromGlobalCluster", ctx, globalClusterId, dbClusterArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromGlobalCluster indicates an expected call of RemoveFromGlobalCluster.
+func (mr *MockIRDSMockRecorder) RemoveFromGlobalCluster(ctx, globalClusterId, dbClusterArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromGlobalCluster", reflect.TypeOf((*MockIRDS)(nil).RemoveFromGlobalCluster), ctx, globalClusterId, dbClusterArn)
+}
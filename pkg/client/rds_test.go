@@ -493,3 +493,676 @@ func TestRDS_DisableDBClusterDeletionProtection(t *testing.T) {
 		})
 	}
 }
+
+func TestRDS_DescribeDBCluster(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		dbClusterId        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe db cluster successfully",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDBClustersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeDBClustersOutput{
+										DBClusters: []types.DBCluster{
+											{
+												DBClusterIdentifier: aws.String("db-cluster-1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe db cluster failure",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDBClustersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeDBClustersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeDBClustersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("db-cluster-1"),
+				Err:          fmt.Errorf("operation error RDS: DescribeDBClusters, DescribeDBClustersError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			_, err = rdsClient.DescribeDBCluster(tt.args.ctx, tt.args.dbClusterId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRDS_CheckDBClusterExists(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		dbClusterId        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "check db cluster exists successfully",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDBClustersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeDBClustersOutput{
+										DBClusters: []types.DBCluster{
+											{
+												DBClusterIdentifier: aws.String("db-cluster-1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "check db cluster exists successfully for not found",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDBClustersNotFoundMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeDBClustersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DBClusterNotFoundFault: DBCluster db-cluster-1 not found")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "check db cluster exists failure",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeDBClustersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeDBClustersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeDBClustersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			output, err := rdsClient.CheckDBClusterExists(tt.args.ctx, tt.args.dbClusterId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if output != tt.want {
+				t.Errorf("output = %#v, want %#v", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestRDS_DescribeGlobalCluster(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		globalClusterId    *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "describe global cluster successfully",
+			args: args{
+				ctx:             context.Background(),
+				globalClusterId: aws.String("global-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeGlobalClustersMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeGlobalClustersOutput{
+										GlobalClusters: []types.GlobalCluster{
+											{
+												GlobalClusterIdentifier: aws.String("global-cluster-1"),
+											},
+										},
+									},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "describe global cluster failure",
+			args: args{
+				ctx:             context.Background(),
+				globalClusterId: aws.String("global-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DescribeGlobalClustersErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DescribeGlobalClustersOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DescribeGlobalClustersError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("global-cluster-1"),
+				Err:          fmt.Errorf("operation error RDS: DescribeGlobalClusters, DescribeGlobalClustersError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			_, err = rdsClient.DescribeGlobalCluster(tt.args.ctx, tt.args.globalClusterId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRDS_RemoveFromGlobalCluster(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		globalClusterId    *string
+		dbClusterArn       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "remove from global cluster successfully",
+			args: args{
+				ctx:             context.Background(),
+				globalClusterId: aws.String("global-cluster-1"),
+				dbClusterArn:    aws.String("arn:aws:rds:us-east-1:123456789012:cluster:db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RemoveFromGlobalClusterMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.RemoveFromGlobalClusterOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "remove from global cluster failure",
+			args: args{
+				ctx:             context.Background(),
+				globalClusterId: aws.String("global-cluster-1"),
+				dbClusterArn:    aws.String("arn:aws:rds:us-east-1:123456789012:cluster:db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"RemoveFromGlobalClusterErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.RemoveFromGlobalClusterOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("RemoveFromGlobalClusterError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("arn:aws:rds:us-east-1:123456789012:cluster:db-cluster-1"),
+				Err:          fmt.Errorf("operation error RDS: RemoveFromGlobalCluster, RemoveFromGlobalClusterError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			err = rdsClient.RemoveFromGlobalCluster(tt.args.ctx, tt.args.globalClusterId, tt.args.dbClusterArn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRDS_PromoteReadReplicaDBCluster(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		dbClusterId        *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "promote read replica db cluster successfully",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"PromoteReadReplicaDBClusterMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.PromoteReadReplicaDBClusterOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "promote read replica db cluster failure",
+			args: args{
+				ctx:         context.Background(),
+				dbClusterId: aws.String("db-cluster-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"PromoteReadReplicaDBClusterErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.PromoteReadReplicaDBClusterOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("PromoteReadReplicaDBClusterError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("db-cluster-1"),
+				Err:          fmt.Errorf("operation error RDS: PromoteReadReplicaDBCluster, PromoteReadReplicaDBClusterError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			err = rdsClient.PromoteReadReplicaDBCluster(tt.args.ctx, tt.args.dbClusterId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRDS_DeleteDBInstance(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                context.Context
+		dbInstanceId       *string
+		withAPIOptionsFunc func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete db instance successfully",
+			args: args{
+				ctx:          context.Background(),
+				dbInstanceId: aws.String("db-instance-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDBInstanceMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DeleteDBInstanceOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete db instance failure",
+			args: args{
+				ctx:          context.Background(),
+				dbInstanceId: aws.String("db-instance-1"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDBInstanceErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DeleteDBInstanceOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteDBInstanceError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("db-instance-1"),
+				Err:          fmt.Errorf("operation error RDS: DeleteDBInstance, DeleteDBInstanceError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			err = rdsClient.DeleteDBInstance(tt.args.ctx, tt.args.dbInstanceId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRDS_DeleteDBCluster(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	type args struct {
+		ctx                     context.Context
+		dbClusterId             *string
+		finalSnapshotIdentifier *string
+		withAPIOptionsFunc      func(*middleware.Stack) error
+	}
+
+	cases := []struct {
+		name    string
+		args    args
+		want    error
+		wantErr bool
+	}{
+		{
+			name: "delete db cluster successfully",
+			args: args{
+				ctx:                     context.Background(),
+				dbClusterId:             aws.String("db-cluster-1"),
+				finalSnapshotIdentifier: aws.String("db-cluster-1-final"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDBClusterMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DeleteDBClusterOutput{},
+								}, middleware.Metadata{}, nil
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "delete db cluster failure",
+			args: args{
+				ctx:                     context.Background(),
+				dbClusterId:             aws.String("db-cluster-1"),
+				finalSnapshotIdentifier: aws.String("db-cluster-1-final"),
+				withAPIOptionsFunc: func(stack *middleware.Stack) error {
+					return stack.Finalize.Add(
+						middleware.FinalizeMiddlewareFunc(
+							"DeleteDBClusterErrorMock",
+							func(context.Context, middleware.FinalizeInput, middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+								return middleware.FinalizeOutput{
+									Result: &rds.DeleteDBClusterOutput{},
+								}, middleware.Metadata{}, fmt.Errorf("DeleteDBClusterError")
+							},
+						),
+						middleware.Before,
+					)
+				},
+			},
+			want: &ClientError{
+				ResourceName: aws.String("db-cluster-1"),
+				Err:          fmt.Errorf("operation error RDS: DeleteDBCluster, DeleteDBClusterError"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.LoadDefaultConfig(
+				tt.args.ctx,
+				config.WithRegion("us-east-1"),
+				config.WithAPIOptions([]func(*middleware.Stack) error{tt.args.withAPIOptionsFunc}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := rds.NewFromConfig(cfg)
+			rdsClient := NewRDS(client)
+
+			err = rdsClient.DeleteDBCluster(tt.args.ctx, tt.args.dbClusterId, tt.args.finalSnapshotIdentifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && err.Error() != tt.want.Error() {
+				t.Errorf("err = %#v, want %#v", err, tt.want)
+			}
+		})
+	}
+}
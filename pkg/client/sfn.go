@@ -0,0 +1,141 @@
+//go:generate mockgen -source=$GOFILE -destination=sfn_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+var SleepTimeSecForSFN = 5
+
+type ISFN interface {
+	ListRunningExecutions(ctx context.Context, stateMachineArn *string) ([]types.ExecutionListItem, error)
+	StopExecution(ctx context.Context, executionArn *string) error
+	DeleteStateMachine(ctx context.Context, stateMachineArn *string) error
+	CheckStateMachineExists(ctx context.Context, stateMachineArn *string) (bool, error)
+}
+
+var _ ISFN = (*SFN)(nil)
+
+type SFN struct {
+	client  *sfn.Client
+	retryer *Retryer
+}
+
+func NewSFN(client *sfn.Client) *SFN {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "ThrottlingException")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForSFN)
+
+	return &SFN{
+		client,
+		retryer,
+	}
+}
+
+func (s *SFN) ListRunningExecutions(ctx context.Context, stateMachineArn *string) ([]types.ExecutionListItem, error) {
+	var nextToken *string
+	executions := []types.ExecutionListItem{}
+
+	optFn := func(o *sfn.Options) {
+		o.Retryer = s.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return executions, &ClientError{
+				ResourceName: stateMachineArn,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &sfn.ListExecutionsInput{
+			StateMachineArn: stateMachineArn,
+			StatusFilter:    types.ExecutionStatusRunning,
+			NextToken:       nextToken,
+		}
+
+		output, err := s.client.ListExecutions(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: stateMachineArn,
+				Err:          err,
+			}
+		}
+		executions = append(executions, output.Executions...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return executions, nil
+}
+
+func (s *SFN) StopExecution(ctx context.Context, executionArn *string) error {
+	input := &sfn.StopExecutionInput{
+		ExecutionArn: executionArn,
+	}
+
+	optFn := func(o *sfn.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.StopExecution(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: executionArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (s *SFN) DeleteStateMachine(ctx context.Context, stateMachineArn *string) error {
+	input := &sfn.DeleteStateMachineInput{
+		StateMachineArn: stateMachineArn,
+	}
+
+	optFn := func(o *sfn.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.DeleteStateMachine(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: stateMachineArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (s *SFN) CheckStateMachineExists(ctx context.Context, stateMachineArn *string) (bool, error) {
+	input := &sfn.DescribeStateMachineInput{
+		StateMachineArn: stateMachineArn,
+	}
+
+	optFn := func(o *sfn.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.DescribeStateMachine(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "StateMachineDoesNotExist") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: stateMachineArn,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sfn.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sfn.go -destination=sfn_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISFN is a mock of ISFN interface.
+type MockISFN struct {
+	ctrl     *gomock.Controller
+	recorder *MockISFNMockRecorder
+	isgomock struct{}
+}
+
+// MockISFNMockRecorder is the mock recorder for MockISFN.
+type MockISFNMockRecorder struct {
+	mock *MockISFN
+}
+
+// NewMockISFN creates a new mock instance.
+func NewMockISFN(ctrl *gomock.Controller) *MockISFN {
+	mock := &MockISFN{ctrl: ctrl}
+	mock.recorder = &MockISFNMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISFN) EXPECT() *MockISFNMockRecorder {
+	return m.recorder
+}
+
+// CheckStateMachineExists mocks base method.
+func (m *MockISFN) CheckStateMachineExists(ctx context.Context, stateMachineArn *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStateMachineExists", ctx, stateMachineArn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckStateMachineExists indicates an expected call of CheckStateMachineExists.
+func (mr *MockISFNMockRecorder) CheckStateMachineExists(ctx, stateMachineArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStateMachineExists", reflect.TypeOf((*MockISFN)(nil).CheckStateMachineExists), ctx, stateMachineArn)
+}
+
+// DeleteStateMachine mocks base method.
+func (m *MockISFN) DeleteStateMachine(ctx context.Context, stateMachineArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStateMachine", ctx, stateMachineArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStateMachine indicates an expected call of DeleteStateMachine.
+func (mr *MockISFNMockRecorder) DeleteStateMachine(ctx, stateMachineArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateMachine", reflect.TypeOf((*MockISFN)(nil).DeleteStateMachine), ctx, stateMachineArn)
+}
+
+// ListRunningExecutions mocks base method.
+func (m *MockISFN) ListRunningExecutions(ctx context.Context, stateMachineArn *string) ([]types.ExecutionListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRunningExecutions", ctx, stateMachineArn)
+	ret0, _ := ret[0].([]types.ExecutionListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRunningExecutions indicates an expected call of ListRunningExecutions.
+func (mr *MockISFNMockRecorder) ListRunningExecutions(ctx, stateMachineArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRunningExecutions", reflect.TypeOf((*MockISFN)(nil).ListRunningExecutions), ctx, stateMachineArn)
+}
+
+// StopExecution mocks base method.
+func (m *MockISFN) StopExecution(ctx context.Context, executionArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopExecution", ctx, executionArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopExecution indicates an expected call of StopExecution.
+func (mr *MockISFNMockRecorder) StopExecution(ctx, executionArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopExecution", reflect.TypeOf((*MockISFN)(nil).StopExecution), ctx, executionArn)
+}
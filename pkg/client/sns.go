@@ -0,0 +1,140 @@
+//go:generate mockgen -source=$GOFILE -destination=sns_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+var SleepTimeSecForSNS = 5
+
+type ISNS interface {
+	ListSubscriptionsByTopic(ctx context.Context, topicArn *string) ([]types.Subscription, error)
+	Unsubscribe(ctx context.Context, subscriptionArn *string) error
+	DeleteTopic(ctx context.Context, topicArn *string) error
+	CheckTopicExists(ctx context.Context, topicArn *string) (bool, error)
+}
+
+var _ ISNS = (*SNS)(nil)
+
+type SNS struct {
+	client  *sns.Client
+	retryer *Retryer
+}
+
+func NewSNS(client *sns.Client) *SNS {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "Throttling")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForSNS)
+
+	return &SNS{
+		client,
+		retryer,
+	}
+}
+
+func (s *SNS) ListSubscriptionsByTopic(ctx context.Context, topicArn *string) ([]types.Subscription, error) {
+	var nextToken *string
+	subscriptions := []types.Subscription{}
+
+	optFn := func(o *sns.Options) {
+		o.Retryer = s.retryer
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return subscriptions, &ClientError{
+				ResourceName: topicArn,
+				Err:          ctx.Err(),
+			}
+		default:
+		}
+
+		input := &sns.ListSubscriptionsByTopicInput{
+			TopicArn:  topicArn,
+			NextToken: nextToken,
+		}
+
+		output, err := s.client.ListSubscriptionsByTopic(ctx, input, optFn)
+		if err != nil {
+			return nil, &ClientError{
+				ResourceName: topicArn,
+				Err:          err,
+			}
+		}
+		subscriptions = append(subscriptions, output.Subscriptions...)
+
+		nextToken = output.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+
+	return subscriptions, nil
+}
+
+func (s *SNS) Unsubscribe(ctx context.Context, subscriptionArn *string) error {
+	input := &sns.UnsubscribeInput{
+		SubscriptionArn: subscriptionArn,
+	}
+
+	optFn := func(o *sns.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.Unsubscribe(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: subscriptionArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (s *SNS) DeleteTopic(ctx context.Context, topicArn *string) error {
+	input := &sns.DeleteTopicInput{
+		TopicArn: topicArn,
+	}
+
+	optFn := func(o *sns.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.DeleteTopic(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: topicArn,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (s *SNS) CheckTopicExists(ctx context.Context, topicArn *string) (bool, error) {
+	input := &sns.GetTopicAttributesInput{
+		TopicArn: topicArn,
+	}
+
+	optFn := func(o *sns.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.GetTopicAttributes(ctx, input, optFn)
+	if err != nil && strings.Contains(err.Error(), "NotFound") {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: topicArn,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sns.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sns.go -destination=sns_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISNS is a mock of ISNS interface.
+type MockISNS struct {
+	ctrl     *gomock.Controller
+	recorder *MockISNSMockRecorder
+	isgomock struct{}
+}
+
+// MockISNSMockRecorder is the mock recorder for MockISNS.
+type MockISNSMockRecorder struct {
+	mock *MockISNS
+}
+
+// NewMockISNS creates a new mock instance.
+func NewMockISNS(ctrl *gomock.Controller) *MockISNS {
+	mock := &MockISNS{ctrl: ctrl}
+	mock.recorder = &MockISNSMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISNS) EXPECT() *MockISNSMockRecorder {
+	return m.recorder
+}
+
+// CheckTopicExists mocks base method.
+func (m *MockISNS) CheckTopicExists(ctx context.Context, topicArn *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckTopicExists", ctx, topicArn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckTopicExists indicates an expected call of CheckTopicExists.
+func (mr *MockISNSMockRecorder) CheckTopicExists(ctx, topicArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTopicExists", reflect.TypeOf((*MockISNS)(nil).CheckTopicExists), ctx, topicArn)
+}
+
+// DeleteTopic mocks base method.
+func (m *MockISNS) DeleteTopic(ctx context.Context, topicArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopic", ctx, topicArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTopic indicates an expected call of DeleteTopic.
+func (mr *MockISNSMockRecorder) DeleteTopic(ctx, topicArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopic", reflect.TypeOf((*MockISNS)(nil).DeleteTopic), ctx, topicArn)
+}
+
+// ListSubscriptionsByTopic mocks base method.
+func (m *MockISNS) ListSubscriptionsByTopic(ctx context.Context, topicArn *string) ([]types.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionsByTopic", ctx, topicArn)
+	ret0, _ := ret[0].([]types.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionsByTopic indicates an expected call of ListSubscriptionsByTopic.
+func (mr *MockISNSMockRecorder) ListSubscriptionsByTopic(ctx, topicArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionsByTopic", reflect.TypeOf((*MockISNS)(nil).ListSubscriptionsByTopic), ctx, topicArn)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockISNS) Unsubscribe(ctx context.Context, subscriptionArn *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, subscriptionArn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockISNSMockRecorder) Unsubscribe(ctx, subscriptionArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockISNS)(nil).Unsubscribe), ctx, subscriptionArn)
+}
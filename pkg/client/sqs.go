@@ -0,0 +1,107 @@
+//go:generate mockgen -source=$GOFILE -destination=sqs_mock.go -package=$GOPACKAGE -write_package_comment=false
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+var SleepTimeSecForSQS = 5
+
+type ISQS interface {
+	GetQueueArn(ctx context.Context, queueUrl *string) (*string, error)
+	DeleteQueue(ctx context.Context, queueUrl *string) error
+	CheckQueueExists(ctx context.Context, queueUrl *string) (bool, error)
+}
+
+var _ ISQS = (*SQS)(nil)
+
+type SQS struct {
+	client  *sqs.Client
+	retryer *Retryer
+}
+
+func NewSQS(client *sqs.Client) *SQS {
+	retryable := func(err error) bool {
+		return strings.Contains(err.Error(), "RequestThrottled")
+	}
+	retryer := NewRetryer(retryable, SleepTimeSecForSQS)
+
+	return &SQS{
+		client,
+		retryer,
+	}
+}
+
+func (s *SQS) GetQueueArn(ctx context.Context, queueUrl *string) (*string, error) {
+	input := &sqs.GetQueueAttributesInput{
+		QueueUrl: queueUrl,
+		AttributeNames: []types.QueueAttributeName{
+			types.QueueAttributeNameQueueArn,
+		},
+	}
+
+	optFn := func(o *sqs.Options) {
+		o.Retryer = s.retryer
+	}
+
+	output, err := s.client.GetQueueAttributes(ctx, input, optFn)
+	if err != nil {
+		return nil, &ClientError{
+			ResourceName: queueUrl,
+			Err:          err,
+		}
+	}
+
+	queueArn, ok := output.Attributes[string(types.QueueAttributeNameQueueArn)]
+	if !ok {
+		return nil, nil
+	}
+	return &queueArn, nil
+}
+
+func (s *SQS) DeleteQueue(ctx context.Context, queueUrl *string) error {
+	input := &sqs.DeleteQueueInput{
+		QueueUrl: queueUrl,
+	}
+
+	optFn := func(o *sqs.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.DeleteQueue(ctx, input, optFn)
+	if err != nil {
+		return &ClientError{
+			ResourceName: queueUrl,
+			Err:          err,
+		}
+	}
+	return nil
+}
+
+func (s *SQS) CheckQueueExists(ctx context.Context, queueUrl *string) (bool, error) {
+	input := &sqs.GetQueueAttributesInput{
+		QueueUrl: queueUrl,
+	}
+
+	optFn := func(o *sqs.Options) {
+		o.Retryer = s.retryer
+	}
+
+	_, err := s.client.GetQueueAttributes(ctx, input, optFn)
+	// The JSON protocol returns QueueDoesNotExist, while the legacy query protocol returns NonExistentQueue.
+	if err != nil && (strings.Contains(err.Error(), "QueueDoesNotExist") || strings.Contains(err.Error(), "NonExistentQueue")) {
+		return false, nil
+	}
+	if err != nil {
+		return false, &ClientError{
+			ResourceName: queueUrl,
+			Err:          err,
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sqs.go
+//
+// Generated by this command:
+//
+//	mockgen -source=sqs.go -destination=sqs_mock.go -package=client -write_package_comment=false
+//
+
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISQS is a mock of ISQS interface.
+type MockISQS struct {
+	ctrl     *gomock.Controller
+	recorder *MockISQSMockRecorder
+	isgomock struct{}
+}
+
+// MockISQSMockRecorder is the mock recorder for MockISQS.
+type MockISQSMockRecorder struct {
+	mock *MockISQS
+}
+
+// NewMockISQS creates a new mock instance.
+func NewMockISQS(ctrl *gomock.Controller) *MockISQS {
+	mock := &MockISQS{ctrl: ctrl}
+	mock.recorder = &MockISQSMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISQS) EXPECT() *MockISQSMockRecorder {
+	return m.recorder
+}
+
+// CheckQueueExists mocks base method.
+func (m *MockISQS) CheckQueueExists(ctx context.Context, queueUrl *string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckQueueExists", ctx, queueUrl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckQueueExists indicates an expected call of CheckQueueExists.
+func (mr *MockISQSMockRecorder) CheckQueueExists(ctx, queueUrl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckQueueExists", reflect.TypeOf((*MockISQS)(nil).CheckQueueExists), ctx, queueUrl)
+}
+
+// DeleteQueue mocks base method.
+func (m *MockISQS) DeleteQueue(ctx context.Context, queueUrl *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueue", ctx, queueUrl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueue indicates an expected call of DeleteQueue.
+func (mr *MockISQSMockRecorder) DeleteQueue(ctx, queueUrl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueue", reflect.TypeOf((*MockISQS)(nil).DeleteQueue), ctx, queueUrl)
+}
+
+// GetQueueArn mocks base method.
+func (m *MockISQS) GetQueueArn(ctx context.Context, queueUrl *string) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueArn", ctx, queueUrl)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueArn indicates an expected call of GetQueueArn.
+func (mr *MockISQSMockRecorder) GetQueueArn(ctx, queueUrl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueArn", reflect.TypeOf((*MockISQS)(nil).GetQueueArn), ctx, queueUrl)
+}